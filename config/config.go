@@ -57,11 +57,13 @@ func DefaultListRenderConfig() core.ListRenderConfig {
 			MaxWidth:   0,
 		},
 		ContentConfig: core.ListContentConfig{
-			Enabled:   true,
-			Formatter: nil,
-			Style:     lipgloss.NewStyle(),
-			WrapText:  false,
-			MaxWidth:  80,
+			Enabled:        true,
+			Formatter:      nil,
+			Style:          lipgloss.NewStyle(),
+			WrapText:       false,
+			MaxWidth:       80,
+			TruncateMarker: "...",
+			TruncateSide:   core.ListTruncateRight,
 		},
 		PostSpacingConfig: core.ListSpacingConfig{
 			Enabled: false,
@@ -74,6 +76,9 @@ func DefaultListRenderConfig() core.ListRenderConfig {
 			ApplyToComponents: []core.ListComponentType{core.ListComponentCursor, core.ListComponentEnumerator, core.ListComponentContent},
 			Mode:              core.ListBackgroundEntireLine,
 		},
+		GroupConfig: core.ListGroupConfig{
+			HeaderStyle: lipgloss.NewStyle().Bold(true),
+		},
 	}
 }
 
@@ -86,6 +91,9 @@ func DefaultTableConfig() core.TableConfig {
 		ShowHeader:              true,
 		ShowBorders:             true,
 		FullRowHighlighting:     true,
+		CursorFallbackReverse:   true,
+		RenderCacheEnabled:      true,
+		RecoverFormatterPanics:  true,
 		ShowTopBorder:           true,  // Default to enabled when borders are on
 		ShowBottomBorder:        true,  // Default to enabled when borders are on
 		ShowHeaderSeparator:     true,  // Default to enabled when borders are on
@@ -116,33 +124,23 @@ func DefaultViewportConfig() core.ViewportConfig {
 // defining colors and attributes for different item states.
 func DefaultStyleConfig() core.StyleConfig {
 	return core.StyleConfig{
-		CursorStyle:    lipgloss.NewStyle().Foreground(lipgloss.Color("205")).Bold(true),
-		SelectedStyle:  lipgloss.NewStyle().Background(lipgloss.Color("57")).Foreground(lipgloss.Color("230")),
-		DefaultStyle:   lipgloss.NewStyle().Foreground(lipgloss.Color("252")),
-		ThresholdStyle: lipgloss.NewStyle().Foreground(lipgloss.Color("241")).Italic(true),
-		DisabledStyle:  lipgloss.NewStyle().Foreground(lipgloss.Color("243")),
-		LoadingStyle:   lipgloss.NewStyle().Foreground(lipgloss.Color("69")).Italic(true),
-		ErrorStyle:     lipgloss.NewStyle().Foreground(lipgloss.Color("196")).Bold(true),
+		CursorStyle:         lipgloss.NewStyle().Foreground(lipgloss.Color("205")).Bold(true),
+		SelectedStyle:       lipgloss.NewStyle().Background(lipgloss.Color("57")).Foreground(lipgloss.Color("230")),
+		DefaultStyle:        lipgloss.NewStyle().Foreground(lipgloss.Color("252")),
+		ThresholdStyle:      lipgloss.NewStyle().Foreground(lipgloss.Color("241")).Italic(true),
+		DisabledStyle:       lipgloss.NewStyle().Foreground(lipgloss.Color("243")),
+		LoadingStyle:        lipgloss.NewStyle().Foreground(lipgloss.Color("69")).Italic(true),
+		ErrorStyle:          lipgloss.NewStyle().Foreground(lipgloss.Color("196")).Bold(true),
+		ScrollbarStyle:      lipgloss.NewStyle().Foreground(lipgloss.Color("238")),
+		ScrollbarThumbStyle: lipgloss.NewStyle().Foreground(lipgloss.Color("245")),
 	}
 }
 
 // DefaultTheme returns the default theme for a Table component, defining styles
-// for headers, cells, borders, and various states.
+// for headers, cells, borders, and various states. It is the "Default" theme
+// of core.Themes.
 func DefaultTheme() core.Theme {
-	return core.Theme{
-		HeaderStyle:        lipgloss.NewStyle().Foreground(lipgloss.Color("99")).Bold(true),
-		CellStyle:          lipgloss.NewStyle().Foreground(lipgloss.Color("252")),
-		CursorStyle:        lipgloss.NewStyle().Foreground(lipgloss.Color("205")).Bold(true),
-		SelectedStyle:      lipgloss.NewStyle().Background(lipgloss.Color("57")).Foreground(lipgloss.Color("230")),
-		FullRowCursorStyle: lipgloss.NewStyle().Background(lipgloss.Color("12")).Foreground(lipgloss.Color("15")).Bold(true),
-		BorderChars:        core.DefaultBorderChars(),
-		BorderColor:        "241",
-		HeaderColor:        "99",
-		AlternateRowStyle:  lipgloss.NewStyle().Background(lipgloss.Color("235")),
-		DisabledStyle:      lipgloss.NewStyle().Foreground(lipgloss.Color("243")),
-		LoadingStyle:       lipgloss.NewStyle().Foreground(lipgloss.Color("69")).Italic(true),
-		ErrorStyle:         lipgloss.NewStyle().Foreground(lipgloss.Color("196")).Bold(true),
-	}
+	return core.DefaultTheme()
 }
 
 // ValidateViewportConfig checks a ViewportConfig for valid values and returns a
@@ -373,6 +371,32 @@ func (b *ListConfigBuilder) WithSelectionMode(mode core.SelectionMode) *ListConf
 	return b
 }
 
+// WithSelectionConfig sets how the cursor and the selection interact.
+func (b *ListConfigBuilder) WithSelectionConfig(selection core.SelectionConfig) *ListConfigBuilder {
+	b.config.Selection = selection
+	return b
+}
+
+// WithMouseEnabled turns mouse handling on or off.
+func (b *ListConfigBuilder) WithMouseEnabled(enabled bool) *ListConfigBuilder {
+	b.config.MouseEnabled = enabled
+	return b
+}
+
+// WithScrollbar shows or hides the vertical scrollbar.
+func (b *ListConfigBuilder) WithScrollbar(show bool) *ListConfigBuilder {
+	b.config.ViewportConfig.ShowScrollbar = show
+	return b
+}
+
+// WithAutoHeight sizes the viewport to the terminal height, leaving
+// reservedLines free for the rest of the program.
+func (b *ListConfigBuilder) WithAutoHeight(reservedLines int) *ListConfigBuilder {
+	b.config.ViewportConfig.AutoHeight = true
+	b.config.ViewportConfig.ReservedLines = reservedLines
+	return b
+}
+
 // WithMaxWidth sets the maximum width of the list in the configuration.
 func (b *ListConfigBuilder) WithMaxWidth(width int) *ListConfigBuilder {
 	b.config.MaxWidth = width
@@ -432,12 +456,150 @@ func (b *TableConfigBuilder) WithSelectionMode(mode core.SelectionMode) *TableCo
 	return b
 }
 
+// WithSelectionConfig sets how the cursor and the selection interact.
+func (b *TableConfigBuilder) WithSelectionConfig(selection core.SelectionConfig) *TableConfigBuilder {
+	b.config.Selection = selection
+	return b
+}
+
+// WithMaxSelections caps the number of selected rows, evicting the least
+// recently selected row beyond the cap.
+func (b *TableConfigBuilder) WithMaxSelections(max int) *TableConfigBuilder {
+	b.config.MaxSelections = max
+	return b
+}
+
+// WithMouseEnabled turns mouse handling on or off.
+func (b *TableConfigBuilder) WithMouseEnabled(enabled bool) *TableConfigBuilder {
+	b.config.MouseEnabled = enabled
+	return b
+}
+
+// WithScrollbar shows or hides the vertical scrollbar.
+func (b *TableConfigBuilder) WithScrollbar(show bool) *TableConfigBuilder {
+	b.config.ViewportConfig.ShowScrollbar = show
+	return b
+}
+
+// WithAutoHeight sizes the viewport to the terminal height, leaving
+// reservedLines free for the rest of the program.
+func (b *TableConfigBuilder) WithAutoHeight(reservedLines int) *TableConfigBuilder {
+	b.config.ViewportConfig.AutoHeight = true
+	b.config.ViewportConfig.ReservedLines = reservedLines
+	return b
+}
+
+// WithGroupByFields groups the rows by the given fields in the table itself,
+// outermost first.
+func (b *TableConfigBuilder) WithGroupByFields(fields ...string) *TableConfigBuilder {
+	b.config.GroupByFields = fields
+	return b
+}
+
 // WithHeaderVisible sets the header visibility in the configuration.
 func (b *TableConfigBuilder) WithHeaderVisible(visible bool) *TableConfigBuilder {
 	b.config.ShowHeader = visible
 	return b
 }
 
+// WithReservedHeaderSpace keeps blank lines in place of a hidden header, so
+// the rows don't move up.
+func (b *TableConfigBuilder) WithReservedHeaderSpace(reserve bool) *TableConfigBuilder {
+	b.config.ReserveHeaderSpace = reserve
+	return b
+}
+
+// WithSelectionOverridingFormatter renders selected cells as plain text in the
+// selection style instead of merging the selection into formatted cells.
+func (b *TableConfigBuilder) WithSelectionOverridingFormatter(override bool) *TableConfigBuilder {
+	b.config.SelectionOverridesFormatter = override
+	return b
+}
+
+// WithSortIndicators toggles the sort arrows and priorities in the headers of
+// sorted columns.
+func (b *TableConfigBuilder) WithSortIndicators(show bool) *TableConfigBuilder {
+	b.config.ShowSortIndicators = show
+	return b
+}
+
+// WithFooterRow sets the summary row pinned below the rows and whether a
+// separator line is drawn above it.
+func (b *TableConfigBuilder) WithFooterRow(footer core.FooterRowFunc, separator bool) *TableConfigBuilder {
+	b.config.FooterRow = footer
+	b.config.ShowFooterSeparator = separator
+	return b
+}
+
+// WithPagedMode shows the rows one page at a time, with or without a
+// "Page 3/17" line below the table.
+func (b *TableConfigBuilder) WithPagedMode(indicator bool) *TableConfigBuilder {
+	b.config.ViewportConfig.PagedMode = true
+	b.config.ShowPageIndicator = indicator
+	return b
+}
+
+// WithScrollOff keeps rows rows between the cursor and the viewport edges
+// while scrolling.
+func (b *TableConfigBuilder) WithScrollOff(rows int) *TableConfigBuilder {
+	b.config.ViewportConfig.ScrollOff = rows
+	return b
+}
+
+// WithHorizontalScrollIndicator shows or hides the line telling which part of
+// the active cell is in view.
+func (b *TableConfigBuilder) WithHorizontalScrollIndicator(show bool) *TableConfigBuilder {
+	b.config.ShowHorizontalScrollIndicator = show
+	return b
+}
+
+// WithCheckboxColumn shows or hides the row checkboxes.
+func (b *TableConfigBuilder) WithCheckboxColumn(show bool) *TableConfigBuilder {
+	b.config.ShowCheckboxColumn = show
+	return b
+}
+
+// WithAnimateChanges enables or disables marking the rows a refresh changes.
+func (b *TableConfigBuilder) WithAnimateChanges(animate bool) *TableConfigBuilder {
+	b.config.AnimateChanges = animate
+	return b
+}
+
+// WithRenderCache enables or disables reusing the rendered rows between View
+// calls.
+func (b *TableConfigBuilder) WithRenderCache(enabled bool) *TableConfigBuilder {
+	b.config.RenderCacheEnabled = enabled
+	return b
+}
+
+// WithFormatterPanicRecovery enables or disables rendering a warning sign in
+// place of a cell whose formatter panicked.
+func (b *TableConfigBuilder) WithFormatterPanicRecovery(enabled bool) *TableConfigBuilder {
+	b.config.RecoverFormatterPanics = enabled
+	return b
+}
+
+// WithStrictCellCount enables or disables reporting rows whose cell count
+// differs from the column count as load errors.
+func (b *TableConfigBuilder) WithStrictCellCount(strict bool) *TableConfigBuilder {
+	b.config.StrictCellCount = strict
+	return b
+}
+
+// WithDataShapeWarnings enables or disables warning about rows whose cell
+// count differs from the column count.
+func (b *TableConfigBuilder) WithDataShapeWarnings(warn bool) *TableConfigBuilder {
+	b.config.WarnDataShape = warn
+	return b
+}
+
+// WithAutoSizeColumns enables or disables fitting the column widths to their
+// content once the first chunk has loaded.
+func (b *TableConfigBuilder) WithAutoSizeColumns(autoSize bool) *TableConfigBuilder {
+	b.config.AutoSizeColumns = autoSize
+	return b
+}
+
 // WithBordersVisible sets the border visibility in the configuration.
 func (b *TableConfigBuilder) WithBordersVisible(visible bool) *TableConfigBuilder {
 	b.config.ShowBorders = visible
@@ -500,6 +662,42 @@ func MergeListConfigs(base, override core.ListConfig) core.ListConfig {
 	if override.ViewportConfig.InitialIndex >= 0 {
 		result.ViewportConfig.InitialIndex = override.ViewportConfig.InitialIndex
 	}
+	if override.ViewportConfig.ChunkLogger != nil {
+		result.ViewportConfig.ChunkLogger = override.ViewportConfig.ChunkLogger
+	}
+	if override.ViewportConfig.MinRefreshInterval > 0 {
+		result.ViewportConfig.MinRefreshInterval = override.ViewportConfig.MinRefreshInterval
+	}
+	if override.ViewportConfig.ShowScrollbar {
+		result.ViewportConfig.ShowScrollbar = true
+	}
+	if override.ViewportConfig.AutoHeight {
+		result.ViewportConfig.AutoHeight = true
+	}
+	if override.ViewportConfig.ReservedLines > 0 {
+		result.ViewportConfig.ReservedLines = override.ViewportConfig.ReservedLines
+	}
+	if override.ViewportConfig.WrapNavigation {
+		result.ViewportConfig.WrapNavigation = true
+	}
+	if override.ViewportConfig.WrapPageNavigation {
+		result.ViewportConfig.WrapPageNavigation = true
+	}
+	if override.ViewportConfig.SkipDisabled {
+		result.ViewportConfig.SkipDisabled = true
+	}
+	if override.ViewportConfig.PagedMode {
+		result.ViewportConfig.PagedMode = true
+	}
+	if override.ViewportConfig.ScrollOff > 0 {
+		result.ViewportConfig.ScrollOff = override.ViewportConfig.ScrollOff
+	}
+	if override.ViewportConfig.LoadingPlaceholder != nil {
+		result.ViewportConfig.LoadingPlaceholder = override.ViewportConfig.LoadingPlaceholder
+	}
+	if override.ViewportConfig.LoadingAnimationInterval > 0 {
+		result.ViewportConfig.LoadingAnimationInterval = override.ViewportConfig.LoadingAnimationInterval
+	}
 
 	// Merge other configs
 	if override.MaxWidth > 0 {
@@ -507,8 +705,10 @@ func MergeListConfigs(base, override core.ListConfig) core.ListConfig {
 	}
 
 	result.SelectionMode = override.SelectionMode
+	result.Selection = override.Selection
 	result.StyleConfig = override.StyleConfig
 	result.KeyMap = override.KeyMap
+	result.MouseEnabled = override.MouseEnabled
 
 	return result
 }
@@ -523,6 +723,14 @@ func MergeTableConfigs(base, override core.TableConfig) core.TableConfig {
 		result.Columns = override.Columns
 	}
 
+	// Merge grouping if provided
+	if len(override.GroupBy) > 0 {
+		result.GroupBy = override.GroupBy
+	}
+	if len(override.GroupByFields) > 0 {
+		result.GroupByFields = override.GroupByFields
+	}
+
 	// Merge viewport config
 	if override.ViewportConfig.Height > 0 {
 		result.ViewportConfig.Height = override.ViewportConfig.Height
@@ -539,15 +747,97 @@ func MergeTableConfigs(base, override core.TableConfig) core.TableConfig {
 	if override.ViewportConfig.InitialIndex >= 0 {
 		result.ViewportConfig.InitialIndex = override.ViewportConfig.InitialIndex
 	}
+	if override.ViewportConfig.ChunkLogger != nil {
+		result.ViewportConfig.ChunkLogger = override.ViewportConfig.ChunkLogger
+	}
+	if override.ViewportConfig.MinRefreshInterval > 0 {
+		result.ViewportConfig.MinRefreshInterval = override.ViewportConfig.MinRefreshInterval
+	}
+	if override.ViewportConfig.ShowScrollbar {
+		result.ViewportConfig.ShowScrollbar = true
+	}
+	if override.ViewportConfig.AutoHeight {
+		result.ViewportConfig.AutoHeight = true
+	}
+	if override.ViewportConfig.ReservedLines > 0 {
+		result.ViewportConfig.ReservedLines = override.ViewportConfig.ReservedLines
+	}
+	if override.ViewportConfig.WrapNavigation {
+		result.ViewportConfig.WrapNavigation = true
+	}
+	if override.ViewportConfig.WrapPageNavigation {
+		result.ViewportConfig.WrapPageNavigation = true
+	}
+	if override.ViewportConfig.SkipDisabled {
+		result.ViewportConfig.SkipDisabled = true
+	}
+	if override.ViewportConfig.PagedMode {
+		result.ViewportConfig.PagedMode = true
+	}
+	if override.ViewportConfig.ScrollOff > 0 {
+		result.ViewportConfig.ScrollOff = override.ViewportConfig.ScrollOff
+	}
+	if override.ViewportConfig.LoadingPlaceholder != nil {
+		result.ViewportConfig.LoadingPlaceholder = override.ViewportConfig.LoadingPlaceholder
+	}
+	if override.ViewportConfig.LoadingAnimationInterval > 0 {
+		result.ViewportConfig.LoadingAnimationInterval = override.ViewportConfig.LoadingAnimationInterval
+	}
 
 	// Merge other configs
 	result.ShowHeader = override.ShowHeader
+	if override.ReserveHeaderSpace {
+		result.ReserveHeaderSpace = true
+	}
+	if override.SelectionOverridesFormatter {
+		result.SelectionOverridesFormatter = true
+	}
+	if override.ShowSortIndicators {
+		result.ShowSortIndicators = true
+	}
+	if override.FooterRow != nil {
+		result.FooterRow = override.FooterRow
+	}
+	if override.ShowFooterSeparator {
+		result.ShowFooterSeparator = true
+	}
+	if override.ShowPageIndicator {
+		result.ShowPageIndicator = true
+	}
+	if override.ShowHorizontalScrollIndicator {
+		result.ShowHorizontalScrollIndicator = true
+	}
+	if override.ShowCheckboxColumn {
+		result.ShowCheckboxColumn = true
+	}
+	if override.AnimateChanges {
+		result.AnimateChanges = true
+	}
+	if override.AutoSizeColumns {
+		result.AutoSizeColumns = true
+	}
+	if override.StrictCellCount {
+		result.StrictCellCount = true
+	}
+	if override.WarnDataShape {
+		result.WarnDataShape = true
+	}
 	result.ShowBorders = override.ShowBorders
+	result.RenderCacheEnabled = override.RenderCacheEnabled
+	result.RecoverFormatterPanics = override.RecoverFormatterPanics
 	result.SelectionMode = override.SelectionMode
+	result.Selection = override.Selection
+	if override.MaxSelections > 0 {
+		result.MaxSelections = override.MaxSelections
+	}
 	// TODO: animation system is not implemented yet
 	// result.AnimationConfig = override.AnimationConfig
 	result.Theme = override.Theme
+	if override.ForceColorProfile != core.ColorProfileAuto {
+		result.ForceColorProfile = override.ForceColorProfile
+	}
 	result.KeyMap = override.KeyMap
+	result.MouseEnabled = override.MouseEnabled
 
 	return result
 }
@@ -560,8 +850,10 @@ func CloneListConfig(config core.ListConfig) core.ListConfig {
 		StyleConfig:    config.StyleConfig,
 		RenderConfig:   config.RenderConfig,
 		SelectionMode:  config.SelectionMode,
+		Selection:      config.Selection,
 		KeyMap:         config.KeyMap,
 		MaxWidth:       config.MaxWidth,
+		MouseEnabled:   config.MouseEnabled,
 	}
 }
 
@@ -572,14 +864,34 @@ func CloneTableConfig(config core.TableConfig) core.TableConfig {
 	copy(columns, config.Columns)
 
 	return core.TableConfig{
-		Columns:        columns,
-		ShowHeader:     config.ShowHeader,
-		ShowBorders:    config.ShowBorders,
-		ViewportConfig: config.ViewportConfig,
-		Theme:          config.Theme,
+		Columns:                       columns,
+		GroupBy:                       append([]string(nil), config.GroupBy...),
+		GroupByFields:                 append([]string(nil), config.GroupByFields...),
+		ShowHeader:                    config.ShowHeader,
+		ReserveHeaderSpace:            config.ReserveHeaderSpace,
+		SelectionOverridesFormatter:   config.SelectionOverridesFormatter,
+		ShowSortIndicators:            config.ShowSortIndicators,
+		FooterRow:                     config.FooterRow,
+		ShowFooterSeparator:           config.ShowFooterSeparator,
+		ShowPageIndicator:             config.ShowPageIndicator,
+		ShowHorizontalScrollIndicator: config.ShowHorizontalScrollIndicator,
+		ShowCheckboxColumn:            config.ShowCheckboxColumn,
+		AnimateChanges:                config.AnimateChanges,
+		AutoSizeColumns:               config.AutoSizeColumns,
+		RenderCacheEnabled:            config.RenderCacheEnabled,
+		RecoverFormatterPanics:        config.RecoverFormatterPanics,
+		StrictCellCount:               config.StrictCellCount,
+		WarnDataShape:                 config.WarnDataShape,
+		ShowBorders:                   config.ShowBorders,
+		ViewportConfig:                config.ViewportConfig,
+		Theme:                         config.Theme,
 		// TODO: animation system is not implemented yet
 		// AnimationConfig: config.AnimationConfig,
-		SelectionMode: config.SelectionMode,
-		KeyMap:        config.KeyMap,
+		ForceColorProfile: config.ForceColorProfile,
+		SelectionMode:     config.SelectionMode,
+		Selection:         config.Selection,
+		MaxSelections:     config.MaxSelections,
+		KeyMap:            config.KeyMap,
+		MouseEnabled:      config.MouseEnabled,
 	}
 }
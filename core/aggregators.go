@@ -0,0 +1,167 @@
+// Package core provides the fundamental types, interfaces, and messages for the
+// vtable library. It defines the shared data structures and contracts used by
+// different components like List and Table, ensuring a consistent and
+// interoperable architecture. This package is the foundation upon which all other
+// vtable modules are built.
+package core
+
+import (
+	"strconv"
+	"strings"
+)
+
+// Aggregator accumulates cell values one at a time, so a column aggregate can be
+// computed while streaming chunks from a DataSource instead of holding the whole
+// dataset in memory. Use a fresh Aggregator for every computation.
+type Aggregator interface {
+	// Add feeds a single cell value into the aggregate.
+	Add(value string)
+	// Result returns the formatted aggregate of all values added so far.
+	Result() string
+}
+
+// SumAggregator sums numeric cells, skipping cells that do not parse as numbers.
+type SumAggregator struct {
+	sum float64
+}
+
+// Add adds the value to the sum if it is numeric.
+func (a *SumAggregator) Add(value string) {
+	if n, ok := parseAggregateNumber(value); ok {
+		a.sum += n
+	}
+}
+
+// Result returns the formatted sum.
+func (a *SumAggregator) Result() string {
+	return formatAggregateNumber(a.sum)
+}
+
+// AvgAggregator averages numeric cells, skipping cells that do not parse as
+// numbers. The result is empty when no numeric cell was seen.
+type AvgAggregator struct {
+	sum   float64
+	count int
+}
+
+// Add includes the value in the average if it is numeric.
+func (a *AvgAggregator) Add(value string) {
+	if n, ok := parseAggregateNumber(value); ok {
+		a.sum += n
+		a.count++
+	}
+}
+
+// Result returns the formatted average.
+func (a *AvgAggregator) Result() string {
+	if a.count == 0 {
+		return ""
+	}
+	return formatAggregateNumber(a.sum / float64(a.count))
+}
+
+// MinAggregator finds the smallest numeric cell, skipping cells that do not
+// parse as numbers. The result is empty when no numeric cell was seen.
+type MinAggregator struct {
+	min  float64
+	seen bool
+}
+
+// Add updates the minimum if the value is numeric and smaller.
+func (a *MinAggregator) Add(value string) {
+	if n, ok := parseAggregateNumber(value); ok && (!a.seen || n < a.min) {
+		a.min = n
+		a.seen = true
+	}
+}
+
+// Result returns the formatted minimum.
+func (a *MinAggregator) Result() string {
+	if !a.seen {
+		return ""
+	}
+	return formatAggregateNumber(a.min)
+}
+
+// MaxAggregator finds the largest numeric cell, skipping cells that do not
+// parse as numbers. The result is empty when no numeric cell was seen.
+type MaxAggregator struct {
+	max  float64
+	seen bool
+}
+
+// Add updates the maximum if the value is numeric and larger.
+func (a *MaxAggregator) Add(value string) {
+	if n, ok := parseAggregateNumber(value); ok && (!a.seen || n > a.max) {
+		a.max = n
+		a.seen = true
+	}
+}
+
+// Result returns the formatted maximum.
+func (a *MaxAggregator) Result() string {
+	if !a.seen {
+		return ""
+	}
+	return formatAggregateNumber(a.max)
+}
+
+// CountAggregator counts non-empty cells, whether or not they are numeric.
+type CountAggregator struct {
+	count int
+}
+
+// Add counts the value if it is not blank.
+func (a *CountAggregator) Add(value string) {
+	if strings.TrimSpace(value) != "" {
+		a.count++
+	}
+}
+
+// Result returns the count.
+func (a *CountAggregator) Result() string {
+	return strconv.Itoa(a.count)
+}
+
+// Built-in column aggregates for TableColumn.Aggregate. AggCount counts the
+// non-blank cells, numeric or not; the others skip cells that do not parse as
+// numbers.
+var (
+	AggSum   = AggregateUsing(func() Aggregator { return &SumAggregator{} })
+	AggAvg   = AggregateUsing(func() Aggregator { return &AvgAggregator{} })
+	AggCount = AggregateUsing(func() Aggregator { return &CountAggregator{} })
+	AggMin   = AggregateUsing(func() Aggregator { return &MinAggregator{} })
+	AggMax   = AggregateUsing(func() Aggregator { return &MaxAggregator{} })
+)
+
+// AggregateUsing adapts an Aggregator constructor to an AggregateFunc, so the
+// library aggregators can be used as TableColumn.Aggregate, e.g.
+// AggregateUsing(func() Aggregator { return &SumAggregator{} }).
+func AggregateUsing(newAggregator func() Aggregator) AggregateFunc {
+	return func(values []string) string {
+		aggregator := newAggregator()
+		for _, value := range values {
+			aggregator.Add(value)
+		}
+		return aggregator.Result()
+	}
+}
+
+// parseAggregateNumber parses a cell as a number, ignoring surrounding spaces
+// and thousands separators.
+func parseAggregateNumber(value string) (float64, bool) {
+	value = strings.ReplaceAll(strings.TrimSpace(value), ",", "")
+	if value == "" {
+		return 0, false
+	}
+	n, err := strconv.ParseFloat(value, 64)
+	return n, err == nil
+}
+
+// formatAggregateNumber formats an aggregate without trailing zeros, rounding
+// to at most two decimals.
+func formatAggregateNumber(n float64) string {
+	s := strconv.FormatFloat(n, 'f', 2, 64)
+	s = strings.TrimRight(s, "0")
+	return strings.TrimSuffix(s, ".")
+}
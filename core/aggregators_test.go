@@ -0,0 +1,78 @@
+package core
+
+import "testing"
+
+func TestAggregators(t *testing.T) {
+	tests := []struct {
+		name      string
+		aggregate AggregateFunc
+		values    []string
+		expected  string
+	}{
+		{"sum", AggSum, []string{"1", "2", "3.5"}, "6.5"},
+		{"sum of nothing", AggSum, nil, "0"},
+		{"sum skips non-numeric cells", AggSum, []string{"10", "n/a", "", "abc", "5"}, "15"},
+		{"sum of negative numbers", AggSum, []string{"-1.25", "-2"}, "-3.25"},
+		{"sum with thousands separators", AggSum, []string{"1,000", " 2,500 "}, "3500"},
+		{"sum rounds to two decimals", AggSum, []string{"0.333", "0.333"}, "0.67"},
+
+		{"avg", AggAvg, []string{"1", "2", "3", "4"}, "2.5"},
+		{"avg of nothing", AggAvg, nil, ""},
+		{"avg of non-numeric cells", AggAvg, []string{"n/a", ""}, ""},
+		{"avg skips non-numeric cells", AggAvg, []string{"2", "n/a", "4"}, "3"},
+
+		{"min", AggMin, []string{"3", "-1", "2"}, "-1"},
+		{"min of nothing", AggMin, nil, ""},
+		{"min of non-numeric cells", AggMin, []string{"x"}, ""},
+		{"min skips non-numeric cells", AggMin, []string{"x", "7", "9"}, "7"},
+
+		{"max", AggMax, []string{"3", "-1", "12.75"}, "12.75"},
+		{"max of nothing", AggMax, nil, ""},
+		{"max of non-numeric cells", AggMax, []string{"", " "}, ""},
+		{"max of negative numbers", AggMax, []string{"-5", "-3", "x"}, "-3"},
+
+		{"count", AggCount, []string{"1", "2", "3"}, "3"},
+		{"count of nothing", AggCount, nil, "0"},
+		{"count includes non-numeric cells", AggCount, []string{"a", "1", "n/a"}, "3"},
+		{"count skips blank cells", AggCount, []string{"a", "", "  ", "b"}, "2"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.aggregate(tt.values); got != tt.expected {
+				t.Errorf("Expected %q, got %q", tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestAggregatorsStream(t *testing.T) {
+	// Feeding values one at a time gives the result of the whole column
+	values := []string{"4", "x", "8", "", "6"}
+	tests := []struct {
+		name       string
+		aggregator Aggregator
+		expected   string
+	}{
+		{"sum", &SumAggregator{}, "18"},
+		{"avg", &AvgAggregator{}, "6"},
+		{"min", &MinAggregator{}, "4"},
+		{"max", &MaxAggregator{}, "8"},
+		{"count", &CountAggregator{}, "4"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for i, value := range values {
+				tt.aggregator.Add(value)
+				// Results can be read between values
+				if i == 0 && tt.aggregator.Result() == "" {
+					t.Errorf("Expected a result after the first value")
+				}
+			}
+			if got := tt.aggregator.Result(); got != tt.expected {
+				t.Errorf("Expected %q, got %q", tt.expected, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,106 @@
+// Package core provides the fundamental types, interfaces, and messages for the
+// vtable library. It defines the shared data structures and contracts used by
+// different components like List and Table, ensuring a consistent and
+// interoperable architecture. This package is the foundation upon which all other
+// vtable modules are built.
+package core
+
+import (
+	"bytes"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"runtime"
+	"strings"
+)
+
+// CopyFormat selects how copied rows are written to the clipboard.
+type CopyFormat int
+
+// Constants for copy formats.
+const (
+	// CopyTSV writes a header line and one line per row, with cells separated
+	// by tabs. Tabs and line breaks inside cells are replaced by spaces.
+	CopyTSV CopyFormat = iota
+	// CopyCSV writes RFC 4180 CSV with a header record.
+	CopyCSV
+	// CopyJSON writes an array with one object per row, keyed by column field.
+	CopyJSON
+)
+
+// String returns the name of the copy format.
+func (f CopyFormat) String() string {
+	switch f {
+	case CopyTSV:
+		return "tsv"
+	case CopyCSV:
+		return "csv"
+	case CopyJSON:
+		return "json"
+	default:
+		return "unknown"
+	}
+}
+
+// ClipboardWriter places text on a clipboard. Components copy through it so
+// applications and tests can replace the system clipboard.
+type ClipboardWriter interface {
+	WriteClipboard(text string) error
+}
+
+// ErrNoClipboard is returned by SystemClipboard when no clipboard tool is
+// available, as on a headless machine or over SSH.
+var ErrNoClipboard = errors.New("no system clipboard available")
+
+// SystemClipboard writes to the operating system's clipboard through its
+// clipboard tool: pbcopy on macOS, clip on Windows, and wl-copy, xclip or xsel
+// elsewhere, whichever is installed first.
+type SystemClipboard struct{}
+
+// WriteClipboard places text on the system clipboard.
+func (SystemClipboard) WriteClipboard(text string) error {
+	for _, tool := range clipboardTools() {
+		path, err := exec.LookPath(tool[0])
+		if err != nil {
+			continue
+		}
+		cmd := exec.Command(path, tool[1:]...)
+		cmd.Stdin = strings.NewReader(text)
+		if output, err := cmd.CombinedOutput(); err != nil {
+			return fmt.Errorf("%s: %w: %s", tool[0], err, bytes.TrimSpace(output))
+		}
+		return nil
+	}
+	return ErrNoClipboard
+}
+
+// clipboardTools returns the clipboard commands to try on this platform, in
+// order of preference
+func clipboardTools() [][]string {
+	switch runtime.GOOS {
+	case "darwin":
+		return [][]string{{"pbcopy"}}
+	case "windows":
+		return [][]string{{"clip"}}
+	}
+	tools := [][]string{
+		{"xclip", "-selection", "clipboard"},
+		{"xsel", "--clipboard", "--input"},
+		{"termux-clipboard-set"},
+	}
+	if os.Getenv("WAYLAND_DISPLAY") != "" {
+		tools = append([][]string{{"wl-copy"}}, tools...)
+	}
+	return tools
+}
+
+// OSC52Sequence returns the OSC 52 escape sequence that asks the terminal to
+// place text on the clipboard, which works over SSH too. The sequence must
+// reach the terminal through the program's output, in order with its
+// rendering, and terminals without OSC 52 support ignore it without telling,
+// so it suits a fallback for when no system clipboard is available.
+func OSC52Sequence(text string) string {
+	return "\x1b]52;c;" + base64.StdEncoding.EncodeToString([]byte(text)) + "\a"
+}
@@ -0,0 +1,9 @@
+package core
+
+import "testing"
+
+func TestOSC52Sequence(t *testing.T) {
+	if got, expected := OSC52Sequence("hello"), "\x1b]52;c;aGVsbG8=\a"; got != expected {
+		t.Errorf("Expected %q, got %q", expected, got)
+	}
+}
@@ -0,0 +1,235 @@
+package core
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/muesli/termenv"
+)
+
+// ColorProfile is the range of colors a terminal can display.
+type ColorProfile int
+
+// Color profiles, from the richest to the poorest. ColorProfileAuto defers to
+// DetectColorProfile.
+const (
+	ColorProfileAuto ColorProfile = iota
+	ColorProfileTrueColor
+	ColorProfileANSI256
+	ColorProfileANSI
+	ColorProfileMono
+)
+
+// String returns the name of the color profile.
+func (p ColorProfile) String() string {
+	switch p {
+	case ColorProfileAuto:
+		return "auto"
+	case ColorProfileTrueColor:
+		return "truecolor"
+	case ColorProfileANSI256:
+		return "256"
+	case ColorProfileANSI:
+		return "16"
+	case ColorProfileMono:
+		return "mono"
+	default:
+		return fmt.Sprintf("ColorProfile(%d)", int(p))
+	}
+}
+
+// DetectColorProfile returns the color profile lipgloss renders with, which
+// is detected from the terminal and environment of standard output.
+func DetectColorProfile() ColorProfile {
+	switch lipgloss.ColorProfile() {
+	case termenv.TrueColor:
+		return ColorProfileTrueColor
+	case termenv.ANSI256:
+		return ColorProfileANSI256
+	case termenv.ANSI:
+		return ColorProfileANSI
+	default:
+		return ColorProfileMono
+	}
+}
+
+// Degrade returns a copy of the theme whose colors are converted to the
+// nearest colors the profile can display. Foreground and background colors
+// of the styles are converted, as are BorderColor and HeaderColor.
+//
+// ColorProfileMono removes every color. The states that relied on color stay
+// visible through text attributes instead: the cursor, full-row cursor,
+// search matches and the scrollbar thumb are rendered in reverse video, and
+// selected rows, headers, group headers and errors in bold.
+//
+// ColorProfileTrueColor and ColorProfileAuto return the theme unchanged.
+func (t Theme) Degrade(profile ColorProfile) Theme {
+	if profile == ColorProfileAuto || profile == ColorProfileTrueColor {
+		return t
+	}
+
+	styles := []*lipgloss.Style{
+		&t.HeaderStyle, &t.CellStyle, &t.CursorStyle, &t.SelectedStyle,
+		&t.FullRowCursorStyle, &t.AlternateRowStyle, &t.DisabledStyle,
+		&t.LoadingStyle, &t.ErrorStyle, &t.StatusStyle, &t.GroupHeaderStyle,
+		&t.SubtotalStyle, &t.SearchMatchStyle, &t.EvenRowStyle, &t.OddRowStyle,
+		&t.ScrollbarStyle, &t.ScrollbarThumbStyle, &t.SortIndicatorStyle,
+		&t.FooterStyle, &t.CheckboxStyle, &t.ErrorRowStyle,
+		&t.RowEnterStyle, &t.RowExitStyle, &t.PinnedRowStyle,
+		&t.FormatterErrorStyle,
+	}
+	for _, style := range styles {
+		*style = DegradeStyle(*style, profile)
+	}
+	t.BorderColor = DegradeColorString(t.BorderColor, profile)
+	t.HeaderColor = DegradeColorString(t.HeaderColor, profile)
+
+	if profile == ColorProfileMono {
+		for _, style := range []*lipgloss.Style{&t.CursorStyle, &t.FullRowCursorStyle, &t.SearchMatchStyle, &t.ScrollbarThumbStyle} {
+			*style = style.Reverse(true)
+		}
+		for _, style := range []*lipgloss.Style{&t.SelectedStyle, &t.HeaderStyle, &t.GroupHeaderStyle, &t.ErrorStyle, &t.FooterStyle} {
+			*style = style.Bold(true)
+		}
+	}
+	return t
+}
+
+// DegradeStyle converts the foreground and background colors of a style to
+// the nearest colors the profile can display. ColorProfileMono unsets them.
+func DegradeStyle(style lipgloss.Style, profile ColorProfile) lipgloss.Style {
+	if profile == ColorProfileMono {
+		return style.UnsetForeground().UnsetBackground()
+	}
+	if color, ok := degradeTerminalColor(style.GetForeground(), profile); ok {
+		style = style.Foreground(color)
+	}
+	if color, ok := degradeTerminalColor(style.GetBackground(), profile); ok {
+		style = style.Background(color)
+	}
+	return style
+}
+
+// degradeTerminalColor converts the lipgloss color types carrying color
+// strings. It reports false for colors it leaves untouched.
+func degradeTerminalColor(color lipgloss.TerminalColor, profile ColorProfile) (lipgloss.TerminalColor, bool) {
+	switch c := color.(type) {
+	case lipgloss.Color:
+		return lipgloss.Color(DegradeColorString(string(c), profile)), true
+	case lipgloss.ANSIColor:
+		return lipgloss.Color(DegradeColorString(strconv.Itoa(int(c)), profile)), true
+	case lipgloss.AdaptiveColor:
+		return lipgloss.AdaptiveColor{
+			Light: DegradeColorString(c.Light, profile),
+			Dark:  DegradeColorString(c.Dark, profile),
+		}, true
+	default:
+		// CompleteColor and CompleteAdaptiveColor already carry a color per
+		// profile
+		return color, false
+	}
+}
+
+// DegradeColorString converts a lipgloss color string, either an ANSI color
+// number or a "#rrggbb" hex color, to the nearest color the profile can
+// display. ColorProfileMono returns an empty string. Unparseable strings are
+// returned unchanged.
+func DegradeColorString(color string, profile ColorProfile) string {
+	if color == "" || profile == ColorProfileAuto || profile == ColorProfileTrueColor {
+		return color
+	}
+	if profile == ColorProfileMono {
+		return ""
+	}
+
+	var rgb [3]uint8
+	if strings.HasPrefix(color, "#") {
+		parsed, ok := parseHexColor(color)
+		if !ok {
+			return color
+		}
+		rgb = parsed
+	} else {
+		index, err := strconv.Atoi(color)
+		if err != nil || index < 0 || index > 255 {
+			return color
+		}
+		if index < 16 || profile == ColorProfileANSI256 {
+			return color
+		}
+		rgb = ansiPaletteColor(index)
+	}
+
+	// 256-color terminals keep 0–15 for their own palette, so only the cube
+	// and the grays are candidates
+	first, last := 16, 255
+	if profile == ColorProfileANSI {
+		first, last = 0, 15
+	}
+	return strconv.Itoa(nearestPaletteColor(rgb, first, last))
+}
+
+// parseHexColor parses a "#rrggbb" or "#rgb" color
+func parseHexColor(color string) ([3]uint8, bool) {
+	hex := strings.TrimPrefix(color, "#")
+	if len(hex) == 3 {
+		hex = string([]byte{hex[0], hex[0], hex[1], hex[1], hex[2], hex[2]})
+	}
+	if len(hex) != 6 {
+		return [3]uint8{}, false
+	}
+	value, err := strconv.ParseUint(hex, 16, 32)
+	if err != nil {
+		return [3]uint8{}, false
+	}
+	return [3]uint8{uint8(value >> 16), uint8(value >> 8), uint8(value)}, true
+}
+
+// ansiBasicColors are the xterm default RGB values of colors 0–15
+var ansiBasicColors = [16][3]uint8{
+	{0x00, 0x00, 0x00}, {0x80, 0x00, 0x00}, {0x00, 0x80, 0x00}, {0x80, 0x80, 0x00},
+	{0x00, 0x00, 0x80}, {0x80, 0x00, 0x80}, {0x00, 0x80, 0x80}, {0xc0, 0xc0, 0xc0},
+	{0x80, 0x80, 0x80}, {0xff, 0x00, 0x00}, {0x00, 0xff, 0x00}, {0xff, 0xff, 0x00},
+	{0x00, 0x00, 0xff}, {0xff, 0x00, 0xff}, {0x00, 0xff, 0xff}, {0xff, 0xff, 0xff},
+}
+
+// ansiCubeLevels are the channel values of the 6x6x6 color cube (16–231)
+var ansiCubeLevels = [6]uint8{0x00, 0x5f, 0x87, 0xaf, 0xd7, 0xff}
+
+// ansiPaletteColor returns the xterm default RGB value of a 256-color index
+func ansiPaletteColor(index int) [3]uint8 {
+	switch {
+	case index < 16:
+		return ansiBasicColors[index]
+	case index < 232:
+		index -= 16
+		return [3]uint8{ansiCubeLevels[index/36], ansiCubeLevels[index/6%6], ansiCubeLevels[index%6]}
+	default:
+		gray := uint8(8 + (index-232)*10)
+		return [3]uint8{gray, gray, gray}
+	}
+}
+
+// nearestPaletteColor returns the palette index in [first, last] closest to
+// rgb, the lowest index on ties
+func nearestPaletteColor(rgb [3]uint8, first, last int) int {
+	best, bestDistance := first, -1
+	for index := first; index <= last; index++ {
+		if distance := colorDistance(rgb, ansiPaletteColor(index)); bestDistance < 0 || distance < bestDistance {
+			best, bestDistance = index, distance
+		}
+	}
+	return best
+}
+
+// colorDistance is the "redmean" weighted squared distance between two
+// colors, a cheap approximation of perceived difference
+func colorDistance(a, b [3]uint8) int {
+	redMean := (int(a[0]) + int(b[0])) / 2
+	dr := int(a[0]) - int(b[0])
+	dg := int(a[1]) - int(b[1])
+	db := int(a[2]) - int(b[2])
+	return ((512+redMean)*dr*dr)>>8 + 4*dg*dg + ((767-redMean)*db*db)>>8
+}
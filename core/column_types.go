@@ -0,0 +1,319 @@
+// Package core provides the fundamental types, interfaces, and messages for the
+// vtable library. It defines the shared data structures and contracts used by
+// different components like List and Table, ensuring a consistent and
+// interoperable architecture. This package is the foundation upon which all other
+// vtable modules are built.
+package core
+
+import (
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+)
+
+// DateLayouts lists the layouts recognized when detecting and comparing
+// ColumnDate cells, tried in order.
+var DateLayouts = []string{
+	"2006-01-02",
+	"2006-01-02 15:04:05",
+	"2006-01-02 15:04",
+	time.RFC3339,
+	"2006/01/02",
+	"01/02/2006",
+	"Jan 2, 2006",
+	"02 Jan 2006",
+}
+
+// ParseCellDate parses a cell value using the first matching layout in
+// DateLayouts.
+func ParseCellDate(value string) (time.Time, bool) {
+	value = strings.TrimSpace(value)
+	for _, layout := range DateLayouts {
+		if parsed, err := time.Parse(layout, value); err == nil {
+			return parsed, true
+		}
+	}
+	return time.Time{}, false
+}
+
+// ParseCellBool parses a boolean cell value: "true", "yes", "y", "on" and "1"
+// are true, "false", "no", "n", "off" and "0" are false, in any case.
+func ParseCellBool(value string) (bool, bool) {
+	switch strings.ToLower(strings.TrimSpace(value)) {
+	case "true", "yes", "y", "on", "1":
+		return true, true
+	case "false", "no", "n", "off", "0":
+		return false, true
+	}
+	return false, false
+}
+
+// parseCellPercent parses a percentage cell value, with or without its
+// trailing "%"
+func parseCellPercent(value string) (float64, error) {
+	return strconv.ParseFloat(strings.TrimSpace(strings.TrimSuffix(strings.TrimSpace(value), "%")), 64)
+}
+
+// DetectCellType returns the most specific type a single cell value parses as.
+// Empty cells are reported as ColumnString.
+func DetectCellType(value string) ColumnType {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return ColumnString
+	}
+	if _, err := strconv.ParseInt(value, 10, 64); err == nil {
+		return ColumnInt
+	}
+	if _, err := strconv.ParseFloat(value, 64); err == nil {
+		return ColumnFloat
+	}
+	if _, ok := ParseCellDate(value); ok {
+		return ColumnDate
+	}
+	return ColumnString
+}
+
+// DetectColumnType infers a column type from sample cell values. Detection is
+// conservative: empty cells are ignored, a mix of ints and floats is a float
+// column, and any other disagreement (including a single unparseable cell)
+// demotes the column to ColumnString.
+func DetectColumnType(values []string) ColumnType {
+	detected := ColumnString
+	seen := false
+
+	for _, value := range values {
+		if strings.TrimSpace(value) == "" {
+			continue
+		}
+
+		cellType := DetectCellType(value)
+		if cellType == ColumnString {
+			return ColumnString
+		}
+
+		if !seen {
+			detected = cellType
+			seen = true
+			continue
+		}
+
+		switch {
+		case cellType == detected:
+		case (cellType == ColumnInt && detected == ColumnFloat) || (cellType == ColumnFloat && detected == ColumnInt):
+			detected = ColumnFloat
+		default:
+			return ColumnString
+		}
+	}
+
+	return detected
+}
+
+// CompareCells compares two cell values according to the column type and
+// returns -1, 0 or 1. Numeric and date cells that fail to parse sort after
+// parseable ones; ColumnString cells are compared lexically.
+func CompareCells(a, b string, columnType ColumnType) int {
+	switch columnType {
+	case ColumnInt, ColumnFloat:
+		aNum, aErr := strconv.ParseFloat(strings.TrimSpace(a), 64)
+		bNum, bErr := strconv.ParseFloat(strings.TrimSpace(b), 64)
+		if aErr == nil && bErr == nil {
+			switch {
+			case aNum < bNum:
+				return -1
+			case aNum > bNum:
+				return 1
+			}
+			return 0
+		}
+		if aErr == nil {
+			return -1
+		}
+		if bErr == nil {
+			return 1
+		}
+	case ColumnPercent:
+		aNum, aErr := parseCellPercent(a)
+		bNum, bErr := parseCellPercent(b)
+		if aErr == nil && bErr == nil {
+			switch {
+			case aNum < bNum:
+				return -1
+			case aNum > bNum:
+				return 1
+			}
+			return 0
+		}
+		if aErr == nil {
+			return -1
+		}
+		if bErr == nil {
+			return 1
+		}
+	case ColumnBool:
+		aBool, aOk := ParseCellBool(a)
+		bBool, bOk := ParseCellBool(b)
+		if aOk && bOk {
+			switch {
+			case aBool == bBool:
+				return 0
+			case bBool:
+				return -1
+			}
+			return 1
+		}
+		if aOk {
+			return -1
+		}
+		if bOk {
+			return 1
+		}
+	case ColumnDate:
+		aDate, aOk := ParseCellDate(a)
+		bDate, bOk := ParseCellDate(b)
+		if aOk && bOk {
+			return aDate.Compare(bDate)
+		}
+		if aOk {
+			return -1
+		}
+		if bOk {
+			return 1
+		}
+	}
+	return strings.Compare(a, b)
+}
+
+// ColumnTypeFormatter returns the default cell formatter of a column's Type,
+// used when the column has no formatter of its own: numbers are grouped by
+// thousands with NumberFormatter, percentages too with a "%" suffix, booleans
+// render as "✓" or "✗", and dates are reformatted to the column's DateLayout.
+// Cells that don't parse as the type are rendered unchanged. It returns nil
+// for ColumnString, and for ColumnDate without a DateLayout.
+func ColumnTypeFormatter(column TableColumn) SimpleCellFormatter {
+	switch column.Type {
+	case ColumnInt:
+		return NumberFormatter(NumberFormatOptions{})
+	case ColumnFloat:
+		return NumberFormatter(NumberFormatOptions{Decimals: -1})
+	case ColumnPercent:
+		number := NumberFormatter(NumberFormatOptions{Decimals: -1, Suffix: "%"})
+		return func(cellValue string, rowIndex int, column TableColumn, ctx RenderContext, isCursor, isSelected, isActiveCell bool) string {
+			if _, err := parseCellPercent(cellValue); err != nil {
+				return cellValue
+			}
+			trimmed := strings.TrimSuffix(strings.TrimSpace(cellValue), "%")
+			return number(trimmed, rowIndex, column, ctx, isCursor, isSelected, isActiveCell)
+		}
+	case ColumnBool:
+		return func(cellValue string, rowIndex int, column TableColumn, ctx RenderContext, isCursor, isSelected, isActiveCell bool) string {
+			value, ok := ParseCellBool(cellValue)
+			switch {
+			case !ok:
+				return cellValue
+			case value:
+				return "✓"
+			}
+			return "✗"
+		}
+	case ColumnDate:
+		if column.DateLayout == "" {
+			return nil
+		}
+		return func(cellValue string, rowIndex int, column TableColumn, ctx RenderContext, isCursor, isSelected, isActiveCell bool) string {
+			if date, ok := ParseCellDate(cellValue); ok {
+				return date.Format(column.DateLayout)
+			}
+			return cellValue
+		}
+	}
+	return nil
+}
+
+// ColumnAlignment returns the alignment a column's cells are rendered with:
+// its Alignment when set to AlignCenter or AlignRight, or marked with
+// AlignmentSet, otherwise the default of its Type, AlignRight for numbers and
+// percentages and AlignCenter for booleans.
+func ColumnAlignment(column TableColumn) int {
+	if column.Alignment != AlignLeft || column.AlignmentSet {
+		return column.Alignment
+	}
+	switch column.Type {
+	case ColumnInt, ColumnFloat, ColumnPercent:
+		return AlignRight
+	case ColumnBool:
+		return AlignCenter
+	}
+	return AlignLeft
+}
+
+// CompareSortField compares two cell values of a sort field the way request
+// asks for: with the field's comparator from SortComparators when there is
+// one, otherwise with CompareCells and the field's FieldTypes entry. The result
+// is in ascending order; callers apply the field's direction.
+func CompareSortField(request DataRequest, field, a, b string) int {
+	if compare, ok := request.SortComparators[field]; ok && compare != nil {
+		return compare(a, b)
+	}
+	return CompareCells(a, b, request.FieldTypes[field])
+}
+
+// NaturalCompare compares two strings treating runs of digits as numbers, so
+// "Item 2" sorts before "Item 10". Non-digit runs are compared rune by rune and
+// numbers with leading zeros compare equal to their value, with the shorter
+// spelling first as a tie-breaker. It returns -1, 0 or 1.
+func NaturalCompare(a, b string) int {
+	ar, br := []rune(a), []rune(b)
+	i, j := 0, 0
+	tie := 0
+
+	for i < len(ar) && j < len(br) {
+		if unicode.IsDigit(ar[i]) && unicode.IsDigit(br[j]) {
+			startA, startB := i, j
+			for i < len(ar) && unicode.IsDigit(ar[i]) {
+				i++
+			}
+			for j < len(br) && unicode.IsDigit(br[j]) {
+				j++
+			}
+
+			numA := strings.TrimLeft(string(ar[startA:i]), "0")
+			numB := strings.TrimLeft(string(br[startB:j]), "0")
+			if len(numA) != len(numB) {
+				if len(numA) < len(numB) {
+					return -1
+				}
+				return 1
+			}
+			if cmp := strings.Compare(numA, numB); cmp != 0 {
+				return cmp
+			}
+			if tie == 0 && i-startA != j-startB {
+				if i-startA < j-startB {
+					tie = -1
+				} else {
+					tie = 1
+				}
+			}
+			continue
+		}
+
+		if ar[i] != br[j] {
+			if ar[i] < br[j] {
+				return -1
+			}
+			return 1
+		}
+		i++
+		j++
+	}
+
+	switch {
+	case len(ar)-i < len(br)-j:
+		return -1
+	case len(ar)-i > len(br)-j:
+		return 1
+	}
+	return tie
+}
@@ -0,0 +1,44 @@
+package core
+
+import "testing"
+
+func TestColumnAlignment(t *testing.T) {
+	tests := []struct {
+		name     string
+		column   TableColumn
+		expected int
+	}{
+		{"string default", TableColumn{}, AlignLeft},
+		{"number default", TableColumn{Type: ColumnInt}, AlignRight},
+		{"bool default", TableColumn{Type: ColumnBool}, AlignCenter},
+		{"explicit center", TableColumn{Type: ColumnFloat, Alignment: AlignCenter}, AlignCenter},
+		{"explicit left", TableColumn{Type: ColumnPercent, Alignment: AlignLeft, AlignmentSet: true}, AlignLeft},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ColumnAlignment(tt.column); got != tt.expected {
+				t.Errorf("Expected alignment %d, got %d", tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestNaturalCompare(t *testing.T) {
+	cases := []struct {
+		a, b string
+		want int
+	}{
+		{"Item 2", "Item 10", -1},
+		{"Item 10", "Item 2", 1},
+		{"Item 2", "Item 2", 0},
+		{"v1.9.0", "v1.10.0", -1},
+		{"file007", "file7", 1},
+		{"a", "b", -1},
+		{"Item", "Item 1", -1},
+	}
+	for _, c := range cases {
+		if got := NaturalCompare(c.a, c.b); got != c.want {
+			t.Errorf("NaturalCompare(%q, %q) = %d, want %d", c.a, c.b, got, c.want)
+		}
+	}
+}
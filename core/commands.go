@@ -187,6 +187,32 @@ func JumpToCmd(index int) tea.Cmd {
 	}
 }
 
+// JumpToPercentCmd creates a command that sends a JumpToPercentMsg to move
+// the cursor to fraction p of the dataset, e.g. 0.5 for the middle. The
+// target index is round(p * (total-1)) over the current filtered total, with
+// p clamped to 0.0 to 1.0.
+func JumpToPercentCmd(p float64) tea.Cmd {
+	return func() tea.Msg {
+		return JumpToPercentMsg{Percent: p}
+	}
+}
+
+// SetMarkCmd creates a command that sends a SetMarkMsg to mark the row under
+// the cursor with a single-character name.
+func SetMarkCmd(name rune) tea.Cmd {
+	return func() tea.Msg {
+		return SetMarkMsg{Name: name}
+	}
+}
+
+// JumpToMarkCmd creates a command that sends a JumpToMarkMsg to move the cursor
+// to the row marked with name.
+func JumpToMarkCmd(name rune) tea.Cmd {
+	return func() tea.Msg {
+		return JumpToMarkMsg{Name: name}
+	}
+}
+
 // TreeJumpToIndexCmd creates a command that sends a TreeJumpToIndexMsg to move
 // the cursor to a specific index in a tree, with an option to expand parent nodes.
 func TreeJumpToIndexCmd(index int, expandParents bool) tea.Cmd {
@@ -198,6 +224,54 @@ func TreeJumpToIndexCmd(index int, expandParents bool) tea.Cmd {
 	}
 }
 
+// TreeExpandAllCmd creates a command that sends a TreeExpandAllMsg to expand
+// every node of a tree.
+func TreeExpandAllCmd() tea.Cmd {
+	return func() tea.Msg {
+		return TreeExpandAllMsg{}
+	}
+}
+
+// TreeCollapseAllCmd creates a command that sends a TreeCollapseAllMsg to
+// collapse every node of a tree.
+func TreeCollapseAllCmd() tea.Cmd {
+	return func() tea.Msg {
+		return TreeCollapseAllMsg{}
+	}
+}
+
+// TreeExpandToDepthCmd creates a command that sends a TreeExpandToDepthMsg to
+// show the nodes of a tree down to the given depth.
+func TreeExpandToDepthCmd(depth int) tea.Cmd {
+	return func() tea.Msg {
+		return TreeExpandToDepthMsg{Depth: depth}
+	}
+}
+
+// TreeStructureChangedCmd creates a command that sends a TreeStructureChangedMsg
+// with the number of visible nodes.
+func TreeStructureChangedCmd(totalVisible int) tea.Cmd {
+	return func() tea.Msg {
+		return TreeStructureChangedMsg{TotalVisible: totalVisible}
+	}
+}
+
+// DataRequestSetCmd creates a command that sends a DataRequestSetMsg to apply a
+// data request wholesale and reload.
+func DataRequestSetCmd(request DataRequest) tea.Cmd {
+	return func() tea.Msg {
+		return DataRequestSetMsg{Request: request}
+	}
+}
+
+// StateRestoreCmd creates a command that sends a StateRestoreMsg to restore a
+// saved view state.
+func StateRestoreCmd(state PersistedState) tea.Cmd {
+	return func() tea.Msg {
+		return StateRestoreMsg{State: state}
+	}
+}
+
 // DataRefreshCmd creates a command that sends a DataRefreshMsg to trigger a
 // full data reload.
 func DataRefreshCmd() tea.Cmd {
@@ -238,6 +312,38 @@ func DataChunkErrorCmd(startIndex int, err error, request DataRequest) tea.Cmd {
 	}
 }
 
+// RetryItemCmd creates a command that sends a RetryItemMsg to reload the item
+// with the given ID.
+func RetryItemCmd(id string) tea.Cmd {
+	return func() tea.Msg {
+		return RetryItemMsg{ID: id}
+	}
+}
+
+// RetryAllErroredCmd creates a command that sends a RetryAllErroredMsg to
+// reload every loaded item that has an error.
+func RetryAllErroredCmd() tea.Cmd {
+	return func() tea.Msg {
+		return RetryAllErroredMsg{}
+	}
+}
+
+// ItemRetryResultCmd creates a command that sends an ItemRetryResultMsg,
+// reporting the outcome of reloading an item.
+func ItemRetryResultCmd(id string, item Data[any], err error) tea.Cmd {
+	return func() tea.Msg {
+		return ItemRetryResultMsg{ID: id, Item: item, Err: err}
+	}
+}
+
+// IndexOfIDCmd creates a command that sends an IndexOfIDMsg, reporting where
+// an IndexableDataSource found a row.
+func IndexOfIDCmd(id string, index int, found bool) tea.Cmd {
+	return func() tea.Msg {
+		return IndexOfIDMsg{ID: id, Index: index, Found: found}
+	}
+}
+
 // DataTotalCmd creates a command that sends a DataTotalMsg, providing the total
 // number of items in the dataset.
 func DataTotalCmd(total int) tea.Cmd {
@@ -254,6 +360,14 @@ func DataTotalUpdateCmd(total int) tea.Cmd {
 	}
 }
 
+// TotalChangedCmd creates a command that sends a TotalChangedMsg, reporting a
+// change of the total from old to new.
+func TotalChangedCmd(old, new int) tea.Cmd {
+	return func() tea.Msg {
+		return TotalChangedMsg{Old: old, New: new}
+	}
+}
+
 // DataLoadErrorCmd creates a command that sends a DataLoadErrorMsg, indicating a
 // general data loading error.
 func DataLoadErrorCmd(err error) tea.Cmd {
@@ -262,6 +376,22 @@ func DataLoadErrorCmd(err error) tea.Cmd {
 	}
 }
 
+// FormatterPanicCmd creates a command that sends a FormatterPanicMsg to report
+// a cell formatter that panicked.
+func FormatterPanicCmd(column int, rowID string, recovered any) tea.Cmd {
+	return func() tea.Msg {
+		return FormatterPanicMsg{Column: column, RowID: rowID, Recovered: recovered}
+	}
+}
+
+// DataShapeWarningCmd creates a command that sends a DataShapeWarningMsg to
+// report a row whose cell count differs from the column count.
+func DataShapeWarningCmd(index int, rowID string, cells, columns int) tea.Cmd {
+	return func() tea.Msg {
+		return DataShapeWarningMsg{Index: index, RowID: rowID, Cells: cells, Columns: columns}
+	}
+}
+
 // DataSourceSetCmd creates a command that sends a DataSourceSetMsg to replace
 // the component's data source.
 func DataSourceSetCmd(dataSource DataSource[any]) tea.Cmd {
@@ -332,6 +462,16 @@ func SelectAllCmd() tea.Cmd {
 	}
 }
 
+// SelectAllToggleCmd creates a command that sends a SelectAllToggleMsg. It behaves
+// like a "select all" checkbox: it selects all items unless every item is already
+// selected, in which case it clears the selection. Use SelectAllCmd to always
+// select all items.
+func SelectAllToggleCmd() tea.Cmd {
+	return func() tea.Msg {
+		return SelectAllToggleMsg{}
+	}
+}
+
 // SelectClearCmd creates a command that sends a SelectClearMsg to clear all selections.
 func SelectClearCmd() tea.Cmd {
 	return func() tea.Msg {
@@ -339,6 +479,14 @@ func SelectClearCmd() tea.Cmd {
 	}
 }
 
+// SelectionEvictedCmd creates a command that sends a SelectionEvictedMsg to
+// report a row deselected to honor TableConfig.MaxSelections.
+func SelectionEvictedCmd(id string) tea.Cmd {
+	return func() tea.Msg {
+		return SelectionEvictedMsg{ID: id}
+	}
+}
+
 // SelectRangeCmd creates a command that sends a SelectRangeMsg to select a range
 // of items between two item IDs.
 func SelectRangeCmd(startID, endID string) tea.Cmd {
@@ -374,6 +522,14 @@ func SelectionResponseCmd(success bool, index int, id string, selected bool, ope
 	}
 }
 
+// ItemActivatedCmd creates a command that sends an ItemActivatedMsg for the item
+// at index.
+func ItemActivatedCmd(index int, id string) tea.Cmd {
+	return func() tea.Msg {
+		return ItemActivatedMsg{Index: index, ID: id}
+	}
+}
+
 // SelectionChangedCmd creates a command that sends a SelectionChangedMsg to
 // indicate that the selection state has changed within the data source.
 func SelectionChangedCmd(selectedIndices []int, selectedIDs []string, totalSelected int) tea.Cmd {
@@ -386,6 +542,62 @@ func SelectionChangedCmd(selectedIndices []int, selectedIDs []string, totalSelec
 	}
 }
 
+// NavigationWrappedCmd creates a command that sends a NavigationWrappedMsg to
+// report that the cursor wrapped around in the given direction.
+func NavigationWrappedCmd(direction string) tea.Cmd {
+	return func() tea.Msg {
+		return NavigationWrappedMsg{Direction: direction}
+	}
+}
+
+// SelectionAnchorSetCmd creates a command that sends a SelectionAnchorSetMsg
+// to start a range selection at the cursor.
+func SelectionAnchorSetCmd() tea.Cmd {
+	return func() tea.Msg {
+		return SelectionAnchorSetMsg{}
+	}
+}
+
+// SelectionAnchorCommitCmd creates a command that sends a
+// SelectionAnchorCommitMsg to end a range selection and keep it.
+func SelectionAnchorCommitCmd() tea.Cmd {
+	return func() tea.Msg {
+		return SelectionAnchorCommitMsg{}
+	}
+}
+
+// SelectionAnchorClearCmd creates a command that sends a
+// SelectionAnchorClearMsg to end a range selection and deselect it.
+func SelectionAnchorClearCmd() tea.Cmd {
+	return func() tea.Msg {
+		return SelectionAnchorClearMsg{}
+	}
+}
+
+// RangeSelectionUpdatedCmd creates a command that sends a
+// RangeSelectionUpdatedMsg to report the extent of a range selection.
+func RangeSelectionUpdatedCmd(anchor, cursor, count int) tea.Cmd {
+	return func() tea.Msg {
+		return RangeSelectionUpdatedMsg{Anchor: anchor, Cursor: cursor, Count: count}
+	}
+}
+
+// SelectionRejectedCmd creates a command that sends a SelectionRejectedMsg to
+// report that the item at index could not be selected.
+func SelectionRejectedCmd(index int, reason string) tea.Cmd {
+	return func() tea.Msg {
+		return SelectionRejectedMsg{Index: index, Reason: reason}
+	}
+}
+
+// CursorChangedCmd creates a command that sends a CursorChangedMsg to report
+// that the cursor moved from one index to another.
+func CursorChangedCmd(from, to int, id string) tea.Cmd {
+	return func() tea.Msg {
+		return CursorChangedMsg{From: from, To: to, ID: id}
+	}
+}
+
 // FilterSetCmd creates a command that sends a FilterSetMsg to apply a filter to the data.
 func FilterSetCmd(field string, value any) tea.Cmd {
 	return func() tea.Msg {
@@ -411,6 +623,22 @@ func FiltersClearAllCmd() tea.Cmd {
 	}
 }
 
+// FiltersFlushCmd creates a command that sends a FiltersFlushMsg to apply
+// debounced filter changes immediately.
+func FiltersFlushCmd() tea.Cmd {
+	return func() tea.Msg {
+		return FiltersFlushMsg{}
+	}
+}
+
+// FuzzyFieldsSetCmd creates a command that sends a FuzzyFieldsSetMsg to match
+// the filters of the given fields fuzzily.
+func FuzzyFieldsSetCmd(fields []string) tea.Cmd {
+	return func() tea.Msg {
+		return FuzzyFieldsSetMsg{Fields: fields}
+	}
+}
+
 // SortToggleCmd creates a command that sends a SortToggleMsg to toggle the sort
 // order of a field.
 func SortToggleCmd(field string) tea.Cmd {
@@ -457,6 +685,158 @@ func SortsClearAllCmd() tea.Cmd {
 	}
 }
 
+// OverflowStrategySetCmd creates a command that sends an OverflowStrategySetMsg
+// to change how a table fits columns that are wider than the available width.
+func OverflowStrategySetCmd(strategy OverflowStrategy) tea.Cmd {
+	return func() tea.Msg {
+		return OverflowStrategySetMsg{Strategy: strategy}
+	}
+}
+
+// ResponsiveCardSetCmd creates a command that sends a ResponsiveCardSetMsg to
+// enable or disable a table's card layout for narrow widths.
+func ResponsiveCardSetCmd(enabled bool) tea.Cmd {
+	return func() tea.Msg {
+		return ResponsiveCardSetMsg{Enabled: enabled}
+	}
+}
+
+// ColumnFilterEditCmd creates a command that sends a ColumnFilterEditMsg to open
+// the inline filter input of a table column.
+func ColumnFilterEditCmd(column int) tea.Cmd {
+	return func() tea.Msg {
+		return ColumnFilterEditMsg{Column: column}
+	}
+}
+
+// CellEditStartCmd creates a command that sends a CellEditStartMsg to edit the
+// active cell of the row under a table's cursor.
+func CellEditStartCmd() tea.Cmd {
+	return func() tea.Msg {
+		return CellEditStartMsg{}
+	}
+}
+
+// CellEditCommitCmd creates a command that sends a CellEditCommitMsg reporting
+// an edited cell.
+func CellEditCommitCmd(rowID, field, oldValue, newValue string) tea.Cmd {
+	return func() tea.Msg {
+		return CellEditCommitMsg{RowID: rowID, Field: field, OldValue: oldValue, NewValue: newValue}
+	}
+}
+
+// ColumnResizeModeCmd creates a command that sends a ColumnResizeModeMsg to
+// enter or leave a table's interactive resize mode.
+func ColumnResizeModeCmd(enabled bool) tea.Cmd {
+	return func() tea.Msg {
+		return ColumnResizeModeMsg{Enabled: enabled}
+	}
+}
+
+// ColumnResizeCmd creates a command that sends a ColumnResizeMsg to change a
+// column's width by delta.
+func ColumnResizeCmd(columnIndex int, delta int) tea.Cmd {
+	return func() tea.Msg {
+		return ColumnResizeMsg{Index: columnIndex, Delta: delta}
+	}
+}
+
+// ColumnWidthSetCmd creates a command that sends a ColumnWidthSetMsg to set a
+// column's width.
+func ColumnWidthSetCmd(columnIndex int, width int) tea.Cmd {
+	return func() tea.Msg {
+		return ColumnWidthSetMsg{Index: columnIndex, Width: width}
+	}
+}
+
+// ColumnPinCmd creates a command that sends a ColumnPinMsg to pin or unpin a
+// column.
+func ColumnPinCmd(index int, pinned bool) tea.Cmd {
+	return func() tea.Msg {
+		return ColumnPinMsg{Index: index, Pinned: pinned}
+	}
+}
+
+// RowPinCmd creates a command that sends a RowPinMsg to pin or unpin a row.
+func RowPinCmd(id string, pinned bool) tea.Cmd {
+	return func() tea.Msg {
+		return RowPinMsg{ID: id, Pinned: pinned}
+	}
+}
+
+// ColumnVisibilityCmd creates a command that sends a ColumnVisibilityMsg to
+// show or hide the column whose Field is field. The last visible column cannot
+// be hidden.
+func ColumnVisibilityCmd(field string, visible bool) tea.Cmd {
+	return func() tea.Msg {
+		return ColumnVisibilityMsg{Field: field, Visible: visible}
+	}
+}
+
+// ColumnResizedCmd creates a command that sends a ColumnResizedMsg to report a
+// column's new width.
+func ColumnResizedCmd(index, width int) tea.Cmd {
+	return func() tea.Msg {
+		return ColumnResizedMsg{Index: index, Width: width}
+	}
+}
+
+// ColumnsAutoSizeCmd creates a command that sends a ColumnsAutoSizeMsg to fit
+// the column widths to their content.
+func ColumnsAutoSizeCmd() tea.Cmd {
+	return func() tea.Msg {
+		return ColumnsAutoSizeMsg{}
+	}
+}
+
+// ColumnsAutoSizedCmd creates a command that sends a ColumnsAutoSizedMsg with
+// the auto-sized column widths.
+func ColumnsAutoSizedCmd(widths []int) tea.Cmd {
+	return func() tea.Msg {
+		return ColumnsAutoSizedMsg{Widths: widths}
+	}
+}
+
+// MoveColumnLeftCmd creates a command that sends a ColumnMoveMsg to move the
+// active column one position to the left.
+func MoveColumnLeftCmd() tea.Cmd {
+	return func() tea.Msg {
+		return ColumnMoveMsg{Delta: -1}
+	}
+}
+
+// MoveColumnRightCmd creates a command that sends a ColumnMoveMsg to move the
+// active column one position to the right.
+func MoveColumnRightCmd() tea.Cmd {
+	return func() tea.Msg {
+		return ColumnMoveMsg{Delta: 1}
+	}
+}
+
+// ColumnMovedCmd creates a command that sends a ColumnMovedMsg to report a
+// column's move.
+func ColumnMovedCmd(from, to int) tea.Cmd {
+	return func() tea.Msg {
+		return ColumnMovedMsg{From: from, To: to}
+	}
+}
+
+// CycleSortCmd creates a command that sends a CycleSortMsg to advance the sort
+// of a field according to its column's SortCycle.
+func CycleSortCmd(field string) tea.Cmd {
+	return func() tea.Msg {
+		return CycleSortMsg{Field: field}
+	}
+}
+
+// SortChangedCmd creates a command that sends a SortChangedMsg with the current
+// sort configuration.
+func SortChangedCmd(fields, directions []string) tea.Cmd {
+	return func() tea.Msg {
+		return SortChangedMsg{Fields: fields, Directions: directions}
+	}
+}
+
 // FocusCmd creates a command that sends a FocusMsg to give focus to the component.
 func FocusCmd() tea.Cmd {
 	return func() tea.Msg {
@@ -471,6 +851,30 @@ func BlurCmd() tea.Cmd {
 	}
 }
 
+// BoundaryReachedCmd creates a command that sends a BoundaryReachedMsg to report
+// that navigation stopped at the start or end of the dataset.
+func BoundaryReachedCmd(atStart bool) tea.Cmd {
+	return func() tea.Msg {
+		return BoundaryReachedMsg{AtStart: atStart}
+	}
+}
+
+// FocusNextCmd creates a command that sends a FocusNextMsg to ask the
+// application to focus the next pane.
+func FocusNextCmd() tea.Cmd {
+	return func() tea.Msg {
+		return FocusNextMsg{}
+	}
+}
+
+// FocusPrevCmd creates a command that sends a FocusPrevMsg to ask the
+// application to focus the previous pane.
+func FocusPrevCmd() tea.Cmd {
+	return func() tea.Msg {
+		return FocusPrevMsg{}
+	}
+}
+
 // GlobalAnimationTickCmd creates a command that produces a GlobalAnimationTickMsg
 // at a regular interval, driving the animation engine.
 func GlobalAnimationTickCmd() tea.Cmd {
@@ -479,6 +883,21 @@ func GlobalAnimationTickCmd() tea.Cmd {
 	})
 }
 
+// RowTransitionTickCmd creates a command that sends a RowTransitionTickMsg
+// after interval.
+func RowTransitionTickCmd(interval time.Duration) tea.Cmd {
+	return tea.Tick(interval, func(time.Time) tea.Msg {
+		return RowTransitionTickMsg{}
+	})
+}
+
+// LoadingTickCmd creates a command that sends a LoadingTickMsg after interval.
+func LoadingTickCmd(interval time.Duration) tea.Cmd {
+	return tea.Tick(interval, func(time.Time) tea.Msg {
+		return LoadingTickMsg{}
+	})
+}
+
 // AnimationUpdateCmd creates a command that sends an AnimationUpdateMsg to
 // indicate that specific animations have updated.
 func AnimationUpdateCmd(updatedAnimations []string) tea.Cmd {
@@ -564,6 +983,22 @@ func ColumnSetCmd(columns []TableColumn) tea.Cmd {
 	}
 }
 
+// ColumnTypesDetectCmd creates a command that sends a ColumnTypesDetectMsg to
+// infer column types from up to sampleRows loaded rows.
+func ColumnTypesDetectCmd(sampleRows int) tea.Cmd {
+	return func() tea.Msg {
+		return ColumnTypesDetectMsg{SampleRows: sampleRows}
+	}
+}
+
+// ColumnTypesDetectedCmd creates a command that sends a ColumnTypesDetectedMsg
+// reporting the detected column types.
+func ColumnTypesDetectedCmd(types []ColumnType) tea.Cmd {
+	return func() tea.Msg {
+		return ColumnTypesDetectedMsg{Types: types}
+	}
+}
+
 // ColumnUpdateCmd creates a command that sends a ColumnUpdateMsg to update the
 // configuration of a single table column.
 func ColumnUpdateCmd(index int, column TableColumn) tea.Cmd {
@@ -639,6 +1074,23 @@ func ActiveCellIndicationModeSetCmd(enabled bool) tea.Cmd {
 	}
 }
 
+// ActiveCellMoveCmd creates a command that sends an ActiveCellMoveMsg to move
+// the active cell by dRow rows and dCol columns, e.g. (0, 1) for the next
+// column.
+func ActiveCellMoveCmd(dRow, dCol int) tea.Cmd {
+	return func() tea.Msg {
+		return ActiveCellMoveMsg{DRow: dRow, DCol: dCol}
+	}
+}
+
+// ActiveCellChangedCmd creates a command that sends an ActiveCellChangedMsg
+// to report the new position and value of the active cell.
+func ActiveCellChangedCmd(row, col int, value string) tea.Cmd {
+	return func() tea.Msg {
+		return ActiveCellChangedMsg{Row: row, Col: col, Value: value}
+	}
+}
+
 // ActiveCellBackgroundColorSetCmd creates a command that sends an
 // ActiveCellBackgroundColorSetMsg to set the active cell's background color.
 func ActiveCellBackgroundColorSetCmd(color string) tea.Cmd {
@@ -647,8 +1099,18 @@ func ActiveCellBackgroundColorSetCmd(color string) tea.Cmd {
 	}
 }
 
+// ActiveCellBorderStyleSetCmd creates a command that sends an
+// ActiveCellBorderStyleSetMsg to outline the active cell instead of filling it.
+func ActiveCellBorderStyleSetCmd(style ActiveCellBorderStyle) tea.Cmd {
+	return func() tea.Msg {
+		return ActiveCellBorderStyleSetMsg{Style: style}
+	}
+}
+
 // CellFormatterSetCmd creates a command that sends a CellFormatterSetMsg to apply
-// a custom formatter to a table column.
+// a custom formatter to a table column. The formatter belongs to the column at
+// columnIndex and follows it when columns are moved with MoveColumnLeftCmd and
+// MoveColumnRightCmd.
 func CellFormatterSetCmd(columnIndex int, formatter SimpleCellFormatter) tea.Cmd {
 	return func() tea.Msg {
 		return CellFormatterSetMsg{
@@ -688,6 +1150,32 @@ func HeaderFormatterSetCmd(columnIndex int, formatter SimpleHeaderFormatter) tea
 	}
 }
 
+// CellFormatterSetByFieldCmd creates a command that sends a
+// CellFormatterSetByFieldMsg to apply a custom formatter to the column whose
+// Field is field, wherever that column is. When a column has both, its field
+// formatter is used instead of the one set with CellFormatterSetCmd.
+func CellFormatterSetByFieldCmd(field string, formatter SimpleCellFormatter) tea.Cmd {
+	return func() tea.Msg {
+		return CellFormatterSetByFieldMsg{
+			Field:     field,
+			Formatter: formatter,
+		}
+	}
+}
+
+// HeaderFormatterSetByFieldCmd creates a command that sends a
+// HeaderFormatterSetByFieldMsg to apply a custom formatter to the header of the
+// column whose Field is field. It takes precedence over a formatter set with
+// HeaderFormatterSetCmd for the same column.
+func HeaderFormatterSetByFieldCmd(field string, formatter SimpleHeaderFormatter) tea.Cmd {
+	return func() tea.Msg {
+		return HeaderFormatterSetByFieldMsg{
+			Field:     field,
+			Formatter: formatter,
+		}
+	}
+}
+
 // LoadingFormatterSetCmd creates a command that sends a LoadingFormatterSetMsg.
 //
 // Deprecated: Use RowFormatterSetCmd instead.
@@ -885,6 +1373,46 @@ func ValidationErrorCmd(field string, value any, err error, context string) tea.
 	}
 }
 
+// GroupBySetCmd creates a command that sends a GroupBySetMsg to set the fields
+// a table groups its rows by.
+func GroupBySetCmd(fields []string) tea.Cmd {
+	return func() tea.Msg {
+		return GroupBySetMsg{Fields: fields}
+	}
+}
+
+// GroupByFieldsSetCmd creates a command that sends a GroupByFieldsSetMsg to
+// set the fields a table groups its rows by itself.
+func GroupByFieldsSetCmd(fields []string) tea.Cmd {
+	return func() tea.Msg {
+		return GroupByFieldsSetMsg{Fields: fields}
+	}
+}
+
+// GroupToggleCmd creates a command that sends a GroupToggleMsg to collapse or
+// expand the group with the given key.
+func GroupToggleCmd(key string) tea.Cmd {
+	return func() tea.Msg {
+		return GroupToggleMsg{Key: key}
+	}
+}
+
+// GroupToggledCmd creates a command that sends a GroupToggledMsg to report that
+// a group was collapsed or expanded.
+func GroupToggledCmd(key string, collapsed bool) tea.Cmd {
+	return func() tea.Msg {
+		return GroupToggledMsg{Key: key, Collapsed: collapsed}
+	}
+}
+
+// StatusLineSetCmd creates a command that sends a StatusLineSetMsg to set the
+// text of a table's managed status line.
+func StatusLineSetCmd(text string) tea.Cmd {
+	return func() tea.Msg {
+		return StatusLineSetMsg{Text: text}
+	}
+}
+
 // StatusCmd creates a command that sends a StatusMsg to display a status message
 // to the user.
 func StatusCmd(message string, statusType StatusType) tea.Cmd {
@@ -926,6 +1454,30 @@ func SearchResultCmd(results []int, query string, total int) tea.Cmd {
 	}
 }
 
+// SearchStateCmd creates a command that sends a SearchStateMsg to report the
+// state of an incremental search.
+func SearchStateCmd(query string, matchCount, currentMatch int) tea.Cmd {
+	return func() tea.Msg {
+		return SearchStateMsg{Query: query, MatchCount: matchCount, CurrentMatch: currentMatch}
+	}
+}
+
+// ExportCSVCmd creates a command that sends an ExportCSVMsg to export a table's
+// rows to the CSV file at path.
+func ExportCSVCmd(path string, includeHeaders bool) tea.Cmd {
+	return func() tea.Msg {
+		return ExportCSVMsg{Path: path, IncludeHeaders: includeHeaders}
+	}
+}
+
+// CopySelectionCmd creates a command that sends a CopySelectionMsg to copy the
+// selected rows to the clipboard in the given format.
+func CopySelectionCmd(format CopyFormat) tea.Cmd {
+	return func() tea.Msg {
+		return CopySelectionMsg{Format: format}
+	}
+}
+
 // AccessibilityConfigCmd creates a command that sends an AccessibilityConfigMsg
 // to configure accessibility features.
 func AccessibilityConfigCmd(screenReader, highContrast, reducedMotion bool) tea.Cmd {
@@ -1021,6 +1573,45 @@ type FullRowHighlightEnableMsg struct {
 	Enabled bool
 }
 
+// ZebraStripingEnableCmd creates a command that sends a ZebraStripingEnableMsg
+// to enable or disable alternating row backgrounds.
+func ZebraStripingEnableCmd(enabled bool) tea.Cmd {
+	return func() tea.Msg {
+		return ZebraStripingEnableMsg{Enabled: enabled}
+	}
+}
+
+// ZebraStripingEnableMsg enables or disables alternating row backgrounds.
+type ZebraStripingEnableMsg struct {
+	Enabled bool
+}
+
+// RowStyleFuncSetCmd creates a command that sends a RowStyleFuncSetMsg to
+// replace the table's row styling callback. A nil function removes it.
+func RowStyleFuncSetCmd(fn RowStyleFunc) tea.Cmd {
+	return func() tea.Msg {
+		return RowStyleFuncSetMsg{Func: fn}
+	}
+}
+
+// RowStyleFuncSetMsg replaces the table's row styling callback.
+type RowStyleFuncSetMsg struct {
+	Func RowStyleFunc
+}
+
+// FooterRowSetCmd creates a command that sends a FooterRowSetMsg to replace
+// the table's footer row. A nil function removes the footer.
+func FooterRowSetCmd(fn FooterRowFunc) tea.Cmd {
+	return func() tea.Msg {
+		return FooterRowSetMsg{Func: fn}
+	}
+}
+
+// FooterRowSetMsg replaces the function producing the table's footer row.
+type FooterRowSetMsg struct {
+	Func FooterRowFunc
+}
+
 // AriaLabelCmd returns a command to set the ARIA label for accessibility.
 func AriaLabelCmd(label string) tea.Cmd {
 	return func() tea.Msg {
@@ -1062,3 +1653,35 @@ func SetComponentBackgroundCmd(componentType ListComponentType, cursorBg, select
 		}
 	}
 }
+
+// ListBackgroundModeSetCmd returns a command to turn on the background styling
+// of a list in the given mode, keeping its background style.
+func ListBackgroundModeSetCmd(mode ListBackgroundMode) tea.Cmd {
+	return func() tea.Msg {
+		return ListBackgroundModeSetMsg{Mode: mode}
+	}
+}
+
+// ListCursorIndicatorSetCmd returns a command to set the cursor indicator of a
+// list. Items without the cursor are indented by the width of the indicator.
+func ListCursorIndicatorSetCmd(indicator string) tea.Cmd {
+	return func() tea.Msg {
+		return ListCursorIndicatorSetMsg{Indicator: indicator}
+	}
+}
+
+// ListEnumeratorAlignmentSetCmd returns a command to align a list's enumerator
+// within width cells.
+func ListEnumeratorAlignmentSetCmd(alignment ListEnumeratorAlignment, width int) tea.Cmd {
+	return func() tea.Msg {
+		return ListEnumeratorAlignmentSetMsg{Alignment: alignment, Width: width}
+	}
+}
+
+// ListTextWrapEnableCmd returns a command to enable or disable wrapping of a
+// list's item content.
+func ListTextWrapEnableCmd(enabled bool) tea.Cmd {
+	return func() tea.Msg {
+		return ListTextWrapEnableMsg{Enabled: enabled}
+	}
+}
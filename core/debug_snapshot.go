@@ -0,0 +1,79 @@
+package core
+
+import (
+	"maps"
+	"slices"
+)
+
+// DebugSnapshot is a dump of the viewport math of a list, table or tree, to
+// attach to bug reports about scrolling and chunk loading. It encodes to JSON
+// with encoding/json.
+type DebugSnapshot struct {
+	// CursorIndex is the absolute index of the cursor, and CursorViewportIndex
+	// its row within the viewport.
+	CursorIndex         int
+	CursorViewportIndex int
+	// ViewportStart is the index of the first visible item and ViewportHeight
+	// the number of items the viewport shows.
+	ViewportStart  int
+	ViewportHeight int
+
+	// TopThreshold and BottomThreshold are the viewport rows where the cursor
+	// stops and the viewport scrolls instead, or -1 when disabled.
+	TopThreshold        int
+	BottomThreshold     int
+	IsAtTopThreshold    bool
+	IsAtBottomThreshold bool
+
+	// TotalItems is the number of items after filtering.
+	TotalItems int
+
+	// LoadedChunks are the chunks in memory, by start index, and
+	// LoadingChunks the start indices of the chunks being loaded.
+	LoadedChunks  []ChunkInfo
+	LoadingChunks []int
+
+	// ActiveColumn is the focused column of a table, -1 for lists and trees.
+	ActiveColumn int
+	// ScrollOffsets are the horizontal scroll offsets of a table's columns
+	// scrolled away from their start, by column index.
+	ScrollOffsets map[int]int
+}
+
+// NewDebugSnapshot fills the parts of a DebugSnapshot that lists, tables and
+// trees share: the viewport, its thresholds, the total and the chunks.
+func NewDebugSnapshot(state ViewportState, config ViewportConfig, totalItems int, chunks map[int]Chunk[any], loading map[int]bool) DebugSnapshot {
+	snapshot := DebugSnapshot{
+		CursorIndex:         state.CursorIndex,
+		CursorViewportIndex: state.CursorViewportIndex,
+		ViewportStart:       state.ViewportStartIndex,
+		ViewportHeight:      config.Height,
+		TopThreshold:        -1,
+		BottomThreshold:     -1,
+		IsAtTopThreshold:    state.IsAtTopThreshold,
+		IsAtBottomThreshold: state.IsAtBottomThreshold,
+		TotalItems:          totalItems,
+		ActiveColumn:        -1,
+	}
+	if config.TopThreshold >= 0 {
+		snapshot.TopThreshold = config.TopThreshold
+	}
+	if config.BottomThreshold >= 0 {
+		snapshot.BottomThreshold = config.Height - config.BottomThreshold - 1
+	}
+
+	for _, start := range slices.Sorted(maps.Keys(chunks)) {
+		chunk := chunks[start]
+		snapshot.LoadedChunks = append(snapshot.LoadedChunks, ChunkInfo{
+			StartIndex: chunk.StartIndex,
+			EndIndex:   chunk.EndIndex,
+			ItemCount:  len(chunk.Items),
+		})
+	}
+	for _, start := range slices.Sorted(maps.Keys(loading)) {
+		if loading[start] {
+			snapshot.LoadingChunks = append(snapshot.LoadingChunks, start)
+		}
+	}
+	return snapshot
+}
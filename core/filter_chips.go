@@ -0,0 +1,152 @@
+package core
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+)
+
+// FieldNameFunc returns the human-readable name of a field, as shown by
+// RenderActiveFilters.
+type FieldNameFunc func(field string) string
+
+// FilterChipOptions configures RenderActiveFilters. The zero value shows the
+// field names as they are, colored.
+type FilterChipOptions struct {
+	// FieldName names the fields. It defaults to the field itself.
+	FieldName FieldNameFunc
+
+	// FocusedField is the field whose chip is highlighted, e.g. the one the
+	// user moved to with the arrow keys to remove its filter. Empty
+	// highlights none.
+	FocusedField string
+
+	// Separator goes between chips. It defaults to a space.
+	Separator string
+
+	// RemoveMark ends each chip, showing it can be removed. It defaults to
+	// "✕".
+	RemoveMark string
+
+	// ChipColor and FocusedColor are the colors of the chips and of the
+	// focused chip. They default to gray and pink; NoColor leaves the chips
+	// uncolored, the focused chip bold.
+	ChipColor    string
+	FocusedColor string
+	NoColor      bool
+}
+
+// FilterChipFields returns the fields of the active filters in the order
+// RenderActiveFilters shows their chips, so an app can move a focus across the
+// chips and remove the filter of the focused one. Fields with a nil or empty
+// filter are not active and left out.
+func FilterChipFields(filters map[string]any) []string {
+	var fields []string
+	for _, field := range slices.Sorted(maps.Keys(filters)) {
+		switch filter := filters[field].(type) {
+		case nil:
+			continue
+		case string:
+			if filter == "" {
+				continue
+			}
+		}
+		fields = append(fields, field)
+	}
+	return fields
+}
+
+// RenderActiveFilters renders the active filters as a line of chips, such as
+// "[Category: A ✕] [Age ≥ 35 ✕]", one per field of FilterChipFields. Each
+// kind of filter value reads differently: text as "name: text", ExactFilter
+// as "name = value", RegexFilter as "name ~ /pattern/" and RangeFilter as
+// "name: 50–100", "name ≥ 35" or "name < 10" depending on its bounds. Other
+// values show formatted with %v. It returns "" without active filters.
+func RenderActiveFilters(filters map[string]any, opts FilterChipOptions) string {
+	if opts.FieldName == nil {
+		opts.FieldName = func(field string) string { return field }
+	}
+	if opts.Separator == "" {
+		opts.Separator = " "
+	}
+	if opts.RemoveMark == "" {
+		opts.RemoveMark = "✕"
+	}
+	if opts.ChipColor == "" {
+		opts.ChipColor = "245"
+	}
+	if opts.FocusedColor == "" {
+		opts.FocusedColor = "205"
+	}
+
+	chipStyle := lipgloss.NewStyle()
+	focusedStyle := lipgloss.NewStyle().Bold(true)
+	if !opts.NoColor {
+		chipStyle = chipStyle.Foreground(lipgloss.Color(opts.ChipColor))
+		focusedStyle = focusedStyle.Foreground(lipgloss.Color(opts.FocusedColor))
+	}
+
+	var chips []string
+	for _, field := range FilterChipFields(filters) {
+		chip := "[" + filterChipText(opts.FieldName(field), filters[field]) + " " + opts.RemoveMark + "]"
+		if field == opts.FocusedField {
+			chips = append(chips, focusedStyle.Render(chip))
+			continue
+		}
+		chips = append(chips, chipStyle.Render(chip))
+	}
+	return strings.Join(chips, opts.Separator)
+}
+
+// filterChipText describes a filter value on a field named name
+func filterChipText(name string, filter any) string {
+	switch f := filter.(type) {
+	case string:
+		return name + ": " + f
+	case ExactFilter:
+		return name + " = " + f.Value
+	case RegexFilter:
+		return name + " ~ /" + f.Pattern + "/"
+	case RangeFilter:
+		return rangeChipText(name, f)
+	default:
+		return fmt.Sprintf("%s: %v", name, filter)
+	}
+}
+
+// rangeChipText describes a range filter: a span when both bounds are
+// included, comparisons otherwise
+func rangeChipText(name string, f RangeFilter) string {
+	lower, upper := ">", "<"
+	if f.MinInclusive {
+		lower = "≥"
+	}
+	if f.MaxInclusive {
+		upper = "≤"
+	}
+
+	switch {
+	case f.Min != "" && f.Max != "":
+		if f.MinInclusive && f.MaxInclusive {
+			if f.Min == f.Max {
+				return name + " = " + f.Min
+			}
+			return name + ": " + f.Min + "–" + f.Max
+		}
+		// Written as "50 < name ≤ 100", the lower bound reads left of the name
+		below := "<"
+		if f.MinInclusive {
+			below = "≤"
+		}
+		return f.Min + " " + below + " " + name + " " + upper + " " + f.Max
+	case f.Min != "":
+		return name + " " + lower + " " + f.Min
+	case f.Max != "":
+		return name + " " + upper + " " + f.Max
+	default:
+		return name + ": any"
+	}
+}
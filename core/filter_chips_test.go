@@ -0,0 +1,50 @@
+package core
+
+import (
+	"cmp"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/muesli/termenv"
+)
+
+func TestRenderActiveFilters(t *testing.T) {
+	filters := map[string]any{
+		"category": "A",
+		"age":      RangeFilter{Type: ColumnInt, Min: "35", MinInclusive: true},
+		"value":    RangeFilter{Type: ColumnInt, Min: "50", MinInclusive: true, Max: "100", MaxInclusive: true},
+		"score":    RangeFilter{Type: ColumnFloat, Min: "1", Max: "2", MaxInclusive: true},
+		"name":     RegexFilter{Pattern: "^a"},
+		"status":   ExactFilter{Value: "done"},
+		"count":    42,
+		"empty":    "",
+		"unset":    nil,
+	}
+	names := map[string]string{"category": "Category", "age": "Age"}
+	opts := FilterChipOptions{
+		NoColor:   true,
+		FieldName: func(field string) string { return cmp.Or(names[field], field) },
+	}
+
+	want := "[Age ≥ 35 ✕] [Category: A ✕] [count: 42 ✕] [name ~ /^a/ ✕] [1 < score ≤ 2 ✕] [status = done ✕] [value: 50–100 ✕]"
+	if got := StripANSI(RenderActiveFilters(filters, opts)); got != want {
+		t.Errorf("Unexpected chips:\ngot:  %s\nwant: %s", got, want)
+	}
+	if fields := FilterChipFields(filters); !slices.Equal(fields, []string{"age", "category", "count", "name", "score", "status", "value"}) {
+		t.Errorf("Expected the active fields in chip order, got %v", fields)
+	}
+	if got := RenderActiveFilters(map[string]any{"unset": nil}, opts); got != "" {
+		t.Errorf("Expected no chips without active filters, got %q", got)
+	}
+
+	// The focused chip stands out
+	previousProfile := lipgloss.ColorProfile()
+	lipgloss.SetColorProfile(termenv.TrueColor)
+	defer lipgloss.SetColorProfile(previousProfile)
+	opts.FocusedField = "status"
+	if got := RenderActiveFilters(filters, opts); !strings.Contains(got, lipgloss.NewStyle().Bold(true).Render("[status = done ✕]")) {
+		t.Errorf("Expected the focused chip in bold, got %q", got)
+	}
+}
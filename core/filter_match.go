@@ -0,0 +1,95 @@
+package core
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"sync"
+)
+
+// RegexFilter is a filter value matching cells against a regular expression
+// in Go's RE2 syntax. The pattern is unanchored, so it matches anywhere in
+// the cell unless it uses ^ and $.
+type RegexFilter struct {
+	Pattern string
+}
+
+// ExactFilter is a filter value matching cells equal to Value.
+type ExactFilter struct {
+	Value string
+}
+
+// regexCache holds compiled RegexFilter patterns, including the ones that
+// failed to compile, keyed by pattern
+var regexCache sync.Map
+
+// regexCacheEntry is a cached compilation result
+type regexCacheEntry struct {
+	re  *regexp.Regexp
+	err error
+}
+
+// compileFilterRegex compiles a pattern once and serves later calls from the
+// cache
+func compileFilterRegex(pattern string) (*regexp.Regexp, error) {
+	if cached, ok := regexCache.Load(pattern); ok {
+		entry := cached.(regexCacheEntry)
+		return entry.re, entry.err
+	}
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		err = fmt.Errorf("invalid regex filter %q: %w", pattern, err)
+	}
+	regexCache.Store(pattern, regexCacheEntry{re: re, err: err})
+	return re, err
+}
+
+// ApplyFilter reports whether a cell value passes a filter value taken from
+// DataRequest.Filters, so every DataSource can interpret filters the same way.
+// The supported filter values are:
+//
+//   - string: substring match after FoldString, so case and locale-specific
+//     letter forms are ignored; an empty string matches all;
+//   - RegexFilter: the pattern matches somewhere in the cell;
+//   - RangeFilter: the cell falls within the bounds, see RangeFilter.Matches;
+//   - ExactFilter: the cell equals Value, case included;
+//   - nil: matches every cell.
+//
+// Regular expressions are compiled on first use and cached by pattern. An
+// invalid pattern, or a filter value of another type, returns an error which a
+// DataSource should report with DataLoadErrorMsg.
+func ApplyFilter(cellValue string, filter any) (bool, error) {
+	return ApplyRequestFilter(cellValue, filter, false)
+}
+
+// ApplyRequestFilter is ApplyFilter with the accent insensitivity of a
+// request: when foldDiacritics is set, as DataRequest.FilterFoldDiacritics
+// asks, string filters also ignore accents, so "cafe" matches "Café".
+func ApplyRequestFilter(cellValue string, filter any, foldDiacritics bool) (bool, error) {
+	switch f := filter.(type) {
+	case nil:
+		return true, nil
+	case string:
+		return strings.Contains(FoldFilterText(cellValue, foldDiacritics), FoldFilterText(f, foldDiacritics)), nil
+	case RegexFilter:
+		re, err := compileFilterRegex(f.Pattern)
+		if err != nil {
+			return false, err
+		}
+		return re.MatchString(cellValue), nil
+	case RangeFilter:
+		return f.Matches(cellValue), nil
+	case ExactFilter:
+		return cellValue == f.Value, nil
+	default:
+		return false, fmt.Errorf("unsupported filter value of type %T", filter)
+	}
+}
+
+// ValidateFilter returns the error ApplyFilter would return for a filter value
+// without matching a cell, e.g. to reject an invalid regex before it reaches
+// a DataSource.
+func ValidateFilter(filter any) error {
+	_, err := ApplyFilter("", filter)
+	return err
+}
@@ -0,0 +1,34 @@
+package core
+
+import "testing"
+
+func TestApplyFilter(t *testing.T) {
+	tests := []struct {
+		value   string
+		filter  any
+		matched bool
+	}{
+		{"Item 12", "item", true},
+		{"Item 12", "", true},
+		{"Item 12", nil, true},
+		{"Item 12", RegexFilter{Pattern: `^Item \d+$`}, true},
+		{"Item 12", RegexFilter{Pattern: `^\d`}, false},
+		{"Item 12", ExactFilter{Value: "item 12"}, false},
+		{"Item 12", ExactFilter{Value: "Item 12"}, true},
+		{"15", RangeFilter{Type: ColumnInt, Min: "10", MinInclusive: true}, true},
+		{"5", RangeFilter{Type: ColumnInt, Min: "10", MinInclusive: true}, false},
+	}
+	for _, tt := range tests {
+		matched, err := ApplyFilter(tt.value, tt.filter)
+		if err != nil || matched != tt.matched {
+			t.Errorf("ApplyFilter(%q, %#v) = %v, %v; want %v", tt.value, tt.filter, matched, err, tt.matched)
+		}
+	}
+
+	if _, err := ApplyFilter("x", 42); err == nil {
+		t.Error("Expected an error for an unsupported filter value")
+	}
+	if _, err := ApplyFilter("x", RegexFilter{Pattern: "("}); err == nil {
+		t.Error("Expected an error for an invalid regex")
+	}
+}
@@ -0,0 +1,99 @@
+package core
+
+import (
+	"strings"
+	"unicode"
+)
+
+// diacriticBases maps precomposed Latin and Greek letters to their base letter
+var diacriticBases = func() map[rune]rune {
+	groups := []struct {
+		letters string
+		base    rune
+	}{
+		{"ÀÁÂÃÄÅĀĂĄ", 'A'}, {"àáâãäåāăą", 'a'},
+		{"ÇĆĈĊČ", 'C'}, {"çćĉċč", 'c'},
+		{"ĎĐ", 'D'}, {"ďđ", 'd'},
+		{"ÈÉÊËĒĔĖĘĚ", 'E'}, {"èéêëēĕėęě", 'e'},
+		{"ĜĞĠĢ", 'G'}, {"ĝğġģ", 'g'},
+		{"ĤĦ", 'H'}, {"ĥħ", 'h'},
+		{"ÌÍÎÏĨĪĬĮİ", 'I'}, {"ìíîïĩīĭį", 'i'},
+		{"Ĵ", 'J'}, {"ĵ", 'j'},
+		{"Ķ", 'K'}, {"ķ", 'k'},
+		{"ĹĻĽĿŁ", 'L'}, {"ĺļľŀł", 'l'},
+		{"ÑŃŅŇ", 'N'}, {"ñńņňŉ", 'n'},
+		{"ÒÓÔÕÖØŌŎŐ", 'O'}, {"òóôõöøōŏő", 'o'},
+		{"ŔŖŘ", 'R'}, {"ŕŗř", 'r'},
+		{"ŚŜŞŠ", 'S'}, {"śŝşš", 's'},
+		{"ŢŤŦ", 'T'}, {"ţťŧ", 't'},
+		{"ÙÚÛÜŨŪŬŮŰŲ", 'U'}, {"ùúûüũūŭůűų", 'u'},
+		{"Ŵ", 'W'}, {"ŵ", 'w'},
+		{"ÝŶŸ", 'Y'}, {"ýÿŷ", 'y'},
+		{"ŹŻŽ", 'Z'}, {"źżž", 'z'},
+		{"Ά", 'Α'}, {"ά", 'α'},
+		{"Έ", 'Ε'}, {"έ", 'ε'},
+		{"Ή", 'Η'}, {"ή", 'η'},
+		{"ΊΪ", 'Ι'}, {"ίϊΐ", 'ι'},
+		{"Ό", 'Ο'}, {"ό", 'ο'},
+		{"ΎΫ", 'Υ'}, {"ύϋΰ", 'υ'},
+		{"Ώ", 'Ω'}, {"ώ", 'ω'},
+	}
+
+	bases := make(map[rune]rune)
+	for _, group := range groups {
+		for _, letter := range group.letters {
+			bases[letter] = group.base
+		}
+	}
+	return bases
+}()
+
+// FoldString case-folds s so that strings differing only in case compare
+// equal, whatever the locale they were typed in. Unlike strings.ToLower, it
+// folds every case variant of a letter to the same rune: the Greek final sigma
+// ς folds with σ and Σ, and the Turkish dotted İ and dotless ı both fold to i,
+// as do I and i. The German ß folds to "ss".
+func FoldString(s string) string {
+	var builder strings.Builder
+	builder.Grow(len(s))
+	for _, r := range s {
+		switch r {
+		case 'ß', 'ẞ':
+			builder.WriteString("ss")
+		case 'İ', 'ı':
+			builder.WriteRune('i')
+		default:
+			builder.WriteRune(unicode.ToLower(unicode.ToUpper(r)))
+		}
+	}
+	return builder.String()
+}
+
+// StripDiacritics removes accents from s: precomposed Latin and Greek letters
+// are replaced by their base letter, so "café" becomes "cafe", and combining
+// marks following a base letter are dropped.
+func StripDiacritics(s string) string {
+	var builder strings.Builder
+	builder.Grow(len(s))
+	for _, r := range s {
+		if unicode.Is(unicode.Mn, r) {
+			continue
+		}
+		if base, ok := diacriticBases[r]; ok {
+			r = base
+		}
+		builder.WriteRune(r)
+	}
+	return builder.String()
+}
+
+// FoldFilterText folds s the way the built-in substring filter and search
+// match text: FoldString, followed by StripDiacritics when foldDiacritics is
+// set.
+func FoldFilterText(s string, foldDiacritics bool) string {
+	s = FoldString(s)
+	if foldDiacritics {
+		s = StripDiacritics(s)
+	}
+	return s
+}
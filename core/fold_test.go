@@ -0,0 +1,39 @@
+package core
+
+import "testing"
+
+func TestFoldString(t *testing.T) {
+	tests := []struct {
+		a, b       string
+		diacritics bool
+		equal      bool
+	}{
+		{"CAFÉ", "café", false, true},
+		{"café", "cafe", false, false},
+		{"café", "CAFE", true, true},
+		{"Ångström", "angstrom", true, true},
+		{"ΟΔΟΣ", "οδος", false, true},
+		{"ΟΔΟΣ", "οδοσ", false, true},
+		{"Άθήνα", "αθηνα", true, true},
+		{"İstanbul", "istanbul", false, true},
+		{"ISTANBUL", "ıstanbul", false, true},
+		{"Straße", "STRASSE", false, true},
+	}
+	for _, tt := range tests {
+		a, b := FoldFilterText(tt.a, tt.diacritics), FoldFilterText(tt.b, tt.diacritics)
+		if (a == b) != tt.equal {
+			t.Errorf("FoldFilterText(%q) = %q, FoldFilterText(%q) = %q; want equal %v", tt.a, a, tt.b, b, tt.equal)
+		}
+	}
+
+	// The substring filter folds, and ignores accents when the request asks
+	if matched, _ := ApplyFilter("Café Müller", "MÜLLER"); !matched {
+		t.Error("Expected a case-insensitive match of accented text")
+	}
+	if matched, _ := ApplyFilter("Café Müller", "cafe"); matched {
+		t.Error("Expected accents to matter without FilterFoldDiacritics")
+	}
+	if matched, _ := ApplyRequestFilter("Café Müller", "cafe mull", true); !matched {
+		t.Error("Expected an accent-insensitive match with FilterFoldDiacritics")
+	}
+}
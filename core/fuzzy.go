@@ -0,0 +1,103 @@
+// Package core provides the fundamental types, interfaces, and messages for the
+// vtable library. It defines the shared data structures and contracts used by
+// different components like List and Table, ensuring a consistent and
+// interoperable architecture. This package is the foundation upon which all other
+// vtable modules are built.
+package core
+
+import (
+	"unicode"
+)
+
+// Fuzzy match scoring weights. See FuzzyMatch for how they combine.
+const (
+	fuzzyMatchScore       = 16 // Each matched pattern rune
+	fuzzyConsecutiveBonus = 8  // Match right after the previous match
+	fuzzyWordStartBonus   = 8  // Match at the start of a word
+	fuzzyGapPenalty       = 1  // Each target rune skipped between matches
+)
+
+// FuzzyMatch reports whether the runes of pattern appear in target in order,
+// not necessarily adjacent, and scores how well they match. Comparison is
+// case-insensitive.
+//
+// The score is computed deterministically so rankings are stable:
+//
+//   - every matched pattern rune scores 16;
+//   - a match right after the previous match adds 8;
+//   - a match at the start of a word adds 8, where a word starts at the first
+//     rune of target, after a rune that is not a letter or digit, or at an
+//     upper case letter following a lower case one;
+//   - every target rune skipped between the first and the last match costs 1.
+//
+// Runes before the first match are not penalized. For each occurrence of the
+// pattern's first rune, the rest of the pattern is matched greedily at the
+// earliest following positions, and the best scoring alignment wins, the
+// earliest one on ties. Targets with equal scores are left to the caller to
+// order, typically by keeping their original order with a stable sort.
+//
+// An empty pattern matches every target with a score of 0.
+func FuzzyMatch(pattern, target string) (score int, matched bool) {
+	needle := []rune(pattern)
+	if len(needle) == 0 {
+		return 0, true
+	}
+	haystack := []rune(target)
+
+	best, found := 0, false
+	for start, r := range haystack {
+		if !fuzzyRuneEqual(r, needle[0]) {
+			continue
+		}
+		if s, ok := fuzzyAlign(needle, haystack, start); ok && (!found || s > best) {
+			best, found = s, true
+		}
+	}
+	return best, found
+}
+
+// fuzzyAlign scores the greedy alignment of needle in haystack whose first rune
+// matches at start
+func fuzzyAlign(needle, haystack []rune, start int) (int, bool) {
+	score := 0
+	previous := -1
+	next := 0
+	for i := start; i < len(haystack) && next < len(needle); i++ {
+		if !fuzzyRuneEqual(haystack[i], needle[next]) {
+			continue
+		}
+
+		score += fuzzyMatchScore
+		if previous >= 0 {
+			if i == previous+1 {
+				score += fuzzyConsecutiveBonus
+			} else {
+				score -= (i - previous - 1) * fuzzyGapPenalty
+			}
+		}
+		if fuzzyWordStart(haystack, i) {
+			score += fuzzyWordStartBonus
+		}
+
+		previous = i
+		next++
+	}
+	return score, next == len(needle)
+}
+
+// fuzzyRuneEqual compares two runes case-insensitively
+func fuzzyRuneEqual(a, b rune) bool {
+	return a == b || unicode.ToLower(a) == unicode.ToLower(b)
+}
+
+// fuzzyWordStart reports whether the rune at index i starts a word
+func fuzzyWordStart(runes []rune, i int) bool {
+	if i == 0 {
+		return true
+	}
+	prev, cur := runes[i-1], runes[i]
+	if !unicode.IsLetter(prev) && !unicode.IsDigit(prev) {
+		return true
+	}
+	return unicode.IsLower(prev) && unicode.IsUpper(cur)
+}
@@ -0,0 +1,46 @@
+package core
+
+import (
+	"fmt"
+	"sort"
+	"testing"
+)
+
+func TestFuzzyMatch(t *testing.T) {
+	// An empty pattern matches everything with a zero score
+	if score, ok := FuzzyMatch("", "Item 1"); !ok || score != 0 {
+		t.Errorf("Expected an empty pattern to match with score 0, got %d %v", score, ok)
+	}
+	if _, ok := FuzzyMatch("mti", "Item 1"); ok {
+		t.Error("Expected out of order runes not to match")
+	}
+	if _, ok := FuzzyMatch("ITM", "item"); !ok {
+		t.Error("Expected matching to ignore case")
+	}
+
+	// Word starts and adjacent runes outrank scattered matches
+	tight, _ := FuzzyMatch("itm", "Item 1")
+	loose, _ := FuzzyMatch("itm", "bits and mortar")
+	if tight <= loose {
+		t.Errorf("Expected %d > %d", tight, loose)
+	}
+
+	// The best alignment wins over the leftmost one
+	late, _ := FuzzyMatch("ab", "a_xab")
+	alone, _ := FuzzyMatch("ab", "xab")
+	if late != alone {
+		t.Errorf("Expected the adjacent alignment to be scored, got %d and %d", late, alone)
+	}
+
+	// Equal scores keep their original order under a stable sort
+	targets := []string{"Status2", "Status0", "Status1"}
+	scores := make([]int, len(targets))
+	for i, target := range targets {
+		scores[i], _ = FuzzyMatch("stat", target)
+	}
+	order := []int{0, 1, 2}
+	sort.SliceStable(order, func(i, j int) bool { return scores[order[i]] > scores[order[j]] })
+	if fmt.Sprint(order) != "[0 1 2]" {
+		t.Errorf("Expected ties to keep their order, got %v with scores %v", order, scores)
+	}
+}
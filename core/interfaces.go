@@ -9,6 +9,7 @@ import (
 	"time"
 
 	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
 )
 
 // DataSource defines the contract for providing data to vtable components like
@@ -49,6 +50,77 @@ type DataSource[T any] interface {
 	GetItemID(item T) string
 }
 
+// SelectionCounter is an optional interface a DataSource can implement to report
+// the number of selected items across the whole dataset, including items that
+// are not currently loaded. Components use it for selection-aware operations like
+// toggling select-all.
+type SelectionCounter interface {
+	// GetSelectionCount returns the number of currently selected items.
+	GetSelectionCount() int
+}
+
+// EditableDataSource is an optional interface a DataSource can implement to
+// persist cells edited inline in a table. The table calls SetCellValue when an
+// edit is committed and then reloads the visible chunks.
+type EditableDataSource interface {
+	// SetCellValue stores a new value for the field of the row with the given ID.
+	SetCellValue(rowID, field, value string) tea.Cmd
+}
+
+// RetryableDataSource is an optional interface a DataSource can implement to
+// reload single items that failed to load, i.e. whose Data.Error is set. The
+// table calls RetryItem for RetryItemCmd and RetryAllErroredCmd.
+type RetryableDataSource interface {
+	// RetryItem reloads the item with the given ID. It should return a tea.Cmd
+	// that resolves to an ItemRetryResultMsg.
+	RetryItem(id string) tea.Cmd
+}
+
+// IndexableDataSource is an optional interface a DataSource can implement to
+// find a row by ID. After a DataRefreshMsg the table calls IndexOfID for the
+// row under the cursor and moves the cursor back onto it; sources without it
+// keep the cursor at its old index, clamped to the new total.
+//
+// IndexOfID is asynchronous: it is called once the refreshed total is known,
+// and its command must resolve to an IndexOfIDMsg giving the row's index under
+// the sort and filters of the last DataRequest the source was given, or Found
+// false when the row is no longer in the data. A reply for an ID the table no
+// longer waits on is ignored.
+type IndexableDataSource interface {
+	// IndexOfID looks up the row with the given ID. It should return a tea.Cmd
+	// that resolves to an IndexOfIDMsg.
+	IndexOfID(id string) tea.Cmd
+}
+
+// HeightHintDataSource is an optional interface a DataSource can implement to
+// tell a table with wrapped columns how many lines each row takes, so the
+// table picks the rows fitting its height without rendering the rows it then
+// leaves out. Without it the rows are rendered and measured. With hints the
+// table also plans its chunk loading in lines: the viewport and its bounding
+// area span the rows filling Height plus BoundingAreaBefore and
+// BoundingAreaAfter lines, so tall rows load fewer rows ahead. Rows not loaded
+// yet have no hint and count as one line.
+//
+// Hints only choose which rows are rendered: the rendered rows are still cut
+// to the height. A wrong hint makes the rows shown near the edges differ from
+// those measuring would show, a minor scroll jitter, but never corrupts the
+// view or the cursor position.
+type HeightHintDataSource interface {
+	// RowHeightHint returns the number of lines the row with the given ID
+	// takes when the table is availableWidth cells wide.
+	RowHeightHint(id string, availableWidth int) int
+}
+
+// GroupingDataSource is an optional interface a DataSource can implement to
+// group its rows. Grouped sources return group header, subtotal and grand total
+// rows (see TableRowKind) alongside the data rows and count them in GetTotal.
+// The table calls SetGrouping and refreshes whenever the grouping fields or the
+// set of collapsed groups change.
+type GroupingDataSource interface {
+	// SetGrouping sets the fields to group by and the keys of collapsed groups.
+	SetGrouping(groupBy []string, collapsed map[string]bool)
+}
+
 // SearchableDataSource extends the DataSource interface with search capabilities.
 type SearchableDataSource[T any] interface {
 	DataSource[T]
@@ -58,6 +130,16 @@ type SearchableDataSource[T any] interface {
 	FindItemIndex(key string, value any) tea.Cmd
 }
 
+// SearchableDataProvider is an optional interface for a DataSource holding its
+// rows in memory. A table's JumpToMatch uses it to find the first matching row
+// in one call instead of loading the data chunk by chunk.
+type SearchableDataProvider interface {
+	// FindItemIndexFunc returns the index of the first row satisfying pred,
+	// among the rows sorted and filtered as request asks. Its Start and Count
+	// are zero and ignored.
+	FindItemIndexFunc(request DataRequest, pred func(row TableRow) bool) (int, bool)
+}
+
 // ItemFormatter is a function that defines how a single list item is rendered
 // into a string. It receives the item's data, its state (cursor, selection),
 // and the render context.
@@ -97,6 +179,15 @@ type SimpleCellFormatter func(
 	isActiveCell bool,
 ) string
 
+// RowStyleFunc returns a base style for every cell of a table data row, or nil
+// to leave the row unstyled. Cell formatters render on top of it, and the
+// selection and cursor highlighting replace it.
+type RowStyleFunc func(row TableRow, index int, isCursor, isSelected bool) *lipgloss.Style
+
+// FooterRowFunc returns the summary row pinned below a table's rows, such as
+// counts or sums. It is called on every render.
+type FooterRowFunc func(ctx RenderContext) TableRow
+
 // SimpleHeaderFormatter is a function that defines how a table header cell is
 // rendered. It automatically handles text truncation based on column width.
 type SimpleHeaderFormatter func(
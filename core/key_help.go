@@ -0,0 +1,227 @@
+package core
+
+import (
+	"slices"
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+)
+
+// HelpOptions configures RenderKeyHelp. The zero value renders the compact
+// listing on one line, colored.
+type HelpOptions struct {
+	// Full lists the bindings under a title per category, laid out in as many
+	// columns as Width allows. Otherwise the bindings follow each other on as
+	// few lines as Width allows.
+	Full bool
+
+	// Width is the number of cells the help may span, usually the terminal
+	// width. Zero or less never wraps.
+	Width int
+
+	// Separator goes between bindings of the compact listing. It defaults to
+	// " • ".
+	Separator string
+
+	// ActiveKey highlights the binding the key belongs to, e.g. the last key
+	// pressed, as reported by tea.KeyMsg.String().
+	ActiveKey string
+
+	// KeyColor, DescriptionColor and ActiveColor color the keys, their
+	// descriptions and the highlighted binding. They default to cyan, gray and
+	// pink; NoColor leaves the help uncolored, the highlighted binding bold.
+	KeyColor         string
+	DescriptionColor string
+	ActiveColor      string
+	NoColor          bool
+}
+
+// keyHelpEntry is a binding of a NavigationKeyMap with its description
+type keyHelpEntry struct {
+	keys        []string
+	description string
+}
+
+// keyHelpCategory is a titled group of bindings
+type keyHelpCategory struct {
+	title   string
+	entries []keyHelpEntry
+}
+
+// keyHelpCategories groups the bound keys of a key map, leaving out unbound
+// actions and categories left empty
+func keyHelpCategories(keyMap NavigationKeyMap) []keyHelpCategory {
+	categories := []keyHelpCategory{
+		{title: "Navigation", entries: []keyHelpEntry{
+			{keyMap.Up, "up"},
+			{keyMap.Down, "down"},
+			{keyMap.Left, "left"},
+			{keyMap.Right, "right"},
+			{keyMap.PageUp, "page up"},
+			{keyMap.PageDown, "page down"},
+			{keyMap.Home, "go to start"},
+			{keyMap.End, "go to end"},
+			{keyMap.JumpToPercent, "jump to %"},
+			{keyMap.Tab, "next"},
+			{keyMap.ShiftTab, "previous"},
+		}},
+		{title: "Selection", entries: []keyHelpEntry{
+			{keyMap.Select, "select"},
+			{keyMap.SelectAll, "select all"},
+		}},
+		{title: "Data", entries: []keyHelpEntry{
+			{keyMap.Filter, "filter"},
+			{keyMap.Sort, "sort"},
+			{keyMap.Search, "search"},
+		}},
+		{title: "General", entries: []keyHelpEntry{
+			{keyMap.Quit, "quit"},
+		}},
+	}
+
+	var bound []keyHelpCategory
+	for _, category := range categories {
+		category.entries = slices.DeleteFunc(category.entries, func(entry keyHelpEntry) bool {
+			return len(entry.keys) == 0
+		})
+		if len(category.entries) > 0 {
+			bound = append(bound, category)
+		}
+	}
+	return bound
+}
+
+// keyDisplayName shows the arrow keys as arrows and a space as "space"
+func keyDisplayName(key string) string {
+	switch key {
+	case "up":
+		return "↑"
+	case "down":
+		return "↓"
+	case "left":
+		return "←"
+	case "right":
+		return "→"
+	case " ":
+		return "space"
+	}
+	return key
+}
+
+// RenderKeyHelp renders the bindings of a key map as help text, so apps don't
+// have to write their own. Actions without keys are left out, and bindings
+// are grouped by category: navigation, selection, data and general. A binding
+// shows its keys joined by "/" followed by what it does, e.g. "↑/k up".
+//
+// The compact listing wraps between bindings to fit opts.Width; the full
+// listing lays each category out in columns. A binding wider than the width
+// gets a line of its own.
+func RenderKeyHelp(keyMap NavigationKeyMap, opts HelpOptions) string {
+	if opts.Separator == "" {
+		opts.Separator = " • "
+	}
+	if opts.KeyColor == "" {
+		opts.KeyColor = "39"
+	}
+	if opts.DescriptionColor == "" {
+		opts.DescriptionColor = "245"
+	}
+	if opts.ActiveColor == "" {
+		opts.ActiveColor = "205"
+	}
+
+	categories := keyHelpCategories(keyMap)
+	if opts.Full {
+		var sections []string
+		for _, category := range categories {
+			sections = append(sections, category.title+":\n"+layoutKeyHelpColumns(renderKeyHelpEntries(category.entries, opts), opts.Width))
+		}
+		return strings.Join(sections, "\n")
+	}
+
+	var entries []string
+	for _, category := range categories {
+		entries = append(entries, renderKeyHelpEntries(category.entries, opts)...)
+	}
+	return wrapKeyHelp(entries, opts.Separator, opts.Width)
+}
+
+// renderKeyHelpEntries renders bindings, highlighting the one holding
+// opts.ActiveKey
+func renderKeyHelpEntries(entries []keyHelpEntry, opts HelpOptions) []string {
+	keyStyle := lipgloss.NewStyle()
+	descriptionStyle := lipgloss.NewStyle()
+	activeStyle := lipgloss.NewStyle().Bold(true)
+	if !opts.NoColor {
+		keyStyle = keyStyle.Foreground(lipgloss.Color(opts.KeyColor))
+		descriptionStyle = descriptionStyle.Foreground(lipgloss.Color(opts.DescriptionColor))
+		activeStyle = activeStyle.Foreground(lipgloss.Color(opts.ActiveColor))
+	}
+
+	rendered := make([]string, len(entries))
+	for i, entry := range entries {
+		names := make([]string, len(entry.keys))
+		for j, key := range entry.keys {
+			names[j] = keyDisplayName(key)
+		}
+		keys := strings.Join(names, "/")
+
+		if opts.ActiveKey != "" && slices.Contains(entry.keys, opts.ActiveKey) {
+			rendered[i] = activeStyle.Render(keys + " " + entry.description)
+			continue
+		}
+		rendered[i] = keyStyle.Render(keys) + " " + descriptionStyle.Render(entry.description)
+	}
+	return rendered
+}
+
+// wrapKeyHelp joins rendered bindings with separator, starting a new line
+// before a binding that would overflow width
+func wrapKeyHelp(entries []string, separator string, width int) string {
+	var lines []string
+	var line string
+	for _, entry := range entries {
+		switch {
+		case line == "":
+			line = entry
+		case width > 0 && lipgloss.Width(line+separator+entry) > width:
+			lines = append(lines, line)
+			line = entry
+		default:
+			line += separator + entry
+		}
+	}
+	if line != "" {
+		lines = append(lines, line)
+	}
+	return strings.Join(lines, "\n")
+}
+
+// layoutKeyHelpColumns lays rendered bindings out in columns of equal width,
+// filled row by row, as many as fit in width
+func layoutKeyHelpColumns(entries []string, width int) string {
+	const gap = 2
+	cellWidth := 0
+	for _, entry := range entries {
+		cellWidth = max(cellWidth, lipgloss.Width(entry))
+	}
+
+	columns := len(entries)
+	if width > 0 {
+		columns = max(1, min(columns, (width+gap)/(cellWidth+gap)))
+	}
+
+	var lines []string
+	for start := 0; start < len(entries); start += columns {
+		row := entries[start:min(start+columns, len(entries))]
+		var line strings.Builder
+		for i, entry := range row {
+			line.WriteString(entry)
+			if i < len(row)-1 {
+				line.WriteString(strings.Repeat(" ", cellWidth-lipgloss.Width(entry)+gap))
+			}
+		}
+		lines = append(lines, line.String())
+	}
+	return strings.Join(lines, "\n")
+}
@@ -0,0 +1,68 @@
+package core
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/muesli/termenv"
+)
+
+func TestRenderKeyHelp(t *testing.T) {
+	previousProfile := lipgloss.ColorProfile()
+	lipgloss.SetColorProfile(termenv.TrueColor)
+	defer lipgloss.SetColorProfile(previousProfile)
+
+	keyMap := NavigationKeyMap{
+		Up:        []string{"up", "k"},
+		Down:      []string{"down", "j"},
+		PageUp:    []string{"pgup"},
+		PageDown:  []string{"pgdown"},
+		Home:      []string{"home"},
+		End:       []string{"end"},
+		Select:    []string{"enter", " "},
+		SelectAll: []string{"ctrl+a"},
+		Filter:    []string{"/"},
+		Sort:      []string{"s"},
+		Quit:      []string{"q"},
+	}
+	opts := HelpOptions{NoColor: true}
+
+	help := StripANSI(RenderKeyHelp(keyMap, opts))
+	if strings.Contains(help, "\n") || !strings.Contains(help, "↑/k up • ↓/j down") || !strings.Contains(help, "enter/space select") {
+		t.Errorf("Expected the compact help on one line, got %q", help)
+	}
+
+	// A narrow width wraps between bindings
+	opts.Width = 30
+	wrapped := StripANSI(RenderKeyHelp(keyMap, opts))
+	lines := strings.Split(wrapped, "\n")
+	if len(lines) < 2 {
+		t.Fatalf("Expected the help wrapped on several lines, got %q", wrapped)
+	}
+	for _, line := range lines {
+		if lipgloss.Width(line) > 30 {
+			t.Errorf("Expected lines within 30 cells, got %q", line)
+		}
+	}
+
+	// The full listing titles its categories
+	opts.Full = true
+	full := StripANSI(RenderKeyHelp(keyMap, opts))
+	for _, title := range []string{"Navigation:", "Selection:", "Data:", "General:"} {
+		if !strings.Contains(full, title) {
+			t.Errorf("Expected %q in the full help:\n%s", title, full)
+		}
+	}
+
+	// Remapped keys show their new bindings, and the active one is bold
+	keyMap.Up = []string{"w"}
+	keyMap.Quit = []string{"ctrl+c"}
+	remapped := RenderKeyHelp(keyMap, HelpOptions{NoColor: true, ActiveKey: "w"})
+	if plain := StripANSI(remapped); !strings.Contains(plain, "w up") || strings.Contains(plain, "k up") || !strings.Contains(plain, "ctrl+c quit") {
+		t.Errorf("Expected the remapped bindings, got %q", plain)
+	}
+	if bold := lipgloss.NewStyle().Bold(true).Render("w up"); !strings.Contains(remapped, bold) {
+		t.Errorf("Expected the active binding highlighted, got %q", remapped)
+	}
+}
@@ -0,0 +1,30 @@
+package core
+
+import "github.com/charmbracelet/lipgloss"
+
+// DefaultSpinnerFrames are the frames SpinnerLoadingPlaceholder cycles through
+// when none are given.
+var DefaultSpinnerFrames = []string{"⠋", "⠙", "⠹", "⠸", "⠼", "⠴", "⠦", "⠧", "⠇", "⠏"}
+
+// loadingPlaceholderStyle dims loading placeholders.
+var loadingPlaceholderStyle = lipgloss.NewStyle().Faint(true)
+
+// DefaultLoadingPlaceholder renders a dimmed "Loading…" line. It is the
+// ViewportConfig.LoadingPlaceholder of lists when none is set.
+func DefaultLoadingPlaceholder(index int, ctx RenderContext) string {
+	return loadingPlaceholderStyle.Render("Loading…")
+}
+
+// SpinnerLoadingPlaceholder returns a ViewportConfig.LoadingPlaceholder
+// rendering a dimmed spinner before "Loading…". The spinner shows the frame
+// for RenderContext.LoadingFrame, so it only turns when
+// ViewportConfig.LoadingAnimationInterval is set. It uses DefaultSpinnerFrames
+// when no frames are given.
+func SpinnerLoadingPlaceholder(frames ...string) func(index int, ctx RenderContext) string {
+	if len(frames) == 0 {
+		frames = DefaultSpinnerFrames
+	}
+	return func(index int, ctx RenderContext) string {
+		return loadingPlaceholderStyle.Render(frames[ctx.LoadingFrame%len(frames)] + " Loading…")
+	}
+}
@@ -0,0 +1,29 @@
+package core
+
+import (
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+)
+
+// MergeStyle renders styled content with style underneath: the style's
+// sequence opens the content and follows every reset in it, so text the
+// content leaves unstyled, or styles only in part, still shows the style
+func MergeStyle(content string, style lipgloss.Style) string {
+	const marker = "\x00"
+	colors := lipgloss.NewStyle().
+		Foreground(style.GetForeground()).
+		Background(style.GetBackground()).
+		Bold(style.GetBold()).
+		Italic(style.GetItalic()).
+		Underline(style.GetUnderline()).
+		Faint(style.GetFaint()).
+		Reverse(style.GetReverse())
+	prefix, _, _ := strings.Cut(colors.Render(marker), marker)
+	if prefix == "" {
+		return content
+	}
+
+	replacer := strings.NewReplacer("\x1b[0m", "\x1b[0m"+prefix, "\x1b[m", "\x1b[m"+prefix)
+	return prefix + replacer.Replace(content) + "\x1b[0m"
+}
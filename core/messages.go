@@ -46,6 +46,29 @@ type JumpToMsg struct {
 	Index int
 }
 
+// JumpToPercentMsg is a message sent to move the cursor to a fraction of the
+// dataset, from 0.0 for the first item to 1.0 for the last. Components answer
+// it with a JumpToMsg for the matching index.
+type JumpToPercentMsg struct {
+	Percent float64
+}
+
+// SetMarkMsg is a message sent to record the row under the cursor as a mark.
+type SetMarkMsg struct {
+	Name rune
+}
+
+// JumpToMarkMsg is a message sent to move the cursor to a marked row.
+type JumpToMarkMsg struct {
+	Name rune
+}
+
+// MarkUnavailableMsg is emitted when jumping to a mark that was never set or
+// whose row is no longer in the data, for example because it is filtered out.
+type MarkUnavailableMsg struct {
+	Name rune
+}
+
 // TreeJumpToIndexMsg is a message sent to move the cursor to a specific index
 // in a tree component, with an option to expand parent nodes to make the target visible.
 type TreeJumpToIndexMsg struct {
@@ -53,10 +76,54 @@ type TreeJumpToIndexMsg struct {
 	ExpandParents bool // If true, expand all parent nodes to make the target item visible
 }
 
+// TreeExpandAllMsg is a message sent to expand every node of a tree.
+type TreeExpandAllMsg struct{}
+
+// TreeCollapseAllMsg is a message sent to collapse every node of a tree.
+type TreeCollapseAllMsg struct{}
+
+// TreeExpandToDepthMsg is a message sent to expand a tree so that nodes down to
+// Depth are visible, collapsing the nodes below. Root nodes are at depth 0.
+type TreeExpandToDepthMsg struct {
+	Depth int
+}
+
+// TreeStructureChangedMsg is a message sent by a tree after a bulk expansion
+// change, reporting the number of nodes now visible.
+type TreeStructureChangedMsg struct {
+	TotalVisible int
+}
+
+// TreeChildrenLoadedMsg is a message sent by a lazy tree data source when the
+// children of a node have been loaded. Children holds a []tree.TreeData[T]
+// matching the tree's item type; core cannot name that type without importing
+// the tree package. A non-nil Err leaves the node's children unknown.
+type TreeChildrenLoadedMsg struct {
+	ParentID string
+	Children any
+	Err      error
+}
+
 // DataRefreshMsg is a message sent to trigger a full refresh of the component's
 // data, including reloading the total count and all visible chunks.
 type DataRefreshMsg struct{}
 
+// DataRequestSetMsg is a message to apply a data request wholesale: its sort
+// fields, sort directions and filters replace the component's current ones, and
+// its Start becomes the cursor position once the data is reloaded.
+type DataRequestSetMsg struct {
+	Request DataRequest
+}
+
+// StateRestoreMsg is a message to restore a view state saved with SaveState.
+type StateRestoreMsg struct {
+	State PersistedState
+}
+
+// RefreshFlushMsg applies total updates and refreshes deferred by
+// ViewportConfig.MinRefreshInterval.
+type RefreshFlushMsg struct{}
+
 // DataChunksRefreshMsg is a message sent to refresh only the currently loaded
 // data chunks, preserving the cursor position. This is useful for reflecting
 // state changes (like selection) without a full reload.
@@ -78,6 +145,33 @@ type DataChunkErrorMsg struct {
 	Request    DataRequest
 }
 
+// RetryItemMsg is a message asking a component to reload the item with the
+// given ID through a RetryableDataSource.
+type RetryItemMsg struct {
+	ID string
+}
+
+// RetryAllErroredMsg is a message asking a component to retry every loaded
+// item that has an error.
+type RetryAllErroredMsg struct{}
+
+// ItemRetryResultMsg is sent by a RetryableDataSource once an item has been
+// reloaded. On success Item holds the reloaded item, which replaces the one in
+// the loaded chunks; otherwise Err tells why the retry failed.
+type ItemRetryResultMsg struct {
+	ID   string
+	Item Data[any]
+	Err  error
+}
+
+// IndexOfIDMsg is sent by an IndexableDataSource with the index of the row
+// with the given ID, or Found false when the row is no longer in the data.
+type IndexOfIDMsg struct {
+	ID    string
+	Index int
+	Found bool
+}
+
 // DataTotalMsg is a message sent by a DataSource containing the total number of
 // items in the dataset.
 type DataTotalMsg struct {
@@ -90,12 +184,38 @@ type DataTotalUpdateMsg struct {
 	Total int
 }
 
+// TotalChangedMsg reports that a refresh of the total, such as one requested
+// with RefreshTotalPreservingPosition, changed it from Old to New.
+type TotalChangedMsg struct {
+	Old int
+	New int
+}
+
 // DataLoadErrorMsg is a message indicating a general error occurred during
 // data loading, not specific to a single chunk.
 type DataLoadErrorMsg struct {
 	Error error
 }
 
+// FormatterPanicMsg is emitted with TableConfig.RecoverFormatterPanics the
+// first time the cell formatter of a column panics. RowID is the row being
+// rendered and Recovered the value the formatter panicked with.
+type FormatterPanicMsg struct {
+	Column    int
+	RowID     string
+	Recovered any
+}
+
+// DataShapeWarningMsg is emitted with TableConfig.WarnDataShape when a loaded
+// data row has Cells cells for a table with Columns columns. Index is the
+// row's absolute index.
+type DataShapeWarningMsg struct {
+	Index   int
+	RowID   string
+	Cells   int
+	Columns int
+}
+
 // DataSourceSetMsg is a message sent to replace the component's current
 // DataSource with a new one.
 type DataSourceSetMsg struct {
@@ -115,6 +235,23 @@ type ChunkLoadingStartedMsg struct {
 	Request    DataRequest
 }
 
+// AggregatesComputedMsg carries the column aggregates a table computed over
+// its whole filtered dataset for its footer row. Cells are in data source
+// order, empty for columns without an Aggregate. Error is set when the data
+// could not be scanned.
+type AggregatesComputedMsg struct {
+	Cells []string
+	Error error
+}
+
+// RowTransitionTickMsg advances the row change transition shown with
+// TableConfig.AnimateChanges by one frame.
+type RowTransitionTickMsg struct{}
+
+// LoadingTickMsg is sent at ViewportConfig.LoadingAnimationInterval while
+// chunks are loading, to advance animated loading placeholders.
+type LoadingTickMsg struct{}
+
 // ChunkLoadingCompletedMsg is a message indicating that a data chunk has
 // finished loading, successfully or not.
 type ChunkLoadingCompletedMsg struct {
@@ -143,12 +280,29 @@ type SelectAllMsg struct{}
 // SelectClearMsg is a message to clear all current selections.
 type SelectClearMsg struct{}
 
+// SelectAllToggleMsg is a message to select all items, or clear the selection
+// if every item is already selected.
+type SelectAllToggleMsg struct{}
+
 // SelectRangeMsg is a message to select a range of items between two item IDs.
 type SelectRangeMsg struct {
 	StartID string
 	EndID   string
 }
 
+// SelectionAnchorSetMsg is a message to start a range selection anchored at
+// the item under the cursor. Until it is committed or cleared, moving the
+// cursor selects every item from the anchor to the cursor.
+type SelectionAnchorSetMsg struct{}
+
+// SelectionAnchorCommitMsg is a message to end a range selection, keeping the
+// selected range.
+type SelectionAnchorCommitMsg struct{}
+
+// SelectionAnchorClearMsg is a message to end a range selection, deselecting
+// the range.
+type SelectionAnchorClearMsg struct{}
+
 // SelectionModeSetMsg is a message to change the component's selection mode
 // (e.g., single, multiple, none).
 type SelectionModeSetMsg struct {
@@ -167,14 +321,36 @@ type SelectionResponseMsg struct {
 	AffectedIDs []string // For operations that affect multiple items
 }
 
+// SelectionEvictedMsg is sent when a table deselects the least recently
+// selected row to stay within TableConfig.MaxSelections.
+type SelectionEvictedMsg struct {
+	ID string
+}
+
 // SelectionChangedMsg is a message indicating that the selection state has
-// changed within the data source.
+// changed within the data source. Lists emit it once per change of the number
+// of selected items, with only TotalSelected set.
 type SelectionChangedMsg struct {
 	SelectedIndices []int
 	SelectedIDs     []string
 	TotalSelected   int
 }
 
+// CursorChangedMsg is emitted by lists when the cursor moves to another item.
+// ID is the ID of the item now under the cursor, empty if it is not loaded.
+type CursorChangedMsg struct {
+	From int
+	To   int
+	ID   string
+}
+
+// ItemActivatedMsg is emitted when an item is activated, such as by double
+// clicking it.
+type ItemActivatedMsg struct {
+	Index int
+	ID    string
+}
+
 // FilterSetMsg is a message to apply or update a filter on a specific data field.
 type FilterSetMsg struct {
 	Field string
@@ -189,6 +365,114 @@ type FilterClearMsg struct {
 // FiltersClearAllMsg is a message to remove all active filters.
 type FiltersClearAllMsg struct{}
 
+// FiltersFlushMsg is a message to apply debounced filter changes immediately.
+type FiltersFlushMsg struct{}
+
+// FuzzyFieldsSetMsg is a message to set the fields whose filters are matched
+// fuzzily, see DataRequest.FuzzyFields.
+type FuzzyFieldsSetMsg struct {
+	Fields []string
+}
+
+// OverflowStrategySetMsg is a message to change how a table fits columns that
+// are wider than the available width.
+type OverflowStrategySetMsg struct {
+	Strategy OverflowStrategy
+}
+
+// ResponsiveCardSetMsg is a message to enable or disable a table's card layout
+// for narrow widths.
+type ResponsiveCardSetMsg struct {
+	Enabled bool
+}
+
+// ColumnFilterEditMsg is a message to open the inline filter input of a table
+// column. Number and date columns accept range input parsed into a RangeFilter.
+type ColumnFilterEditMsg struct {
+	Column int
+}
+
+// CellEditStartMsg is a message to start editing the active cell of the row
+// under a table's cursor in place.
+type CellEditStartMsg struct{}
+
+// CellEditCommitMsg is emitted when an inline cell edit is committed with a
+// changed value, so the application or data source can persist it.
+type CellEditCommitMsg struct {
+	RowID    string
+	Field    string
+	OldValue string
+	NewValue string
+}
+
+// ColumnResizeModeMsg is a message to enter or leave a table's interactive
+// resize mode, where left and right shrink or grow the active column.
+type ColumnResizeModeMsg struct {
+	Enabled bool
+}
+
+// ColumnResizeMsg is a message to grow (positive Delta) or shrink (negative
+// Delta) a table column, clamped to its MinWidth and MaxWidth.
+type ColumnResizeMsg struct {
+	Index int
+	Delta int
+}
+
+// ColumnWidthSetMsg is a message to set a table column's width, clamped to its
+// MinWidth and MaxWidth.
+type ColumnWidthSetMsg struct {
+	Index int
+	Width int
+}
+
+// RowPinMsg is a message to pin the table row with the given ID above the
+// scrolling rows, or to unpin it.
+type RowPinMsg struct {
+	ID     string
+	Pinned bool
+}
+
+// ColumnPinMsg is a message to pin or unpin a table column.
+type ColumnPinMsg struct {
+	Index  int
+	Pinned bool
+}
+
+// ColumnVisibilityMsg is a message to show or hide the table column whose
+// Field is Field.
+type ColumnVisibilityMsg struct {
+	Field   string
+	Visible bool
+}
+
+// ColumnResizedMsg is sent after a table column's width changes.
+type ColumnResizedMsg struct {
+	Index int
+	Width int
+}
+
+// ColumnsAutoSizeMsg is a message to fit the width of every table column to
+// its header and a sample of its loaded cells.
+type ColumnsAutoSizeMsg struct{}
+
+// ColumnsAutoSizedMsg is sent after the table columns were auto-sized, with
+// the new width of each configured column.
+type ColumnsAutoSizedMsg struct {
+	Widths []int
+}
+
+// ColumnMoveMsg is a message to move the active table column by Delta
+// positions, negative to the left.
+type ColumnMoveMsg struct {
+	Delta int
+}
+
+// ColumnMovedMsg is sent after a table column moves from index From to To.
+type ColumnMovedMsg struct {
+	From int
+	To   int
+}
+
 // SortToggleMsg is a message to toggle the sort order of a field (e.g., asc ->
 // desc -> none).
 type SortToggleMsg struct {
@@ -215,12 +499,63 @@ type SortRemoveMsg struct {
 // SortsClearAllMsg is a message to clear all sorting configurations.
 type SortsClearAllMsg struct{}
 
+// CycleSortMsg is a message to advance the sort of a field to the next
+// direction in its column's SortCycle.
+type CycleSortMsg struct {
+	Field string
+}
+
+// SortChangedMsg is emitted after the sort configuration of a component has
+// changed through CycleSortMsg.
+type SortChangedMsg struct {
+	Fields     []string
+	Directions []string
+}
+
 // FocusMsg is a message to give focus to the component, making it active.
 type FocusMsg struct{}
 
 // BlurMsg is a message to remove focus from the component, making it inactive.
 type BlurMsg struct{}
 
+// BoundaryReachedMsg is emitted when a navigation action cannot move the cursor
+// because it is already at the start or end of the dataset, including when the
+// dataset is empty.
+type BoundaryReachedMsg struct {
+	AtStart bool
+}
+
+// NavigationWrappedMsg is emitted when the cursor wraps around the dataset with
+// ViewportConfig.WrapNavigation or WrapPageNavigation. Direction is "down" when
+// the cursor moved from the last item to the first and "up" the other way.
+type NavigationWrappedMsg struct {
+	Direction string
+}
+
+// SelectionRejectedMsg is emitted when selecting the item at Index is refused,
+// e.g. because it is disabled. Reason tells why.
+type SelectionRejectedMsg struct {
+	Index  int
+	Reason string
+}
+
+// RangeSelectionUpdatedMsg is emitted when a range selection changes. Anchor
+// and Cursor are the indices the range spans, in either order, and Count is
+// the number of items in it.
+type RangeSelectionUpdatedMsg struct {
+	Anchor int
+	Cursor int
+	Count  int
+}
+
+// FocusNextMsg is emitted by a component asking the application to move focus
+// to the next pane, e.g. when Tab is pressed with TabMoveFocus.
+type FocusNextMsg struct{}
+
+// FocusPrevMsg is emitted by a component asking the application to move focus
+// to the previous pane, e.g. when ShiftTab is pressed with TabMoveFocus.
+type FocusPrevMsg struct{}
+
 // GlobalAnimationTickMsg is a message sent periodically by the animation engine
 // to drive time-based animations.
 type GlobalAnimationTickMsg struct {
@@ -287,6 +622,18 @@ type ColumnUpdateMsg struct {
 	Column TableColumn
 }
 
+// ColumnTypesDetectMsg is a message to infer each table column's type from a
+// sample of loaded rows.
+type ColumnTypesDetectMsg struct {
+	SampleRows int // Maximum number of rows to inspect; 0 or less means all loaded rows
+}
+
+// ColumnTypesDetectedMsg is sent after column type detection with the type of
+// each column, in column order.
+type ColumnTypesDetectedMsg struct {
+	Types []ColumnType
+}
+
 // HeaderVisibilityMsg is a message to set the visibility of the table header.
 type HeaderVisibilityMsg struct {
 	Visible bool
@@ -349,6 +696,20 @@ type HeaderFormatterSetMsg struct {
 	Formatter   SimpleHeaderFormatter
 }
 
+// CellFormatterSetByFieldMsg is a message to set a custom cell formatter for
+// the table column showing Field. A nil Formatter removes it.
+type CellFormatterSetByFieldMsg struct {
+	Field     string
+	Formatter SimpleCellFormatter
+}
+
+// HeaderFormatterSetByFieldMsg is a message to set a custom header formatter
+// for the table column showing Field. A nil Formatter removes it.
+type HeaderFormatterSetByFieldMsg struct {
+	Field     string
+	Formatter SimpleHeaderFormatter
+}
+
 // LoadingFormatterSetMsg is a message to set a custom loading row formatter.
 //
 // Deprecated: Use RowFormatterSetMsg instead.
@@ -479,6 +840,35 @@ type StatusMsg struct {
 	Type    StatusType
 }
 
+// GroupBySetMsg is a message to set the fields a table groups its rows by.
+type GroupBySetMsg struct {
+	Fields []string
+}
+
+// GroupByFieldsSetMsg is a message to set the fields a table groups its rows
+// by itself, regardless of its data source.
+type GroupByFieldsSetMsg struct {
+	Fields []string
+}
+
+// GroupToggleMsg is a message to collapse or expand a group in a grouped table.
+type GroupToggleMsg struct {
+	Key string
+}
+
+// GroupToggledMsg is emitted when a group of a grouped table is collapsed or
+// expanded. Key is the group's key, "/"-separated for nested groups.
+type GroupToggledMsg struct {
+	Key       string
+	Collapsed bool
+}
+
+// StatusLineSetMsg is a message to set the text of a table's managed status
+// line. An empty text hides the status line.
+type StatusLineSetMsg struct {
+	Text string
+}
+
 // StatusType defines the category of a status message.
 type StatusType int
 
@@ -506,6 +896,107 @@ type SearchResultMsg struct {
 	Total   int
 }
 
+// SearchProgressMsg is emitted periodically by a full-source search started with
+// a table's SearchAllAsync, after each scanned chunk.
+type SearchProgressMsg struct {
+	// SearchID identifies the search the progress belongs to.
+	SearchID int
+	// Scanned is the number of items scanned so far.
+	Scanned int
+	// Total is the number of items being scanned.
+	Total int
+	// Matches is the number of matching items found so far.
+	Matches int
+}
+
+// SearchCompleteMsg is emitted when a full-source search finishes, is
+// cancelled, or fails to load a chunk.
+type SearchCompleteMsg struct {
+	// SearchID identifies the finished search.
+	SearchID int
+	// Query is the searched text.
+	Query string
+	// Indices are the absolute indices of matching items, in ascending order.
+	// A cancelled or failed search reports the matches found before it stopped.
+	Indices []int
+	// Cancelled is true if the search was stopped by its cancel function.
+	Cancelled bool
+	// Error is set if loading a chunk failed.
+	Error error
+}
+
+// MatchSearchProgressMsg is emitted after each chunk a table scans for a row
+// matching the predicate given to JumpToMatch, JumpToNextMatch or
+// JumpToPrevMatch, while no match has been found.
+type MatchSearchProgressMsg struct {
+	// SearchID identifies the scan the progress belongs to.
+	SearchID int
+	// Scanned is the number of rows scanned so far.
+	Scanned int
+	// Total is the number of rows the scan covers at most.
+	Total int
+}
+
+// MatchSearchCompleteMsg is emitted when a predicate scan finds a matching
+// row, which the table then jumps to, or runs out of rows.
+type MatchSearchCompleteMsg struct {
+	// SearchID identifies the finished scan.
+	SearchID int
+	// Index is the absolute index of the matching row, or -1.
+	Index int
+	// Found is true if a row matched.
+	Found bool
+	// Error is set if loading a chunk failed.
+	Error error
+}
+
+// SearchStateMsg is emitted by a table's incremental search whenever its query
+// or current match changes.
+type SearchStateMsg struct {
+	// Query is the text being searched for. It is empty when the search is
+	// cleared.
+	Query string
+	// MatchCount is the number of rows containing the query.
+	MatchCount int
+	// CurrentMatch is the 1-based position of the row under the cursor among
+	// the matches, or 0 when there are none.
+	CurrentMatch int
+}
+
+// ExportCSVMsg is a message to export a table's rows to a CSV file.
+type ExportCSVMsg struct {
+	Path           string
+	IncludeHeaders bool
+}
+
+// ExportCompletedMsg is emitted when a CSV export finishes or fails.
+type ExportCompletedMsg struct {
+	// Path is the file that was written.
+	Path string
+	// RowCount is the number of data rows written, excluding the header.
+	RowCount int
+	// Err is set if loading a chunk or writing the file failed.
+	Err error
+}
+
+// CopySelectionMsg is a message to copy the selected rows to the clipboard.
+type CopySelectionMsg struct {
+	Format CopyFormat
+}
+
+// CopyCompletedMsg is emitted when copying the selected rows finishes or fails.
+type CopyCompletedMsg struct {
+	// Count is the number of rows copied. It is 0 when nothing is selected, in
+	// which case the clipboard is left untouched.
+	Count int
+	// OSC52 is set when the system clipboard failed and the rows were sent to
+	// the terminal's clipboard with OSC 52 instead, which the terminal cannot
+	// confirm.
+	OSC52 bool
+	// Err is set if loading a chunk or writing to the clipboard failed.
+	Err error
+}
+
 // AccessibilityConfigMsg is a message to configure accessibility features.
 type AccessibilityConfigMsg struct {
 	ScreenReader  bool
@@ -556,6 +1047,28 @@ type ActiveCellBackgroundColorSetMsg struct {
 	Color string // lipgloss color value
 }
 
+// ActiveCellBorderStyleSetMsg is a message to set the outline style of the
+// active cell in a table.
+type ActiveCellBorderStyleSetMsg struct {
+	Style ActiveCellBorderStyle
+}
+
+// ActiveCellMoveMsg is a message to move the active cell of a table by DRow
+// rows and DCol columns, moving the cursor row along with it.
+type ActiveCellMoveMsg struct {
+	DRow int
+	DCol int
+}
+
+// ActiveCellChangedMsg is emitted when the active cell of a table moves. Row
+// is the absolute row index, Col the column index and Value the cell's text,
+// empty while its row is loading.
+type ActiveCellChangedMsg struct {
+	Row   int
+	Col   int
+	Value string
+}
+
 // SetFullRowSelectionMsg is a message to enable/disable full row selection background styling
 type SetFullRowSelectionMsg struct {
 	Enabled    bool
@@ -579,6 +1092,30 @@ type SetComponentBackgroundMsg struct {
 	ApplyNormal   bool
 }
 
+// ListBackgroundModeSetMsg is a message to turn on the background styling of
+// a list in the given mode.
+type ListBackgroundModeSetMsg struct {
+	Mode ListBackgroundMode
+}
+
+// ListCursorIndicatorSetMsg is a message to set the cursor indicator of a list.
+type ListCursorIndicatorSetMsg struct {
+	Indicator string
+}
+
+// ListEnumeratorAlignmentSetMsg is a message to set the alignment and width of
+// a list's enumerator.
+type ListEnumeratorAlignmentSetMsg struct {
+	Alignment ListEnumeratorAlignment
+	Width     int
+}
+
+// ListTextWrapEnableMsg is a message to enable or disable wrapping of a list's
+// item content.
+type ListTextWrapEnableMsg struct {
+	Enabled bool
+}
+
 // === HORIZONTAL SCROLLING MESSAGES ===
 
 // HorizontalScrollLeftMsg is a message sent to scroll horizontally left within the current column.
@@ -0,0 +1,114 @@
+package core
+
+import (
+	"cmp"
+	"slices"
+)
+
+// StableMultiSort sorts rows in place by the given fields, in order of
+// priority, each in its direction from dirs: "desc" sorts descending and
+// anything else, including a missing entry, ascending. Cells are looked up by
+// field through each row's Fields (see TableRow.CellByField); a row without
+// the field sorts as an empty cell, and fields no row has are ignored. A
+// field's comparator from comparators takes precedence; other fields are
+// compared as strings with CompareCells. StableMultiSortColumns resolves
+// fields through table columns instead.
+//
+// The sort is stable: rows equal on every field keep their relative order, so
+// a data source sorting its original rows gets them back in their original
+// order once the sort is removed. It runs in O(n log n).
+func StableMultiSort(rows []TableRow, fields, dirs []string, comparators map[string]func(a, b string) int) {
+	var keys []sortKey
+	for i, field := range fields {
+		named := slices.ContainsFunc(rows, func(row TableRow) bool {
+			_, ok := row.CellByField(field)
+			return ok
+		})
+		if !named {
+			continue
+		}
+		keys = append(keys, newSortKey(field, i, dirs, comparators, ColumnString, func(row TableRow) string {
+			cell, _ := row.CellByField(field)
+			return cell
+		}))
+	}
+	stableSortRows(rows, keys)
+}
+
+// StableMultiSortColumns sorts rows in place like StableMultiSort, matching
+// fields to cells through the Field of columns instead of the rows' Fields.
+// Fields matching no column are ignored, and fields without a comparator are
+// compared with CompareCells and the column's Type.
+func StableMultiSortColumns(rows []TableRow, columns []TableColumn, fields, dirs []string, comparators map[string]func(a, b string) int) {
+	var keys []sortKey
+	for i, field := range fields {
+		cell := slices.IndexFunc(columns, func(col TableColumn) bool { return col.Field == field })
+		if cell < 0 {
+			continue
+		}
+		keys = append(keys, newSortKey(field, i, dirs, comparators, columns[cell].Type, func(row TableRow) string {
+			if cell < len(row.Cells) {
+				return row.Cells[cell]
+			}
+			return ""
+		}))
+	}
+	stableSortRows(rows, keys)
+}
+
+// sortKey is a field rows are sorted by
+type sortKey struct {
+	value      func(row TableRow) string
+	descending bool
+	compare    func(a, b string) int
+}
+
+// newSortKey returns the key of the field at position i of the sort fields
+func newSortKey(field string, i int, dirs []string, comparators map[string]func(a, b string) int, columnType ColumnType, value func(row TableRow) string) sortKey {
+	key := sortKey{value: value, descending: i < len(dirs) && dirs[i] == "desc", compare: comparators[field]}
+	if key.compare == nil {
+		key.compare = func(a, b string) int { return CompareCells(a, b, columnType) }
+	}
+	return key
+}
+
+// stableSortRows sorts rows in place by keys, keeping the order of equal rows
+func stableSortRows(rows []TableRow, keys []sortKey) {
+	if len(keys) == 0 {
+		return
+	}
+
+	// Each row's key cells are looked up once
+	values := make([][]string, len(rows))
+	for i, row := range rows {
+		values[i] = make([]string, len(keys))
+		for k, key := range keys {
+			values[i][k] = key.value(row)
+		}
+	}
+
+	// Sorting positions with the original position as the last tie-breaker
+	// keeps the sort stable with an O(n log n) unstable sort
+	order := make([]int, len(rows))
+	for i := range order {
+		order[i] = i
+	}
+	slices.SortFunc(order, func(a, b int) int {
+		for k, key := range keys {
+			result := key.compare(values[a][k], values[b][k])
+			if key.descending {
+				result = -result
+			}
+			if result != 0 {
+				return result
+			}
+		}
+		return cmp.Compare(a, b)
+	})
+
+	sorted := make([]TableRow, len(rows))
+	for i, position := range order {
+		sorted[i] = rows[position]
+	}
+	copy(rows, sorted)
+}
@@ -0,0 +1,117 @@
+package core
+
+import (
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestStableMultiSortColumns(t *testing.T) {
+	columns := []TableColumn{
+		{Field: "name"},
+		{Field: "team"},
+		{Field: "score", Type: ColumnInt},
+	}
+	original := []TableRow{
+		{ID: "a", Cells: []string{"Ann", "red", "10"}},
+		{ID: "b", Cells: []string{"Bob", "blue", "9"}},
+		{ID: "c", Cells: []string{"Cid", "red", "10"}},
+		{ID: "d", Cells: []string{"Dee", "blue", "30"}},
+		{ID: "e", Cells: []string{"Eve", "red", "2"}},
+		{ID: "f", Cells: []string{"Fay", "blue", "9"}},
+	}
+	ids := func(rows []TableRow) string {
+		var result []string
+		for _, row := range rows {
+			result = append(result, row.ID)
+		}
+		return strings.Join(result, "")
+	}
+
+	rows := slices.Clone(original)
+	StableMultiSortColumns(rows, columns, []string{"team"}, nil, nil)
+	if got := ids(rows); got != "bdface" {
+		t.Errorf("Expected ties to keep their order, got %s", got)
+	}
+
+	// Scores sort numerically, descending, with the team breaking ties
+	StableMultiSortColumns(rows, columns, []string{"score", "team"}, []string{"desc", "asc"}, nil)
+	if got := ids(rows); got != "dacbfe" {
+		t.Errorf("Expected a numeric descending sort, got %s", got)
+	}
+
+	// A comparator overrides the column type
+	byLength := map[string]func(a, b string) int{"name": func(a, b string) int { return len(a) - len(b) }}
+	StableMultiSortColumns(rows, columns, []string{"name", "unknown"}, nil, byLength)
+	if got := ids(rows); got != "dacbfe" {
+		t.Errorf("Expected equal names to keep their order, got %s", got)
+	}
+
+	// Adding then removing a secondary sort returns to the primary order
+	rows = slices.Clone(original)
+	StableMultiSortColumns(rows, columns, []string{"team", "score"}, nil, nil)
+	if got := ids(rows); got != "bfdeac" {
+		t.Errorf("Expected team then score order, got %s", got)
+	}
+	rows = slices.Clone(original)
+	StableMultiSortColumns(rows, columns, []string{"team"}, nil, nil)
+	if got := ids(rows); got != "bdface" {
+		t.Errorf("Expected the team order back, got %s", got)
+	}
+	rows = slices.Clone(original)
+	StableMultiSortColumns(rows, columns, nil, nil, nil)
+	if got := ids(rows); got != ids(original) {
+		t.Errorf("Expected the original order without sort, got %s", got)
+	}
+	StableMultiSortColumns(rows, columns, []string{"unknown"}, nil, nil)
+	if got := ids(rows); got != ids(original) {
+		t.Errorf("Expected an unknown field to leave the order, got %s", got)
+	}
+}
+
+func TestStableMultiSort(t *testing.T) {
+	fields := []string{"name", "team", "score"}
+	original := []TableRow{
+		{ID: "a", Fields: fields, Cells: []string{"Ann", "red", "10"}},
+		{ID: "b", Fields: fields, Cells: []string{"Bob", "blue", "9"}},
+		{ID: "c", Fields: fields, Cells: []string{"Cid", "red", "10"}},
+		{ID: "d", Fields: fields, Cells: []string{"Dee", "blue", "30"}},
+		{ID: "e", Fields: fields, Cells: []string{"Eve", "red", "2"}},
+		{ID: "f", Fields: fields, Cells: []string{"Fay", "blue", "9"}},
+	}
+	ids := func(rows []TableRow) string {
+		var result []string
+		for _, row := range rows {
+			result = append(result, row.ID)
+		}
+		return strings.Join(result, "")
+	}
+	numeric := map[string]func(a, b string) int{
+		"score": func(a, b string) int { return CompareCells(a, b, ColumnInt) },
+	}
+
+	// Fields are looked up in the rows; ties keep their order
+	rows := slices.Clone(original)
+	StableMultiSort(rows, []string{"team"}, nil, nil)
+	if got := ids(rows); got != "bdface" {
+		t.Errorf("Expected ties to keep their order, got %s", got)
+	}
+
+	// Adding a secondary sort orders the ties, and removing it restores them
+	StableMultiSort(rows, []string{"team", "score"}, []string{"asc", "desc"}, numeric)
+	if got := ids(rows); got != "dbface" {
+		t.Errorf("Expected team then descending score order, got %s", got)
+	}
+	rows = slices.Clone(original)
+	StableMultiSort(rows, []string{"team"}, nil, nil)
+	if got := ids(rows); got != "bdface" {
+		t.Errorf("Expected the team order back, got %s", got)
+	}
+
+	// Fields no row names leave the order untouched
+	rows = slices.Clone(original)
+	StableMultiSort(rows, []string{"unknown"}, nil, nil)
+	if got := ids(rows); got != ids(original) {
+		t.Errorf("Expected an unknown field to leave the order, got %s", got)
+	}
+}
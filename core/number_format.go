@@ -0,0 +1,145 @@
+package core
+
+import (
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/mattn/go-runewidth"
+)
+
+// NumberFormatOptions configures NumberFormatter. The zero value renders
+// numbers rounded to integers and grouped by thousands, as in "1,234,567".
+type NumberFormatOptions struct {
+	// Decimals is the number of decimal places each number is rounded to. A
+	// negative value keeps the decimals of each cell, rounded to MaxDecimals
+	// when it is positive.
+	Decimals    int
+	MaxDecimals int
+
+	// ThousandsSeparator and DecimalSeparator default to "," and ".", so
+	// "." and "," give the European "1.234,5".
+	ThousandsSeparator string
+	DecimalSeparator   string
+	// GroupSizes are the sizes of the digit groups from the right, the last
+	// one repeating. It defaults to [3]; [3, 2] groups as in "12,34,567".
+	GroupSizes []int
+	// NoGrouping leaves the integer digits ungrouped.
+	NoGrouping bool
+
+	// Prefix and Suffix surround the number, after the sign, as in "-$12".
+	Prefix string
+	Suffix string
+
+	// MinIntegerDigits zero-pads the integer part to at least this many
+	// digits.
+	MinIntegerDigits int
+
+	// AlignDecimal lines decimal points up across rows: the fraction is padded
+	// with spaces to the decimal places, so numbers without a fraction or with
+	// fewer decimals take the same room, and the result is right-aligned in
+	// the column width whatever the column's Alignment. With a negative
+	// Decimals, set MaxDecimals for the room to reserve.
+	AlignDecimal bool
+}
+
+// NumberFormatter returns a cell formatter rendering numeric cell values with
+// thousands separators, fixed decimal places and an optional currency prefix
+// or suffix. Cells that are not numbers are rendered unchanged.
+func NumberFormatter(opts NumberFormatOptions) SimpleCellFormatter {
+	if opts.ThousandsSeparator == "" {
+		opts.ThousandsSeparator = ","
+	}
+	if opts.DecimalSeparator == "" {
+		opts.DecimalSeparator = "."
+	}
+	if len(opts.GroupSizes) == 0 {
+		opts.GroupSizes = []int{3}
+	}
+
+	return func(cellValue string, rowIndex int, column TableColumn, ctx RenderContext, isCursor, isSelected, isActiveCell bool) string {
+		value, err := strconv.ParseFloat(strings.TrimSpace(cellValue), 64)
+		if err != nil || math.IsNaN(value) || math.IsInf(value, 0) {
+			return cellValue
+		}
+
+		text := formatNumber(value, opts)
+		if opts.AlignDecimal {
+			text = strings.Repeat(" ", max(0, column.Width-runewidth.StringWidth(text))) + text
+		}
+		return text
+	}
+}
+
+// formatNumber renders value according to opts, whose separators and group
+// sizes are set
+func formatNumber(value float64, opts NumberFormatOptions) string {
+	var digits string
+	switch {
+	case opts.Decimals >= 0:
+		digits = strconv.FormatFloat(math.Abs(value), 'f', opts.Decimals, 64)
+	case opts.MaxDecimals > 0:
+		digits = strconv.FormatFloat(math.Abs(value), 'f', opts.MaxDecimals, 64)
+		if strings.Contains(digits, ".") {
+			digits = strings.TrimRight(strings.TrimRight(digits, "0"), ".")
+		}
+	default:
+		digits = strconv.FormatFloat(math.Abs(value), 'f', -1, 64)
+	}
+
+	integer, fraction, _ := strings.Cut(digits, ".")
+	// Rounding may leave nothing but zeros, which take no sign
+	negative := value < 0 && strings.Trim(integer+fraction, "0") != ""
+
+	if pad := opts.MinIntegerDigits - len(integer); pad > 0 {
+		integer = strings.Repeat("0", pad) + integer
+	}
+	if !opts.NoGrouping {
+		integer = groupDigits(integer, opts.ThousandsSeparator, opts.GroupSizes)
+	}
+
+	var builder strings.Builder
+	if negative {
+		builder.WriteString("-")
+	}
+	builder.WriteString(opts.Prefix)
+	builder.WriteString(integer)
+	if fraction != "" {
+		builder.WriteString(opts.DecimalSeparator)
+		builder.WriteString(fraction)
+	}
+	builder.WriteString(opts.Suffix)
+
+	if opts.AlignDecimal {
+		places := opts.Decimals
+		if places < 0 {
+			places = opts.MaxDecimals
+		}
+		missing := places - len(fraction)
+		if fraction == "" && places > 0 {
+			missing += runewidth.StringWidth(opts.DecimalSeparator)
+		}
+		builder.WriteString(strings.Repeat(" ", max(0, missing)))
+	}
+	return builder.String()
+}
+
+// groupDigits inserts separator between groups of digits counted from the
+// right, sized by sizes with the last size repeating
+func groupDigits(digits, separator string, sizes []int) string {
+	var groups []string
+	for i := 0; len(digits) > 0; i++ {
+		size := sizes[min(i, len(sizes)-1)]
+		if size <= 0 || size >= len(digits) {
+			groups = append(groups, digits)
+			break
+		}
+		groups = append(groups, digits[len(digits)-size:])
+		digits = digits[:len(digits)-size]
+	}
+
+	for i, j := 0, len(groups)-1; i < j; i, j = i+1, j-1 {
+		groups[i], groups[j] = groups[j], groups[i]
+	}
+	return strings.Join(groups, separator)
+}
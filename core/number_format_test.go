@@ -0,0 +1,56 @@
+package core
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestNumberFormatter(t *testing.T) {
+	column := TableColumn{Width: 14, Alignment: AlignRight}
+	format := func(opts NumberFormatOptions, value string) string {
+		return NumberFormatter(opts)(value, 0, column, RenderContext{}, false, false, false)
+	}
+
+	tests := []struct {
+		opts  NumberFormatOptions
+		value string
+		want  string
+	}{
+		{NumberFormatOptions{}, "1234567", "1,234,567"},
+		{NumberFormatOptions{}, "-1234567.6", "-1,234,568"},
+		{NumberFormatOptions{Decimals: 2, Prefix: "$"}, "-1234.5", "-$1,234.50"},
+		{NumberFormatOptions{Decimals: 1}, "-0.01", "0.0"},
+		{NumberFormatOptions{MinIntegerDigits: 5, NoGrouping: true}, "42", "00042"},
+		{NumberFormatOptions{MinIntegerDigits: 5}, "-42", "-00,042"},
+		{NumberFormatOptions{Decimals: 1, ThousandsSeparator: ".", DecimalSeparator: ",", Suffix: " €"}, "1234567.25", "1.234.567,2 €"},
+		{NumberFormatOptions{GroupSizes: []int{3, 2}}, "1234567", "12,34,567"},
+		{NumberFormatOptions{Decimals: -1}, "3.14159", "3.14159"},
+		{NumberFormatOptions{}, "n/a", "n/a"},
+	}
+	for _, tt := range tests {
+		if got := format(tt.opts, tt.value); got != tt.want {
+			t.Errorf("NumberFormatter(%+v)(%q) = %q, want %q", tt.opts, tt.value, got, tt.want)
+		}
+	}
+
+	// Decimal points line up across magnitudes and decimal counts
+	aligned := NumberFormatOptions{Decimals: -1, MaxDecimals: 3, AlignDecimal: true}
+	var points []int
+	for _, value := range []string{"1234567.5", "3", "-0.125", "42.25"} {
+		got := format(aligned, value)
+		if len(got) != column.Width {
+			t.Errorf("Expected %q to fill the column width, got %q", value, got)
+		}
+		point := strings.Index(got, ".")
+		if point < 0 {
+			point = column.Width - 4
+		}
+		points = append(points, point)
+	}
+	for _, point := range points {
+		if point != points[0] {
+			t.Errorf("Expected decimal points at the same position, got %v", points)
+			break
+		}
+	}
+}
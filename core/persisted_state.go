@@ -0,0 +1,96 @@
+package core
+
+// PersistedState is a snapshot of the view state of a table, list or tree,
+// taken with SaveState and applied with RestoreState so an application can
+// reopen where the user left off. It only holds plain values and marshals to
+// and from JSON with encoding/json.
+//
+// Each component fills in the fields it supports: tables all but
+// ExpandedNodes, lists the cursor, sorting, filters and selection, and trees
+// the cursor, expanded nodes and selection.
+type PersistedState struct {
+	// CursorID is the ID of the item under the cursor. When it is no longer in
+	// the data, the cursor falls back to CursorIndex, clamped to the items.
+	CursorID string
+	// CursorIndex is the absolute index of the cursor.
+	CursorIndex int
+
+	// ActiveColumn is the index of the focused table column.
+	ActiveColumn int
+	// ScrollOffsets maps table column indices to their horizontal scroll offset.
+	ScrollOffsets map[int]int
+	// ColumnWidths maps table column fields to their configured width.
+	ColumnWidths map[string]int
+
+	// SortFields and SortDirections are the sort criteria, in priority order.
+	SortFields     []string
+	SortDirections []string
+	// Filters maps fields to their filter, see PersistFilters.
+	Filters map[string]PersistedFilter
+
+	// ExpandedNodes lists the IDs of the expanded tree nodes.
+	ExpandedNodes []string
+	// SelectedIDs lists the IDs of the selected items.
+	SelectedIDs []string
+}
+
+// Persisted filter kinds, one per filter value type ApplyFilter supports.
+const (
+	PersistedFilterText  = "text"
+	PersistedFilterRegex = "regex"
+	PersistedFilterExact = "exact"
+	PersistedFilterRange = "range"
+)
+
+// PersistedFilter is the JSON-friendly form of a filter value. Kind tells
+// which filter type Value or Range describe.
+type PersistedFilter struct {
+	Kind  string
+	Value string
+	Range *RangeFilter
+}
+
+// PersistFilters converts filter values to their persisted form. Only the
+// filter value types ApplyFilter supports, other than nil, can be persisted;
+// filters of other types are left out.
+func PersistFilters(filters map[string]any) map[string]PersistedFilter {
+	if len(filters) == 0 {
+		return nil
+	}
+	persisted := make(map[string]PersistedFilter, len(filters))
+	for field, value := range filters {
+		switch f := value.(type) {
+		case string:
+			persisted[field] = PersistedFilter{Kind: PersistedFilterText, Value: f}
+		case RegexFilter:
+			persisted[field] = PersistedFilter{Kind: PersistedFilterRegex, Value: f.Pattern}
+		case ExactFilter:
+			persisted[field] = PersistedFilter{Kind: PersistedFilterExact, Value: f.Value}
+		case RangeFilter:
+			rangeFilter := f
+			persisted[field] = PersistedFilter{Kind: PersistedFilterRange, Range: &rangeFilter}
+		}
+	}
+	return persisted
+}
+
+// RestoreFilters converts persisted filters back to filter values, skipping
+// entries of an unknown kind.
+func RestoreFilters(persisted map[string]PersistedFilter) map[string]any {
+	filters := make(map[string]any, len(persisted))
+	for field, filter := range persisted {
+		switch filter.Kind {
+		case PersistedFilterText:
+			filters[field] = filter.Value
+		case PersistedFilterRegex:
+			filters[field] = RegexFilter{Pattern: filter.Value}
+		case PersistedFilterExact:
+			filters[field] = ExactFilter{Value: filter.Value}
+		case PersistedFilterRange:
+			if filter.Range != nil {
+				filters[field] = *filter.Range
+			}
+		}
+	}
+	return filters
+}
@@ -0,0 +1,119 @@
+package core
+
+import (
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/mattn/go-runewidth"
+)
+
+// ProgressThreshold colors a progress bar whose value is at least From.
+type ProgressThreshold struct {
+	From  float64
+	Color lipgloss.TerminalColor
+}
+
+// ProgressBarOptions configures ProgressBarFormatter. The zero value renders a
+// "████░░░░ 50%" bar over the full column width.
+type ProgressBarOptions struct {
+	// Filled and Empty are the runes of the done and remaining parts of the
+	// bar. They default to '█' and '░'.
+	Filled rune
+	Empty  rune
+	// HideLabel drops the numeric percentage after the bar.
+	HideLabel bool
+	// RightToLeft fills the bar from its right end.
+	RightToLeft bool
+	// Thresholds color the filled part with the color of the highest From not
+	// above the value, so ascending thresholds grade the bar as it fills. The
+	// bar is uncolored when no threshold applies.
+	Thresholds []ProgressThreshold
+}
+
+// ProgressBarFormatter returns a cell formatter rendering 0–100 cell values as
+// a bar proportional to the value, followed by the value as a percentage. The
+// bar and label together occupy exactly the column width, measured in terminal
+// cells, so wide runes are supported. Values are clamped to 0–100 and may end
+// with '%'; cells that are not numbers are rendered unchanged.
+func ProgressBarFormatter(opts ProgressBarOptions) SimpleCellFormatter {
+	filled, empty := opts.Filled, opts.Empty
+	if filled == 0 {
+		filled = '█'
+	}
+	if empty == 0 {
+		empty = '░'
+	}
+
+	return func(cellValue string, rowIndex int, column TableColumn, ctx RenderContext, isCursor, isSelected, isActiveCell bool) string {
+		value, err := strconv.ParseFloat(strings.TrimSuffix(strings.TrimSpace(cellValue), "%"), 64)
+		if err != nil || math.IsNaN(value) {
+			return cellValue
+		}
+		value = math.Max(0, math.Min(100, value))
+
+		width := column.Width
+		label := ""
+		if !opts.HideLabel {
+			label = strconv.Itoa(int(math.Round(value))) + "%"
+			// The label goes when it would leave no room for the bar
+			if runewidth.StringWidth(label)+2 > width {
+				label = ""
+			} else {
+				width -= runewidth.StringWidth(label) + 1
+			}
+		}
+
+		text := renderProgressBar(value, width, filled, empty, opts)
+		if label != "" {
+			text += " " + label
+		}
+		// Wide runes may leave a remainder, padded last so the label stays
+		// next to the bar
+		return text + strings.Repeat(" ", max(0, column.Width-lipgloss.Width(text)))
+	}
+}
+
+// renderProgressBar renders a bar of at most width terminal cells, as many as
+// fit whole runes of the widest of filled and empty
+func renderProgressBar(value float64, width int, filled, empty rune, opts ProgressBarOptions) string {
+	if width <= 0 {
+		return ""
+	}
+
+	runeWidth := max(runewidth.RuneWidth(filled), runewidth.RuneWidth(empty), 1)
+	slots := width / runeWidth
+	done := int(math.Round(value / 100 * float64(slots)))
+
+	// The narrower rune is padded so both advance by the same width
+	doneText := strings.Repeat(progressSlot(filled, runeWidth), done)
+	if color, ok := progressColor(value, opts.Thresholds); ok && done > 0 {
+		doneText = lipgloss.NewStyle().Foreground(color).Render(doneText)
+	}
+	restText := strings.Repeat(progressSlot(empty, runeWidth), slots-done)
+
+	if opts.RightToLeft {
+		return restText + doneText
+	}
+	return doneText + restText
+}
+
+// progressSlot pads r with spaces to width terminal cells
+func progressSlot(r rune, width int) string {
+	return string(r) + strings.Repeat(" ", max(0, width-runewidth.RuneWidth(r)))
+}
+
+// progressColor returns the color of the highest threshold not above value
+func progressColor(value float64, thresholds []ProgressThreshold) (lipgloss.TerminalColor, bool) {
+	var best *ProgressThreshold
+	for i := range thresholds {
+		if value >= thresholds[i].From && (best == nil || thresholds[i].From >= best.From) {
+			best = &thresholds[i]
+		}
+	}
+	if best == nil {
+		return nil, false
+	}
+	return best.Color, true
+}
@@ -0,0 +1,203 @@
+// Package core provides the fundamental types, interfaces, and messages for the
+// vtable library. It defines the shared data structures and contracts used by
+// different components like List and Table, ensuring a consistent and
+// interoperable architecture. This package is the foundation upon which all other
+// vtable modules are built.
+package core
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// RangeFilter is a filter value for number and date columns that matches cells
+// within optional lower and upper bounds. Table column filter inputs place it in
+// DataRequest.Filters so a DataSource can apply it with Matches. An empty Min or
+// Max leaves that side unbounded.
+type RangeFilter struct {
+	// Type is the column type the bounds are compared as, ColumnInt,
+	// ColumnFloat or ColumnDate.
+	Type ColumnType
+	// Min is the lower bound, a number or an RFC 3339 timestamp.
+	Min string
+	// MinInclusive includes cells equal to Min.
+	MinInclusive bool
+	// Max is the upper bound, a number or an RFC 3339 timestamp.
+	Max string
+	// MaxInclusive includes cells equal to Max.
+	MaxInclusive bool
+}
+
+// Matches reports whether a cell value falls within the range. Cells that do not
+// parse as the filter's type never match.
+func (f RangeFilter) Matches(value string) bool {
+	if f.Min != "" {
+		cmp, ok := compareRangeBound(value, f.Min, f.Type)
+		if !ok || cmp < 0 || (cmp == 0 && !f.MinInclusive) {
+			return false
+		}
+	}
+	if f.Max != "" {
+		cmp, ok := compareRangeBound(value, f.Max, f.Type)
+		if !ok || cmp > 0 || (cmp == 0 && !f.MaxInclusive) {
+			return false
+		}
+	}
+	return true
+}
+
+// ParseRangeFilter parses range input typed by a user for a number or date
+// column. Supported forms are comparisons (">100", ">=100", "<5", "<=5"),
+// ranges with either end optional ("10..20", "2020-01..2020-12", "..5") and
+// single values ("42", "=42"). Dates may be partial: "2020" covers the whole
+// year and "2020-01" the whole month, so "2020-01..2020-12" includes December.
+func ParseRangeFilter(input string, columnType ColumnType) (RangeFilter, error) {
+	filter := RangeFilter{Type: columnType}
+	input = strings.TrimSpace(input)
+
+	if columnType != ColumnInt && columnType != ColumnFloat && columnType != ColumnDate {
+		return filter, fmt.Errorf("range filters require a number or date column, got %s", columnType)
+	}
+	if input == "" {
+		return filter, fmt.Errorf("empty range")
+	}
+
+	if lo, hi, isRange := strings.Cut(input, ".."); isRange {
+		lo, hi = strings.TrimSpace(lo), strings.TrimSpace(hi)
+		if lo == "" && hi == "" {
+			return filter, fmt.Errorf("range %q has no bounds", input)
+		}
+		if lo != "" {
+			start, _, err := parseRangeBound(lo, columnType)
+			if err != nil {
+				return filter, err
+			}
+			filter.Min, filter.MinInclusive = start, true
+		}
+		if hi != "" {
+			start, end, err := parseRangeBound(hi, columnType)
+			if err != nil {
+				return filter, err
+			}
+			filter.Max, filter.MaxInclusive = upperBound(start, end)
+		}
+		return filter, nil
+	}
+
+	for _, op := range []string{">=", "<=", ">", "<", "="} {
+		if !strings.HasPrefix(input, op) {
+			continue
+		}
+		start, end, err := parseRangeBound(strings.TrimSpace(input[len(op):]), columnType)
+		if err != nil {
+			return filter, err
+		}
+		switch op {
+		case ">=":
+			filter.Min, filter.MinInclusive = start, true
+		case ">":
+			// A partial date is exceeded only once its whole period has passed
+			if end != "" {
+				filter.Min, filter.MinInclusive = end, true
+			} else {
+				filter.Min = start
+			}
+		case "<=":
+			filter.Max, filter.MaxInclusive = upperBound(start, end)
+		case "<":
+			filter.Max = start
+		case "=":
+			filter.Min, filter.MinInclusive = start, true
+			filter.Max, filter.MaxInclusive = upperBound(start, end)
+		}
+		return filter, nil
+	}
+
+	start, end, err := parseRangeBound(input, columnType)
+	if err != nil {
+		return filter, err
+	}
+	filter.Min, filter.MinInclusive = start, true
+	filter.Max, filter.MaxInclusive = upperBound(start, end)
+	return filter, nil
+}
+
+// partialDateLayouts are the coarse date layouts accepted in range input in
+// addition to DateLayouts, with the period each one spans.
+var partialDateLayouts = []struct {
+	layout string
+	years  int
+	months int
+}{
+	{"2006-01", 0, 1},
+	{"2006", 1, 0},
+}
+
+// parseRangeBound validates a single bound and returns it normalized. For
+// partial and day-only dates it also returns the exclusive end of the period
+// the bound covers.
+func parseRangeBound(value string, columnType ColumnType) (start, end string, err error) {
+	if value == "" {
+		return "", "", fmt.Errorf("missing bound")
+	}
+
+	if columnType != ColumnDate {
+		if _, parseErr := strconv.ParseFloat(value, 64); parseErr != nil {
+			return "", "", fmt.Errorf("%q is not a number", value)
+		}
+		return value, "", nil
+	}
+
+	for _, partial := range partialDateLayouts {
+		if t, parseErr := time.Parse(partial.layout, value); parseErr == nil {
+			return t.Format(time.RFC3339), t.AddDate(partial.years, partial.months, 0).Format(time.RFC3339), nil
+		}
+	}
+	if t, parseErr := time.Parse("2006-01-02", value); parseErr == nil {
+		return t.Format(time.RFC3339), t.AddDate(0, 0, 1).Format(time.RFC3339), nil
+	}
+	if t, ok := ParseCellDate(value); ok {
+		return t.Format(time.RFC3339), "", nil
+	}
+	return "", "", fmt.Errorf("%q is not a date", value)
+}
+
+// upperBound returns the upper bound for a value that should be included: the
+// exclusive end of its period when it has one, otherwise the value itself.
+func upperBound(start, end string) (string, bool) {
+	if end != "" {
+		return end, false
+	}
+	return start, true
+}
+
+// compareRangeBound compares a cell value to a bound according to the column
+// type, reporting false if either side does not parse.
+func compareRangeBound(value, bound string, columnType ColumnType) (int, bool) {
+	if columnType == ColumnDate {
+		valueDate, ok := ParseCellDate(value)
+		boundDate, boundOk := ParseCellDate(bound)
+		if !ok || !boundOk {
+			return 0, false
+		}
+		return valueDate.Compare(boundDate), true
+	}
+
+	valueNum, err := strconv.ParseFloat(strings.TrimSpace(value), 64)
+	if err != nil {
+		return 0, false
+	}
+	boundNum, err := strconv.ParseFloat(bound, 64)
+	if err != nil {
+		return 0, false
+	}
+	switch {
+	case valueNum < boundNum:
+		return -1, true
+	case valueNum > boundNum:
+		return 1, true
+	}
+	return 0, true
+}
@@ -0,0 +1,45 @@
+package core
+
+import "testing"
+
+func TestParseRangeFilter(t *testing.T) {
+	tests := []struct {
+		input   string
+		colType ColumnType
+		match   []string
+		reject  []string
+		invalid bool
+	}{
+		{input: ">100", colType: ColumnInt, match: []string{"101", "250"}, reject: []string{"100", "5", "abc"}},
+		{input: "<=5", colType: ColumnFloat, match: []string{"5", "-1.5"}, reject: []string{"5.01"}},
+		{input: "10..20", colType: ColumnInt, match: []string{"10", "15", "20"}, reject: []string{"9", "21"}},
+		{input: "2020-01..2020-12", colType: ColumnDate, match: []string{"2020-01-01", "2020-12-31"}, reject: []string{"2019-12-31", "2021-01-01"}},
+		{input: "2021", colType: ColumnDate, match: []string{"2021-06-15"}, reject: []string{"2022-01-01"}},
+		{input: ">x", colType: ColumnInt, invalid: true},
+		{input: "1..2", colType: ColumnString, invalid: true},
+	}
+
+	for _, tt := range tests {
+		filter, err := ParseRangeFilter(tt.input, tt.colType)
+		if tt.invalid {
+			if err == nil {
+				t.Errorf("%q: expected an error", tt.input)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%q: unexpected error %v", tt.input, err)
+			continue
+		}
+		for _, v := range tt.match {
+			if !filter.Matches(v) {
+				t.Errorf("%q: expected %q to match", tt.input, v)
+			}
+		}
+		for _, v := range tt.reject {
+			if filter.Matches(v) {
+				t.Errorf("%q: expected %q not to match", tt.input, v)
+			}
+		}
+	}
+}
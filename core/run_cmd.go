@@ -0,0 +1,61 @@
+package core
+
+import (
+	"reflect"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// timerCmdCode holds the code pointers of the commands returned by tea.Tick
+// and tea.Every. Every command they return is the same closure, so a command
+// sharing its code pointer is waiting on a timer.
+var timerCmdCode = map[uintptr]bool{
+	reflect.ValueOf(tea.Tick(0, nil)).Pointer():            true,
+	reflect.ValueOf(tea.Every(time.Second, nil)).Pointer(): true,
+}
+
+// IsTimerCmd reports whether a command waits on a timer before producing its
+// message, that is whether it was built with tea.Tick or tea.Every, like the
+// animation, transition and debounce commands of this package.
+func IsTimerCmd(cmd tea.Cmd) bool {
+	return cmd != nil && timerCmdCode[reflect.ValueOf(cmd).Pointer()]
+}
+
+// RunCmd runs a command synchronously, outside of a Bubble Tea program, and
+// returns the messages it produces in order. Batches and sequences are
+// unwrapped, the commands of a sequence keeping their order. Timer commands
+// (see IsTimerCmd) are skipped rather than waited on, so code driving a
+// component by hand never blocks on a loading animation or a debounce.
+func RunCmd(cmd tea.Cmd) []tea.Msg {
+	if cmd == nil || IsTimerCmd(cmd) {
+		return nil
+	}
+
+	msg := cmd()
+	if msg == nil {
+		return nil
+	}
+	if batch, ok := msg.(tea.BatchMsg); ok {
+		return runCmds(batch)
+	}
+
+	// tea.Sequence wraps its commands in an unexported slice type
+	if value := reflect.ValueOf(msg); value.Kind() == reflect.Slice && value.Type().Elem() == reflect.TypeOf(tea.Cmd(nil)) {
+		cmds := make([]tea.Cmd, value.Len())
+		for i := range cmds {
+			cmds[i] = value.Index(i).Interface().(tea.Cmd)
+		}
+		return runCmds(cmds)
+	}
+	return []tea.Msg{msg}
+}
+
+// runCmds runs commands in turn and returns their messages
+func runCmds(cmds []tea.Cmd) []tea.Msg {
+	var msgs []tea.Msg
+	for _, cmd := range cmds {
+		msgs = append(msgs, RunCmd(cmd)...)
+	}
+	return msgs
+}
@@ -0,0 +1,51 @@
+package core
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func TestRunCmd(t *testing.T) {
+	msg := func(value int) tea.Cmd {
+		return func() tea.Msg { return value }
+	}
+
+	tests := []struct {
+		name     string
+		cmd      tea.Cmd
+		expected []tea.Msg
+	}{
+		{"nil", nil, nil},
+		{"single", msg(1), []tea.Msg{1}},
+		{"batch", tea.Batch(msg(1), nil, msg(2)), []tea.Msg{1, 2}},
+		{"sequence keeps its order", tea.Sequence(msg(1), tea.Batch(msg(2), msg(3)), msg(4)), []tea.Msg{1, 2, 3, 4}},
+		{"tick skipped", tea.Batch(tea.Tick(time.Hour, func(time.Time) tea.Msg { return 0 }), msg(1)), []tea.Msg{1}},
+		{"every skipped", tea.Sequence(tea.Every(time.Hour, func(time.Time) tea.Msg { return 0 }), msg(1)), []tea.Msg{1}},
+		{"delay skipped", tea.Batch(DelayCmd(time.Hour, 0), LoadingTickCmd(time.Hour), msg(1)), []tea.Msg{1}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			start := time.Now()
+			got := RunCmd(tt.cmd)
+			if elapsed := time.Since(start); elapsed > time.Second {
+				t.Fatalf("Expected timer commands to be skipped, waited %v", elapsed)
+			}
+			if !reflect.DeepEqual(got, tt.expected) {
+				t.Errorf("Expected %v, got %v", tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestIsTimerCmd(t *testing.T) {
+	if !IsTimerCmd(GlobalAnimationTickCmd()) || !IsTimerCmd(RowTransitionTickCmd(time.Second)) {
+		t.Error("Expected the tick commands to be timer commands")
+	}
+	if IsTimerCmd(CursorDownCmd()) || IsTimerCmd(nil) {
+		t.Error("Expected immediate commands not to be timer commands")
+	}
+}
@@ -0,0 +1,127 @@
+package core
+
+import (
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/mattn/go-runewidth"
+)
+
+// sparklineBlocks are the eight levels a sparkline point is drawn with
+var sparklineBlocks = []rune("▁▂▃▄▅▆▇█")
+
+// SparklineOptions configures SparklineFormatter. The zero value parses
+// comma-separated numbers and scales them between their minimum and maximum.
+type SparklineOptions struct {
+	// Separator splits the cell into numbers. It defaults to ",".
+	Separator string
+
+	// FixedRange scales the points between Min and Max instead of the minimum
+	// and maximum of each cell, so rows can be compared. Points outside the
+	// range are clamped.
+	FixedRange bool
+	Min        float64
+	Max        float64
+
+	// ShowLatest appends the last value of the cell, as written, after the
+	// sparkline.
+	ShowLatest bool
+
+	// UpColor and DownColor color the sparkline when the last value is above
+	// or below the one before it. They default to green and red; NoColor
+	// leaves the sparkline uncolored.
+	UpColor   string
+	DownColor string
+	NoColor   bool
+}
+
+// SparklineFormatter returns a cell formatter drawing a cell holding a list of
+// numbers, such as "3,5,2,8", as a sparkline of block characters filling the
+// column width. With fewer numbers than the width the sparkline is padded on
+// the left, so the latest value always sits at the right edge; with more, the
+// numbers are averaged down to the width. When all numbers are equal, or the
+// cell holds a single one, the points are drawn at mid height. Cells holding
+// anything but numbers are rendered unchanged.
+func SparklineFormatter(opts SparklineOptions) SimpleCellFormatter {
+	if opts.Separator == "" {
+		opts.Separator = ","
+	}
+	if opts.UpColor == "" {
+		opts.UpColor = "42"
+	}
+	if opts.DownColor == "" {
+		opts.DownColor = "196"
+	}
+
+	return func(cellValue string, rowIndex int, column TableColumn, ctx RenderContext, isCursor, isSelected, isActiveCell bool) string {
+		fields := strings.Split(cellValue, opts.Separator)
+		values := make([]float64, 0, len(fields))
+		for _, field := range fields {
+			value, err := strconv.ParseFloat(strings.TrimSpace(field), 64)
+			if err != nil || math.IsNaN(value) || math.IsInf(value, 0) {
+				return cellValue
+			}
+			values = append(values, value)
+		}
+
+		var latest string
+		width := column.Width
+		if opts.ShowLatest {
+			latest = " " + strings.TrimSpace(fields[len(fields)-1])
+			width -= runewidth.StringWidth(latest)
+		}
+		if width <= 0 {
+			width = len(values)
+		}
+
+		line := drawSparkline(values, width, opts)
+		if !opts.NoColor && len(values) > 1 {
+			switch last, previous := values[len(values)-1], values[len(values)-2]; {
+			case last > previous:
+				line = lipgloss.NewStyle().Foreground(lipgloss.Color(opts.UpColor)).Render(line)
+			case last < previous:
+				line = lipgloss.NewStyle().Foreground(lipgloss.Color(opts.DownColor)).Render(line)
+			}
+		}
+		return line + latest
+	}
+}
+
+// drawSparkline draws values as width block characters, padding or averaging
+// them down to fit
+func drawSparkline(values []float64, width int, opts SparklineOptions) string {
+	points := values
+	if len(points) > width {
+		points = make([]float64, width)
+		for i := range points {
+			from, to := i*len(values)/width, (i+1)*len(values)/width
+			var sum float64
+			for _, value := range values[from:to] {
+				sum += value
+			}
+			points[i] = sum / float64(to-from)
+		}
+	}
+
+	low, high := opts.Min, opts.Max
+	if !opts.FixedRange {
+		low, high = points[0], points[0]
+		for _, point := range points {
+			low, high = min(low, point), max(high, point)
+		}
+	}
+
+	var builder strings.Builder
+	builder.WriteString(strings.Repeat(" ", width-len(points)))
+	top := len(sparklineBlocks) - 1
+	for _, point := range points {
+		level := top / 2
+		if high > low {
+			level = int(math.Round((min(max(point, low), high) - low) / (high - low) * float64(top)))
+		}
+		builder.WriteRune(sparklineBlocks[level])
+	}
+	return builder.String()
+}
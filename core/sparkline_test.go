@@ -0,0 +1,49 @@
+package core
+
+import (
+	"testing"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/muesli/termenv"
+)
+
+func TestSparklineFormatter(t *testing.T) {
+	format := func(opts SparklineOptions, width int, value string) string {
+		column := TableColumn{Width: width}
+		return StripANSI(SparklineFormatter(opts)(value, 0, column, RenderContext{}, false, false, false))
+	}
+
+	tests := []struct {
+		opts  SparklineOptions
+		width int
+		value string
+		want  string
+	}{
+		{SparklineOptions{}, 8, "0,1,2,3,4,5,6,7", "▁▂▃▄▅▆▇█"},
+		{SparklineOptions{}, 5, "1,9,5", "  ▁█▅"},
+		{SparklineOptions{}, 4, "3,3,3", " ▄▄▄"},
+		{SparklineOptions{}, 4, "42", "   ▄"},
+		{SparklineOptions{}, 4, "0,0,7,7,0,0,7,7", "▁█▁█"},
+		{SparklineOptions{FixedRange: true, Min: 0, Max: 14}, 3, "0,7,20", "▁▅█"},
+		{SparklineOptions{ShowLatest: true}, 6, "1, 2, 30", "▁▁█ 30"},
+		{SparklineOptions{Separator: " "}, 2, "1 2", "▁█"},
+		{SparklineOptions{}, 4, "1,x,3", "1,x,3"},
+	}
+	for _, tt := range tests {
+		got := format(tt.opts, tt.width, tt.value)
+		if got != tt.want {
+			t.Errorf("SparklineFormatter(%+v)(%q) = %q, want %q", tt.opts, tt.value, got, tt.want)
+		}
+		if lipgloss.Width(got) > tt.width && tt.want != tt.value {
+			t.Errorf("Expected %q to fit %d columns", got, tt.width)
+		}
+	}
+
+	// The trend colors the sparkline, unless disabled
+	column := TableColumn{Width: 4}
+	up := SparklineFormatter(SparklineOptions{})("1,2", 0, column, RenderContext{}, false, false, false)
+	plain := SparklineFormatter(SparklineOptions{NoColor: true})("1,2", 0, column, RenderContext{}, false, false, false)
+	if plain != "  ▁█" || (lipgloss.ColorProfile() != termenv.Ascii && up == plain) {
+		t.Errorf("Expected a colored rising sparkline, got %q and %q", up, plain)
+	}
+}
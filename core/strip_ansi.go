@@ -0,0 +1,10 @@
+package core
+
+import "github.com/charmbracelet/x/ansi"
+
+// StripANSI removes the ANSI escape sequences, such as colors and styles, from
+// a rendered string, leaving the text as it shows on screen. Use it to compare
+// views in tests regardless of the terminal's color profile.
+func StripANSI(s string) string {
+	return ansi.Strip(s)
+}
@@ -0,0 +1,191 @@
+package core
+
+import (
+	"slices"
+	"sync"
+
+	"github.com/charmbracelet/lipgloss"
+)
+
+// BorderStyle names a set of border characters a theme can draw its borders
+// with.
+type BorderStyle int
+
+// Border style presets.
+const (
+	// BorderNormal draws single lines with square corners, as DefaultBorderChars.
+	BorderNormal BorderStyle = iota
+	// BorderRounded draws single lines with rounded corners.
+	BorderRounded
+	// BorderDouble draws double lines.
+	BorderDouble
+	// BorderThick draws heavy lines.
+	BorderThick
+	// BorderASCII draws with "-", "|" and "+" only.
+	BorderASCII
+	// BorderNone draws spaces, keeping the layout of a bordered table.
+	BorderNone
+)
+
+// Chars returns the border characters of the style. Unknown styles return
+// DefaultBorderChars.
+func (s BorderStyle) Chars() BorderChars {
+	switch s {
+	case BorderRounded:
+		chars := DefaultBorderChars()
+		chars.TopLeft, chars.TopRight, chars.BottomLeft, chars.BottomRight = "╭", "╮", "╰", "╯"
+		return chars
+	case BorderDouble:
+		return BorderChars{
+			Horizontal: "═", Vertical: "║",
+			TopLeft: "╔", TopRight: "╗", BottomLeft: "╚", BottomRight: "╝",
+			TopT: "╦", BottomT: "╩", LeftT: "╠", RightT: "╣", Cross: "╬",
+		}
+	case BorderThick:
+		return BorderChars{
+			Horizontal: "━", Vertical: "┃",
+			TopLeft: "┏", TopRight: "┓", BottomLeft: "┗", BottomRight: "┛",
+			TopT: "┳", BottomT: "┻", LeftT: "┣", RightT: "┫", Cross: "╋",
+		}
+	case BorderASCII:
+		return BorderChars{
+			Horizontal: "-", Vertical: "|",
+			TopLeft: "+", TopRight: "+", BottomLeft: "+", BottomRight: "+",
+			TopT: "+", BottomT: "+", LeftT: "+", RightT: "+", Cross: "+",
+		}
+	case BorderNone:
+		return BorderChars{
+			Horizontal: " ", Vertical: " ",
+			TopLeft: " ", TopRight: " ", BottomLeft: " ", BottomRight: " ",
+			TopT: " ", BottomT: " ", LeftT: " ", RightT: " ", Cross: " ",
+		}
+	default:
+		return DefaultBorderChars()
+	}
+}
+
+// ThemeRegistry holds table themes by name. It is safe for concurrent use.
+type ThemeRegistry struct {
+	mu     sync.RWMutex
+	themes map[string]Theme
+	names  []string // registration order
+}
+
+// NewThemeRegistry returns a registry holding the built-in themes: "Default",
+// "Dark", "Minimal" and "Retro".
+func NewThemeRegistry() *ThemeRegistry {
+	registry := &ThemeRegistry{themes: make(map[string]Theme)}
+	registry.Register("Default", DefaultTheme())
+	registry.Register("Dark", DarkTheme())
+	registry.Register("Minimal", MinimalTheme())
+	registry.Register("Retro", RetroTheme())
+	return registry
+}
+
+// Themes is the registry Table.SetThemeByName looks themes up in. Register
+// application themes in it to switch to them by name.
+var Themes = NewThemeRegistry()
+
+// Register adds a theme under name, replacing any theme of that name.
+func (r *ThemeRegistry) Register(name string, theme Theme) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if _, exists := r.themes[name]; !exists {
+		r.names = append(r.names, name)
+	}
+	r.themes[name] = theme
+}
+
+// Get returns the theme registered under name.
+func (r *ThemeRegistry) Get(name string) (Theme, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	theme, ok := r.themes[name]
+	return theme, ok
+}
+
+// Names returns the names of the registered themes in registration order, so
+// the built-in themes come first.
+func (r *ThemeRegistry) Names() []string {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return slices.Clone(r.names)
+}
+
+// DefaultTheme returns the default theme for a Table component, defining styles
+// for headers, cells, borders, and various states.
+func DefaultTheme() Theme {
+	return Theme{
+		HeaderStyle:         lipgloss.NewStyle().Foreground(lipgloss.Color("99")).Bold(true),
+		CellStyle:           lipgloss.NewStyle().Foreground(lipgloss.Color("252")),
+		CursorStyle:         lipgloss.NewStyle().Foreground(lipgloss.Color("205")).Bold(true),
+		SelectedStyle:       lipgloss.NewStyle().Background(lipgloss.Color("57")).Foreground(lipgloss.Color("230")),
+		FullRowCursorStyle:  lipgloss.NewStyle().Background(lipgloss.Color("12")).Foreground(lipgloss.Color("15")).Bold(true),
+		BorderChars:         DefaultBorderChars(),
+		Ellipsis:            "...",
+		BorderColor:         "241",
+		HeaderColor:         "99",
+		AlternateRowStyle:   lipgloss.NewStyle().Background(lipgloss.Color("235")),
+		DisabledStyle:       lipgloss.NewStyle().Foreground(lipgloss.Color("243")),
+		LoadingStyle:        lipgloss.NewStyle().Foreground(lipgloss.Color("69")).Italic(true),
+		ErrorStyle:          lipgloss.NewStyle().Foreground(lipgloss.Color("196")).Bold(true),
+		StatusStyle:         lipgloss.NewStyle().Foreground(lipgloss.Color("245")),
+		GroupHeaderStyle:    lipgloss.NewStyle().Foreground(lipgloss.Color("99")).Bold(true),
+		SubtotalStyle:       lipgloss.NewStyle().Foreground(lipgloss.Color("250")).Italic(true),
+		SearchMatchStyle:    lipgloss.NewStyle().Background(lipgloss.Color("220")).Foreground(lipgloss.Color("0")),
+		EvenRowStyle:        lipgloss.NewStyle(),
+		OddRowStyle:         lipgloss.NewStyle().Background(lipgloss.Color("235")),
+		ScrollbarStyle:      lipgloss.NewStyle().Foreground(lipgloss.Color("238")),
+		ScrollbarThumbStyle: lipgloss.NewStyle().Foreground(lipgloss.Color("245")),
+		SortIndicatorStyle:  lipgloss.NewStyle().Foreground(lipgloss.Color("214")),
+		FooterStyle:         lipgloss.NewStyle().Foreground(lipgloss.Color("250")).Bold(true),
+		CheckboxStyle:       lipgloss.NewStyle().Foreground(lipgloss.Color("99")),
+		ErrorRowStyle:       lipgloss.NewStyle().Foreground(lipgloss.Color("203")),
+		RowEnterStyle:       lipgloss.NewStyle().Foreground(lipgloss.Color("42")).Bold(true),
+		RowExitStyle:        lipgloss.NewStyle().Foreground(lipgloss.Color("240")).Faint(true),
+		PinnedRowStyle:      lipgloss.NewStyle().Foreground(lipgloss.Color("228")).Bold(true),
+		FormatterErrorStyle: lipgloss.NewStyle().Foreground(lipgloss.Color("196")).Bold(true),
+	}
+}
+
+// DarkTheme returns a high contrast theme with double line borders.
+func DarkTheme() Theme {
+	theme := DefaultTheme()
+	theme.HeaderStyle = lipgloss.NewStyle().Foreground(lipgloss.Color("15")).Background(lipgloss.Color("0")).Bold(true)
+	theme.CellStyle = lipgloss.NewStyle().Foreground(lipgloss.Color("15"))
+	theme.CursorStyle = lipgloss.NewStyle().Foreground(lipgloss.Color("15")).Background(lipgloss.Color("22")).Bold(true)
+	theme.SelectedStyle = lipgloss.NewStyle().Foreground(lipgloss.Color("15")).Background(lipgloss.Color("235")).Bold(true)
+	theme.FullRowCursorStyle = lipgloss.NewStyle().Foreground(lipgloss.Color("15")).Background(lipgloss.Color("22")).Bold(true)
+	theme.BorderChars = BorderDouble.Chars()
+	theme.BorderColor = "8"
+	theme.HeaderColor = "15"
+	return theme
+}
+
+// MinimalTheme returns a subdued theme drawing its borders as spaces.
+func MinimalTheme() Theme {
+	theme := DefaultTheme()
+	theme.HeaderStyle = lipgloss.NewStyle().Foreground(lipgloss.Color("4")).Bold(true)
+	theme.CellStyle = lipgloss.NewStyle().Foreground(lipgloss.Color("7"))
+	theme.CursorStyle = lipgloss.NewStyle().Reverse(true)
+	theme.SelectedStyle = lipgloss.NewStyle().Background(lipgloss.Color("235"))
+	theme.FullRowCursorStyle = lipgloss.NewStyle().Reverse(true)
+	theme.BorderChars = BorderNone.Chars()
+	theme.BorderColor = "8"
+	theme.HeaderColor = "4"
+	return theme
+}
+
+// RetroTheme returns a theme with ASCII borders and bright terminal colors.
+func RetroTheme() Theme {
+	theme := DefaultTheme()
+	theme.HeaderStyle = lipgloss.NewStyle().Foreground(lipgloss.Color("13")).Bold(true)
+	theme.CellStyle = lipgloss.NewStyle().Foreground(lipgloss.Color("15"))
+	theme.CursorStyle = lipgloss.NewStyle().Foreground(lipgloss.Color("15")).Background(lipgloss.Color("201")).Bold(true)
+	theme.SelectedStyle = lipgloss.NewStyle().Foreground(lipgloss.Color("15")).Background(lipgloss.Color("235"))
+	theme.FullRowCursorStyle = lipgloss.NewStyle().Foreground(lipgloss.Color("15")).Background(lipgloss.Color("201")).Bold(true)
+	theme.BorderChars = BorderASCII.Chars()
+	theme.BorderColor = "14"
+	theme.HeaderColor = "13"
+	return theme
+}
@@ -0,0 +1,38 @@
+package core
+
+import (
+	"reflect"
+	"slices"
+	"testing"
+)
+
+func TestThemeRegistry(t *testing.T) {
+	names := Themes.Names()
+	if !slices.Equal(names[:4], []string{"Default", "Dark", "Minimal", "Retro"}) {
+		t.Fatalf("Expected the built-in themes first, got %v", names)
+	}
+
+	// Every registered theme draws every border piece
+	for _, name := range names {
+		theme, ok := Themes.Get(name)
+		if !ok {
+			t.Fatalf("Expected theme %q to be registered", name)
+		}
+		chars := reflect.ValueOf(theme.BorderChars)
+		for i := 0; i < chars.NumField(); i++ {
+			if chars.Field(i).String() == "" {
+				t.Errorf("Theme %q has no %s border character", name, chars.Type().Field(i).Name)
+			}
+		}
+	}
+
+	registry := NewThemeRegistry()
+	custom := Theme{BorderChars: BorderRounded.Chars()}
+	registry.Register("Custom", custom)
+	if theme, ok := registry.Get("Custom"); !ok || theme.BorderChars.TopLeft != "╭" {
+		t.Error("Expected the registered theme to be returned")
+	}
+	if _, ok := Themes.Get("Custom"); ok {
+		t.Error("Expected registries not to share themes")
+	}
+}
@@ -0,0 +1,63 @@
+package core
+
+import (
+	"strings"
+
+	"github.com/charmbracelet/x/ansi"
+)
+
+// TruncateText cuts text to exactly width display cells when it is wider,
+// marking the cut with ellipsis where mode says. Widths are measured in
+// grapheme clusters, so wide characters and emoji count double and are never
+// split, and ANSI styling is kept. When a wide character would straddle the
+// cut it is left out and the result is padded with a space next to the
+// ellipsis. A width narrower than the ellipsis shows as much of the ellipsis
+// as fits. Text that fits is returned unchanged.
+func TruncateText(text string, width int, mode TruncateMode, ellipsis string) string {
+	if width <= 0 {
+		return ""
+	}
+	textWidth := ansi.StringWidth(text)
+	if textWidth <= width {
+		return text
+	}
+
+	ellipsisWidth := ansi.StringWidth(ellipsis)
+	if width <= ellipsisWidth {
+		return padTruncated(ansi.Truncate(ellipsis, width, ""), width)
+	}
+
+	keep := width - ellipsisWidth
+	switch mode {
+	case TruncateStart:
+		tail := truncateLeading(text, keep)
+		return strings.Repeat(" ", keep-ansi.StringWidth(tail)) + ellipsis + tail
+	case TruncateMiddle:
+		head := ansi.Truncate(text, (keep+1)/2, "")
+		tail := truncateLeading(text, keep-ansi.StringWidth(head))
+		return padTruncated(head+ellipsis, width-ansi.StringWidth(tail)) + tail
+	default:
+		return padTruncated(ansi.Truncate(text, keep, "")+ellipsis, width)
+	}
+}
+
+// truncateLeading returns the last cells of text, at most width of them
+func truncateLeading(text string, width int) string {
+	if width <= 0 {
+		return ""
+	}
+	textWidth := ansi.StringWidth(text)
+	tail := ansi.TruncateLeft(text, textWidth-width, "")
+	for cut := textWidth - width + 1; ansi.StringWidth(tail) > width; cut++ {
+		tail = ansi.TruncateLeft(text, cut, "")
+	}
+	return tail
+}
+
+// padTruncated pads text with spaces on the right up to width cells
+func padTruncated(text string, width int) string {
+	if padding := width - ansi.StringWidth(text); padding > 0 {
+		return text + strings.Repeat(" ", padding)
+	}
+	return text
+}
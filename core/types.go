@@ -23,8 +23,70 @@ type TableRow struct {
 	// cells should correspond to the order of columns defined in the table
 	// configuration.
 	Cells []string
+
+	// Fields optionally names each cell of Cells by the Field of its column,
+	// so helpers such as StableMultiSort can look cells up by field. Rows of a
+	// data source usually share one slice.
+	Fields []string
+
+	// Kind distinguishes regular data rows from the group header, subtotal and
+	// grand total rows inserted when grouping is enabled. It defaults to
+	// TableRowData.
+	Kind TableRowKind
+
+	// GroupKey identifies the group a header or subtotal row belongs to. Nested
+	// groups join their values with "/", e.g. "EU/France".
+	GroupKey string
+
+	// Level is the nesting depth of a group header or subtotal row, starting at 0.
+	Level int
+}
+
+// CellByField returns the cell named field by Fields, and whether there is one.
+func (r TableRow) CellByField(field string) (string, bool) {
+	for i, name := range r.Fields {
+		if name == field && i < len(r.Cells) {
+			return r.Cells[i], true
+		}
+	}
+	return "", false
 }
 
+// OverflowStrategy defines how a table fits columns that are wider than the
+// available width.
+type OverflowStrategy int
+
+// Constants for column overflow strategies.
+const (
+	// OverflowScroll keeps the declared column widths and relies on horizontal
+	// scrolling of cell content.
+	OverflowScroll OverflowStrategy = iota
+	// OverflowShrink reduces column widths in proportion to their size.
+	OverflowShrink
+	// OverflowHidePriority hides the columns with the lowest Priority until the
+	// rest fits, and shows the number of hidden columns in the header.
+	OverflowHidePriority
+)
+
+// TableRowKind identifies the role of a row in a grouped table.
+type TableRowKind int
+
+// Constants for table row kinds.
+const (
+	// TableRowData is a regular data row.
+	TableRowData TableRowKind = iota
+	// TableRowGroupHeader is a header row that starts a group.
+	TableRowGroupHeader
+	// TableRowSubtotal holds the aggregates of a single group.
+	TableRowSubtotal
+	// TableRowGrandTotal holds the aggregates of the whole dataset.
+	TableRowGrandTotal
+)
+
+// AggregateFunc reduces the cell values of a column to a single formatted
+// value, such as a sum or a count, used for subtotal and total rows.
+type AggregateFunc func(values []string) string
+
 // TableColumn represents the configuration for a single column in a table.
 // It defines properties like the title, width, alignment, and the data field it
 // corresponds to.
@@ -34,10 +96,32 @@ type TableColumn struct {
 
 	// Width is the column width in characters.
 	Width int
+	// MinWidth and MaxWidth bound the width when a column is resized
+	// interactively. A MinWidth of 0 allows shrinking to one character and a
+	// MaxWidth of 0 means no upper bound.
+	MinWidth int
+	MaxWidth int
 
 	// Alignment defines how text is aligned in the column cells (left, right,
 	// center). Use the AlignLeft, AlignCenter, or AlignRight constants.
 	Alignment int
+	// AlignmentSet marks Alignment as chosen explicitly, so that an AlignLeft
+	// is kept over the default alignment of the column's Type.
+	AlignmentSet bool
+
+	// WrapText wraps long cell text onto several lines instead of truncating
+	// it. A row grows to its tallest cell; shorter cells are padded with blank
+	// lines placed according to VerticalAlignment. The viewport height then
+	// counts lines: scrolling, thresholds and pages fit the rows to it in
+	// lines, while CursorIndex keeps counting rows.
+	WrapText bool
+	// VerticalAlignment places the content of a cell shorter than its row. Use
+	// the AlignTop, AlignMiddle, or AlignBottom constants.
+	VerticalAlignment int
+	// TruncateMode chooses where a cell too long for the column is cut and
+	// the theme's Ellipsis shown: at the end, the start, or the middle. Keep
+	// the end of file paths with TruncateStart or TruncateMiddle.
+	TruncateMode TruncateMode
 
 	// Field is the identifier used for sorting/filtering operations. This should
 	// correspond to a key in the underlying data source.
@@ -48,6 +132,90 @@ type TableColumn struct {
 	HeaderAlignment int
 	// HeaderConstraint defines formatting constraints for the header cell.
 	HeaderConstraint CellConstraint
+
+	// Type is the data type of the column's cells. It defaults to ColumnString
+	// and can be inferred from the data with the table's DetectColumnTypes.
+	// The type also sets the column's defaults, see ColumnTypeFormatter and
+	// ColumnAlignment: a column with a formatter of its own, or an Alignment
+	// other than AlignLeft or with AlignmentSet, keeps it.
+	Type ColumnType
+	// DateLayout is the time layout ColumnDate cells are reformatted to, as in
+	// "02 Jan 2006". Empty leaves date cells as they are.
+	DateLayout string
+
+	// Priority ranks the column for OverflowHidePriority: columns with the
+	// lowest priority are hidden first when the table is too wide.
+	Priority int
+
+	// Pinned keeps the column fixed while the other columns scroll: its content
+	// ignores horizontal scrolling and overflow strategies neither shrink nor
+	// hide it. Pinned columns are meant to be declared first; a heavier
+	// separator marks the edge of the pinned block.
+	Pinned bool
+
+	// Hidden leaves the column out of the header, the rows and column
+	// navigation. Its cells are still loaded, so showing it again with
+	// ColumnVisibilityCmd is instant.
+	Hidden bool
+
+	// SortCycle lists the sort directions CycleSortCmd steps through for this
+	// column, using "asc", "desc" and "off". For example ["asc", "desc"] never
+	// turns sorting off and ["desc", "asc", "off"] starts descending. It
+	// defaults to asc, desc, off.
+	SortCycle []string
+
+	// Aggregate, if set, computes the value shown for this column in subtotal
+	// and grand total rows when the table is grouped. Ungrouped tables without
+	// a FooterRow show it in a footer row, computed over the whole filtered
+	// dataset. See AggSum, AggAvg, AggCount, AggMin and AggMax.
+	Aggregate AggregateFunc
+
+	// SortComparator, if set, is passed to the data source in
+	// DataRequest.SortComparators to order this column's field, for example
+	// NaturalCompare.
+	SortComparator func(a, b string) int
+}
+
+// ColumnType describes the kind of values stored in a table column. It lets
+// data sources and formatters treat cells as numbers or dates instead of text.
+type ColumnType int
+
+// Constants for column data types.
+const (
+	// ColumnString is plain text, compared lexically.
+	ColumnString ColumnType = iota
+	// ColumnInt holds whole numbers.
+	ColumnInt
+	// ColumnFloat holds decimal numbers.
+	ColumnFloat
+	// ColumnDate holds dates or timestamps in one of the DateLayouts formats.
+	ColumnDate
+	// ColumnBool holds true/false values, as parsed by ParseCellBool.
+	ColumnBool
+	// ColumnPercent holds percentages, such as "12.5" or "12.5%".
+	ColumnPercent
+)
+
+// ColumnNumber holds numbers, whole or decimal. It is the same type as
+// ColumnFloat.
+const ColumnNumber = ColumnFloat
+
+// String returns a human-readable name for the column type.
+func (t ColumnType) String() string {
+	switch t {
+	case ColumnInt:
+		return "int"
+	case ColumnFloat:
+		return "float"
+	case ColumnDate:
+		return "date"
+	case ColumnBool:
+		return "bool"
+	case ColumnPercent:
+		return "percent"
+	default:
+		return "string"
+	}
 }
 
 // Data is a generic wrapper for any data item managed by a vtable component.
@@ -144,6 +312,14 @@ type ViewportConfig struct {
 	// is triggered. A value of -1 disables it.
 	BottomThreshold int
 
+	// ScrollOff is the number of rows kept between the cursor and the top and
+	// bottom edges of the viewport while scrolling, as vim's scrolloff: the
+	// viewport scrolls before the cursor gets closer. At the start and end of
+	// the dataset the cursor still reaches the edge. It is capped to keep the
+	// cursor in the middle of the viewport and ignored in PagedMode. Unlike
+	// the thresholds, it has no effect on chunk loading.
+	ScrollOff int
+
 	// ChunkSize is the number of items to load in each data chunk.
 	ChunkSize int
 
@@ -152,12 +328,140 @@ type ViewportConfig struct {
 	InitialIndex int
 
 	// BoundingAreaBefore is the number of items to keep loaded before the
-	// viewport top.
+	// viewport top. It counts lines for a table with multi-line rows whose
+	// data source implements HeightHintDataSource.
 	BoundingAreaBefore int
 
 	// BoundingAreaAfter is the number of items to keep loaded after the viewport
-	// bottom.
+	// bottom. It counts lines for a table with multi-line rows whose data
+	// source implements HeightHintDataSource.
 	BoundingAreaAfter int
+
+	// MaxLoadedChunks, if positive, caps the number of chunks kept in memory.
+	// Past it, the least recently used chunks are unloaded, except those
+	// covering the cursor or the viewport.
+	MaxLoadedChunks int
+
+	// PrefetchChunks is the number of chunks loaded ahead of the bounding
+	// area in the direction the viewport last moved, down at first.
+	PrefetchChunks int
+
+	// ChunkLogger, if set, is called for every chunk lifecycle event (load
+	// start, load completion, load failure and unload). It is nil by default,
+	// in which case no lifecycle tracking is done.
+	ChunkLogger func(event ChunkEvent)
+
+	// MinRefreshInterval, if set, throttles DataTotalUpdateMsg and
+	// DataRefreshMsg so the viewport is recomputed at most once per interval.
+	// Updates arriving in between are coalesced and applied by a delayed
+	// RefreshFlushMsg. A shrinking total that would leave the cursor past the
+	// end is always applied immediately.
+	MinRefreshInterval time.Duration
+
+	// ShowScrollbar draws a vertical scrollbar to the right of the rendered
+	// items, showing where the viewport sits in the dataset.
+	ShowScrollbar bool
+
+	// AutoHeight sizes the viewport to the terminal height reported by
+	// tea.WindowSizeMsg, less the lines the component draws around its items
+	// (borders, header) and ReservedLines. Height is used until the first
+	// tea.WindowSizeMsg arrives, and the viewport keeps at least one item.
+	AutoHeight bool
+
+	// ReservedLines is the number of terminal lines AutoHeight leaves free for
+	// the rest of the program, such as a status bar or help.
+	ReservedLines int
+
+	// WrapNavigation moves the cursor from the last item to the first when
+	// moving down, and from the first to the last when moving up, emitting
+	// NavigationWrappedMsg.
+	WrapNavigation bool
+
+	// WrapPageNavigation does the same for page up and page down, which
+	// otherwise stop at the boundaries even with WrapNavigation.
+	WrapPageNavigation bool
+
+	// SkipDisabled moves the cursor up and down over disabled items. When
+	// every item in the direction of a move is disabled, the cursor stays
+	// put and a BoundaryReachedMsg is emitted.
+	SkipDisabled bool
+
+	// LoadingPlaceholder, if set, renders the line shown for an item whose
+	// chunk is still loading. Items missing from a loaded chunk render blank.
+	// It defaults to DefaultLoadingPlaceholder for lists; tables default to
+	// an ellipsis in each cell.
+	LoadingPlaceholder func(index int, ctx RenderContext) string
+
+	// PagedMode shows the items one page of Height items at a time instead of
+	// scrolling continuously: the viewport always starts on a page boundary,
+	// moving the cursor past the page edge turns the page, and page up and
+	// page down move the cursor to the top of the adjacent page. The last
+	// page may be partial, and the next page is loaded ahead of time.
+	PagedMode bool
+
+	// LoadingAnimationInterval, if positive, redraws loading placeholders at
+	// this interval while chunks are loading, advancing
+	// RenderContext.LoadingFrame. Use it with SpinnerLoadingPlaceholder.
+	LoadingAnimationInterval time.Duration
+}
+
+// ChunkEventType identifies a stage in the lifecycle of a data chunk.
+type ChunkEventType int
+
+// Constants for chunk lifecycle event types.
+const (
+	// ChunkEventLoadStarted is reported when a chunk request is sent to the DataSource.
+	ChunkEventLoadStarted ChunkEventType = iota
+	// ChunkEventLoadCompleted is reported when a chunk has been loaded into memory.
+	ChunkEventLoadCompleted
+	// ChunkEventLoadFailed is reported when the DataSource fails to load a chunk.
+	ChunkEventLoadFailed
+	// ChunkEventUnloaded is reported when a chunk is evicted from memory.
+	ChunkEventUnloaded
+)
+
+// String returns a human-readable name for the event type.
+func (t ChunkEventType) String() string {
+	switch t {
+	case ChunkEventLoadStarted:
+		return "load_started"
+	case ChunkEventLoadCompleted:
+		return "load_completed"
+	case ChunkEventLoadFailed:
+		return "load_failed"
+	case ChunkEventUnloaded:
+		return "unloaded"
+	default:
+		return "unknown"
+	}
+}
+
+// ChunkEvent describes a single chunk lifecycle event reported to
+// ViewportConfig.ChunkLogger.
+type ChunkEvent struct {
+	// Type is the lifecycle stage this event reports.
+	Type ChunkEventType
+
+	// StartIndex is the absolute index of the first item in the chunk.
+	StartIndex int
+
+	// Size is the number of items involved: the requested count for load
+	// starts, the loaded item count for completions, and the chunk size for
+	// failures and unloads.
+	Size int
+
+	// Request is the DataRequest associated with the chunk, if any.
+	Request DataRequest
+
+	// Duration is the time between the load start and its completion or
+	// failure. It is zero for other events or when the start wasn't observed.
+	Duration time.Duration
+
+	// Error is the load error for ChunkEventLoadFailed events.
+	Error error
+
+	// Timestamp is when the event occurred.
+	Timestamp time.Time
 }
 
 // DataRequest represents a request for a segment of data from a DataSource.
@@ -177,7 +481,32 @@ type DataRequest struct {
 	SortDirections []string
 
 	// Filters is a map of field names to their corresponding filter values.
+	// Besides plain strings, values may be RegexFilter, RangeFilter or
+	// ExactFilter; ApplyFilter matches a cell against any of them.
 	Filters map[string]any
+
+	// FieldTypes maps field names to their known column types so a DataSource
+	// can sort them numerically or chronologically, e.g. with CompareCells.
+	// Fields without an entry are plain strings.
+	FieldTypes map[string]ColumnType
+
+	// SortComparators maps field names to comparators returning a negative,
+	// zero or positive number when a sorts before, with or after b in ascending
+	// order. A comparator takes precedence over the field's FieldTypes entry,
+	// and the field's sort direction still applies on top of it: "desc"
+	// reverses the comparator's order. CompareSortField applies these rules.
+	SortComparators map[string]func(a, b string) int
+
+	// FuzzyFields lists the fields whose entry in Filters is a pattern to match
+	// with FuzzyMatch instead of a plain substring. A DataSource keeps the items
+	// matching every fuzzy pattern and, unless SortFields is set, orders them by
+	// descending total score, keeping their original order on ties.
+	FuzzyFields []string
+
+	// FilterFoldDiacritics makes string filters and searches accent
+	// insensitive, so "cafe" matches "café". A DataSource honours it by
+	// matching with ApplyRequestFilter.
+	FilterFoldDiacritics bool
 }
 
 // Chunk represents a block of data loaded from a DataSource. Components use
@@ -200,6 +529,52 @@ type Chunk[T any] struct {
 	Request DataRequest
 }
 
+// VisibleRow describes a table row on screen, as returned by the table's
+// VisibleRows.
+type VisibleRow struct {
+	// Index is the absolute index of the row in the sorted and filtered data.
+	Index int
+	// ID is the row's ID.
+	ID string
+	// Row is the row's data.
+	Row TableRow
+	// IsCursor and IsSelected report whether the row is under the cursor and
+	// whether it is selected.
+	IsCursor   bool
+	IsSelected bool
+	// ActiveColumn is the index of the column holding the row's active cell,
+	// or -1 when the row has none.
+	ActiveColumn int
+}
+
+// VisibleItem describes a list or tree item on screen, as returned by their
+// VisibleItems.
+type VisibleItem struct {
+	// Index is the absolute index of the item in the data.
+	Index int
+	// ID is the item's ID.
+	ID string
+	// Item is the item with its state.
+	Item Data[any]
+	// IsCursor and IsSelected report whether the item is under the cursor and
+	// whether it is selected.
+	IsCursor   bool
+	IsSelected bool
+}
+
+// ChunkStats reports how the chunk cache of a component performs.
+type ChunkStats struct {
+	// Loaded is the number of chunks in memory.
+	Loaded int
+	// Evicted is the number of chunks unloaded so far.
+	Evicted int
+	// Hits and Misses count the visible items served from a loaded chunk and
+	// those shown as placeholders because their chunk was not loaded, each
+	// time the viewport moves. Rendering again in place counts nothing.
+	Hits   int
+	Misses int
+}
+
 // ChunkInfo provides metadata about a loaded chunk.
 type ChunkInfo struct {
 	// StartIndex is the absolute index of the first item in the chunk.
@@ -224,6 +599,25 @@ const (
 	SelectionNone
 )
 
+// SelectionConfig controls how the cursor and the selection interact. It is
+// shared by the list, table and tree components, which all apply it the same
+// way:
+//
+//	MoveCursorOnSelect  SelectOnCursorMove  Behavior
+//	false               false               Independent (default): selecting leaves the cursor in place and moving the cursor leaves the selection unchanged.
+//	true                false               Select and advance: selecting the current item moves the cursor down one row.
+//	false               true                Selection follows the cursor: moving the cursor deselects the item it left and selects the item it lands on.
+//	true                true                Same as SelectOnCursorMove alone; advancing on select would carry the selection away from the item just selected.
+//
+// Neither option has any effect when the SelectionMode is SelectionNone.
+type SelectionConfig struct {
+	// MoveCursorOnSelect, if true, moves the cursor down one row after the item
+	// under the cursor is selected or deselected.
+	MoveCursorOnSelect bool
+	// SelectOnCursorMove, if true, makes the selection follow the cursor.
+	SelectOnCursorMove bool
+}
+
 // MetadataKey represents a type-safe key for storing and retrieving values from
 // TypedMetadata. It includes a default value and an optional validator function.
 type MetadataKey[T any] struct {
@@ -316,6 +710,11 @@ type RenderContext struct {
 
 	// Component context
 	ColumnIndex int
+	// ItemIndex is the absolute index of the list item being rendered in the
+	// data source, after filtering, and ViewportIndex its position in the
+	// viewport.
+	ItemIndex     int
+	ViewportIndex int
 
 	// Styling & theming
 	// Theme provides the active theme for table components.
@@ -344,6 +743,9 @@ type RenderContext struct {
 	FocusState FocusState
 	// DeltaTime is the duration since the last render, useful for animations.
 	DeltaTime time.Duration
+	// LoadingFrame counts the loading animation ticks, see
+	// ViewportConfig.LoadingAnimationInterval.
+	LoadingFrame int
 
 	// State indicators (configurable)
 	// ErrorIndicator is the string used to indicate an error state.
@@ -445,6 +847,26 @@ const (
 	AlignRight  = 2
 )
 
+// Vertical alignment constants for cells of multi-line rows.
+const (
+	AlignTop    = 0
+	AlignMiddle = 1
+	AlignBottom = 2
+)
+
+// TruncateMode defines where a cell too long for its column is cut.
+type TruncateMode int
+
+// Constants for truncation modes.
+const (
+	// TruncateEnd keeps the start of the text: "very_long…".
+	TruncateEnd TruncateMode = iota
+	// TruncateStart keeps the end of the text: "…database.go".
+	TruncateStart
+	// TruncateMiddle keeps both ends of the text: "very…long.txt".
+	TruncateMiddle
+)
+
 // Animation represents a single animation instance.
 type Animation struct {
 	// State holds the current values for the animation (e.g., opacity, position).
@@ -571,8 +993,43 @@ type NavigationKeyMap struct {
 	Filter    []string
 	Sort      []string
 	Quit      []string
+
+	// Search opens the incremental search prompt of a table. It defaults to
+	// ctrl+f, leaving "/" to Filter.
+	Search []string
+
+	// JumpToPercent jumps a table to the percentage typed before it as a
+	// count prefix, as in vim: "5", "0", "%" goes to the middle row. While it
+	// is bound, digit keys build the count instead of their usual action.
+	JumpToPercent []string
+
+	// Left and Right move the active cell of a table to the previous and next
+	// column, with Up and Down moving it across rows.
+	Left  []string
+	Right []string
+
+	// Tab and ShiftTab move forward and backward according to TabBehavior.
+	Tab      []string
+	ShiftTab []string
+	// TabBehavior selects what Tab and ShiftTab do. The default moves the
+	// active column.
+	TabBehavior TabBehavior
 }
 
+// TabBehavior defines how a component reacts to the Tab and ShiftTab keys.
+type TabBehavior int
+
+// Constants for Tab key behaviors.
+const (
+	// TabMoveColumn moves the active column to the next or previous column.
+	TabMoveColumn TabBehavior = iota
+	// TabMoveRow moves the cursor to the next or previous row.
+	TabMoveRow
+	// TabMoveFocus emits FocusNextMsg or FocusPrevMsg so a multi-pane
+	// application can move focus to another component.
+	TabMoveFocus
+)
+
 // StyleConfig defines the styles for various states of list items.
 type StyleConfig struct {
 	// CursorStyle is the style for the item under the cursor.
@@ -589,6 +1046,10 @@ type StyleConfig struct {
 	LoadingStyle lipgloss.Style
 	// ErrorStyle is the style for an item with an error.
 	ErrorStyle lipgloss.Style
+	// ScrollbarStyle and ScrollbarThumbStyle style the scrollbar track and
+	// thumb shown when ViewportConfig.ShowScrollbar is set.
+	ScrollbarStyle      lipgloss.Style
+	ScrollbarThumbStyle lipgloss.Style
 }
 
 // Theme defines the visual appearance and character set for table components.
@@ -606,6 +1067,8 @@ type Theme struct {
 	FullRowCursorStyle lipgloss.Style
 	// BorderChars defines the characters used for drawing table borders.
 	BorderChars BorderChars
+	// Ellipsis marks where a truncated cell was cut. It defaults to "...".
+	Ellipsis string
 	// BorderColor is the color for table borders.
 	BorderColor string
 	// HeaderColor is the color for header text.
@@ -618,6 +1081,46 @@ type Theme struct {
 	LoadingStyle lipgloss.Style
 	// ErrorStyle is the style for rows with errors.
 	ErrorStyle lipgloss.Style
+	// StatusStyle is the style for the managed status line below the table.
+	StatusStyle lipgloss.Style
+	// GroupHeaderStyle is the style for group header rows.
+	GroupHeaderStyle lipgloss.Style
+	// SubtotalStyle is the style for subtotal and grand total rows.
+	SubtotalStyle lipgloss.Style
+	// SearchMatchStyle is the style for text matching the incremental search
+	// query in visible cells.
+	SearchMatchStyle lipgloss.Style
+	// EvenRowStyle and OddRowStyle fill data rows at even and odd absolute
+	// indices when zebra striping is enabled.
+	EvenRowStyle lipgloss.Style
+	OddRowStyle  lipgloss.Style
+	// ScrollbarStyle and ScrollbarThumbStyle style the scrollbar track and
+	// thumb shown when ViewportConfig.ShowScrollbar is set.
+	ScrollbarStyle      lipgloss.Style
+	ScrollbarThumbStyle lipgloss.Style
+	// SortIndicatorStyle is the style for the sort arrows and priorities shown
+	// in headers with TableConfig.ShowSortIndicators. Properties it leaves
+	// unset are taken from HeaderStyle.
+	SortIndicatorStyle lipgloss.Style
+	// FooterStyle is the style for the cells of the footer row.
+	FooterStyle lipgloss.Style
+	// CheckboxStyle is the style for the row checkboxes shown with
+	// TableConfig.ShowCheckboxColumn. Properties it leaves unset are taken
+	// from the row's indicator style.
+	CheckboxStyle lipgloss.Style
+	// ErrorRowStyle is the style for data rows whose item has an error. It
+	// replaces the formatting of their cells.
+	ErrorRowStyle lipgloss.Style
+	// RowEnterStyle and RowExitStyle style the rows a refresh added or moved
+	// and the rows it removed while TableConfig.AnimateChanges plays.
+	RowEnterStyle lipgloss.Style
+	RowExitStyle  lipgloss.Style
+	// PinnedRowStyle is the style for the rows pinned above the scrolling
+	// rows with Table.PinRow. It replaces the formatting of their cells.
+	PinnedRowStyle lipgloss.Style
+	// FormatterErrorStyle styles the "⚠" shown in place of a cell whose
+	// formatter panicked, with TableConfig.RecoverFormatterPanics.
+	FormatterErrorStyle lipgloss.Style
 }
 
 // BorderChars defines the characters used for drawing table borders.
@@ -635,12 +1138,39 @@ type BorderChars struct {
 	Cross       string
 }
 
+// ActiveCellBorderStyle defines how the active cell is outlined when active
+// cell indication is enabled.
+type ActiveCellBorderStyle int
+
+// Constants for active cell border styles.
+const (
+	// ActiveCellBorderNone highlights the active cell with a background fill.
+	ActiveCellBorderNone ActiveCellBorderStyle = iota
+	// ActiveCellBorderLight outlines the active cell with light edges (│ │).
+	ActiveCellBorderLight
+	// ActiveCellBorderHeavy outlines the active cell with heavy edges (┃ ┃).
+	ActiveCellBorderHeavy
+	// ActiveCellBorderDouble outlines the active cell with double edges (║ ║).
+	ActiveCellBorderDouble
+)
+
 // TableConfig contains all configuration options for a table component.
 type TableConfig struct {
 	// Columns defines the structure of the table columns.
 	Columns []TableColumn
 	// ShowHeader controls the visibility of the table header.
 	ShowHeader bool
+	// ReserveHeaderSpace keeps the lines of a hidden header as blank lines, so
+	// the rows sit where they would below a shown header, e.g. to line up
+	// with a header rendered outside the table with HeaderView.
+	ReserveHeaderSpace bool
+	// ShowSortIndicators decorates the header of each sorted column with an
+	// arrow for its direction, followed by its priority when several columns
+	// are sorted ("↑1", "↓2"), in the theme's SortIndicatorStyle. The
+	// decoration is added after any header cell formatter and the title is
+	// truncated to make room for it. Custom whole-header formatters are left
+	// undecorated.
+	ShowSortIndicators bool
 	// ShowBorders is a global toggle for all table borders.
 	ShowBorders bool
 
@@ -662,6 +1192,89 @@ type TableConfig struct {
 	// FullRowHighlighting enables a mode where the entire row is highlighted by the cursor.
 	FullRowHighlighting bool
 
+	// ZebraStriping fills data rows alternately with the theme's EvenRowStyle
+	// and OddRowStyle, by absolute row index so stripes stay put while
+	// scrolling. Cursor and selection styling take precedence.
+	ZebraStriping bool
+
+	// SelectionOverridesFormatter renders selected cells as plain text in the
+	// theme's SelectedStyle, discarding what cell formatters styled. By
+	// default the selection is merged into the formatted content instead:
+	// SelectedStyle applies to the unstyled text and is restored after every
+	// ANSI reset the formatter wrote, so a formatter that only colors the
+	// foreground keeps its color on the selection background. Colors the
+	// formatter sets itself, including a background, win over the selection.
+	SelectionOverridesFormatter bool
+
+	// RowStyleFunc, if set, styles whole data rows from their content, for
+	// example to color rows in an error state. The returned style is the base
+	// of the row: cell formatters render on top of it and the selection and
+	// cursor highlighting replace it. Along with ZebraStriping, the stripe
+	// fills in what the row style leaves unset, such as the background.
+	RowStyleFunc RowStyleFunc
+
+	// FooterRow, if set, produces a summary row pinned below the rows, styled
+	// with the theme's FooterStyle. Like the header, it takes no part in
+	// navigation or selection, and its lines come on top of the viewport
+	// height. ShowFooterSeparator draws a line between the rows and it.
+	FooterRow FooterRowFunc
+	// ShowFooterSeparator controls the visibility of the line between the body
+	// and the footer row.
+	ShowFooterSeparator bool
+
+	// ShowPageIndicator renders a "Page 3/17" line below the table, styled
+	// with the theme's StatusStyle. It is meant for ViewportConfig.PagedMode.
+	ShowPageIndicator bool
+
+	// ShowHorizontalScrollIndicator renders a "◀ chars 12–38 of 120 ▶" line
+	// below the table, under the active column, telling which part of the
+	// active cell is in view. It is styled with the theme's StatusStyle.
+	ShowHorizontalScrollIndicator bool
+
+	// ShowCheckboxColumn replaces the selection mark of the indicator column
+	// with a checkbox: "[x]" for selected rows, "[ ]" otherwise, and "[-]" on
+	// group headers whose loaded rows are partially selected. Clicking a
+	// checkbox toggles the row, or the rows of a group.
+	ShowCheckboxColumn bool
+
+	// AnimateChanges, if true, marks the rows a data refresh changes for a
+	// few frames: rows that appeared or moved are styled with the theme's
+	// RowEnterStyle, and rows that disappeared stay in place with its
+	// RowExitStyle. The cursor stays on its row through the refresh.
+	AnimateChanges bool
+
+	// AutoSizeColumns, if true, fits the column widths to their content once
+	// the first chunk has loaded, like Table.AutoSizeColumns.
+	AutoSizeColumns bool
+
+	// RenderCacheEnabled, if true, reuses the rendered string of a visible row
+	// while nothing it depends on changed, so repeated View calls skip the
+	// cell formatters. Any message other than cursor movement, and
+	// Table.InvalidateRenderCache, clears the cache. DefaultTableConfig
+	// enables it.
+	RenderCacheEnabled bool
+
+	// RecoverFormatterPanics, if true, recovers from a cell formatter that
+	// panics while rendering: the cell shows a "⚠" in the theme's
+	// FormatterErrorStyle instead, the rest of the row renders as usual, and
+	// the first panic of each column is reported with a FormatterPanicMsg.
+	// DefaultTableConfig enables it.
+	RecoverFormatterPanics bool
+
+	// StrictCellCount, if true, reports a loaded data row with more or fewer
+	// Cells than there are columns with a DataLoadErrorMsg. Otherwise such
+	// rows render anyway: missing cells are empty and extra cells ignored.
+	StrictCellCount bool
+	// WarnDataShape, if true, reports each loaded data row with more or fewer
+	// Cells than there are columns with a DataShapeWarningMsg, to debug a data
+	// source without failing on it.
+	WarnDataShape bool
+
+	// CursorFallbackReverse, if true, renders the cursor in reverse video when
+	// the theme's cursor style sets neither a foreground nor a background, so
+	// the cursor stays visible with partial themes. DefaultTableConfig enables it.
+	CursorFallbackReverse bool
+
 	// ResetScrollOnNavigation, if true, resets horizontal scroll offsets when
 	// navigating between rows.
 	ResetScrollOnNavigation bool
@@ -670,6 +1283,45 @@ type TableConfig struct {
 	ActiveCellIndicationEnabled bool
 	// ActiveCellBackgroundColor sets the background color for the active cell.
 	ActiveCellBackgroundColor string
+	// ActiveCellBorderStyle selects an outline for the active cell instead of a
+	// background fill. The outline is drawn inside the cell bounds and uses
+	// ActiveCellBackgroundColor as its color.
+	ActiveCellBorderStyle ActiveCellBorderStyle
+
+	// StatusLineWrap, if true, wraps the managed status line onto multiple lines
+	// instead of truncating it to the table width.
+	StatusLineWrap bool
+
+	// OverflowStrategy selects how columns are fitted when their total width
+	// exceeds the width received through tea.WindowSizeMsg.
+	OverflowStrategy OverflowStrategy
+
+	// ResponsiveCard, if true, switches to a card layout on narrow widths: the
+	// lowest priority columns are collapsed as with OverflowHidePriority and
+	// their values are shown as "Title: value" on a second line under each row.
+	ResponsiveCard bool
+	// ResponsiveCardWidth is the available width below which the card layout is
+	// used. When 0, it is used whenever the columns do not fit.
+	ResponsiveCardWidth int
+
+	// GroupBy lists the fields to group rows by, outermost first. Grouping is
+	// performed by data sources implementing GroupingDataSource, typically with
+	// data.GroupRows, over the sorted and filtered rows. Grouping implies a
+	// leading sort on the group fields: the table's sort orders the rows
+	// within each group. Each group starts with a header row such as
+	// "▼ Books (42)", which Enter collapses or expands; the rows of a
+	// collapsed group leave the data, so navigation and the viewport skip them.
+	GroupBy []string
+
+	// GroupByFields lists the fields the table groups rows by itself, outermost
+	// first, for any data source. The table loads every row matching its sort
+	// and filters and groups them with data.GroupRows, so grouping implies a
+	// leading sort on the group fields and the table's sort orders the rows
+	// within each group. Headers read "▼ Books (42)" and Enter collapses or
+	// expands them; a collapsed group's rows leave navigation and the
+	// viewport. Nested groups have "/"-separated keys such as "EU/Books".
+	// When set, GroupBy is ignored.
+	GroupByFields []string
 
 	// ViewportConfig defines the viewport behavior.
 	ViewportConfig ViewportConfig
@@ -677,15 +1329,43 @@ type TableConfig struct {
 	// Theme defines the visual style of the table.
 	Theme Theme
 
+	// ForceColorProfile overrides the color profile the theme is degraded to.
+	// The default, ColorProfileAuto, uses DetectColorProfile.
+	ForceColorProfile ColorProfile
+
 	// AnimationConfig controls animation behavior.
 	// TODO: i need more ideas before doing that
 	// AnimationConfig AnimationConfig
 
 	// SelectionMode defines the selection behavior.
 	SelectionMode SelectionMode
+	// Selection controls how the cursor and the selection interact.
+	Selection SelectionConfig
+	// MaxSelections, if positive, caps the number of rows selected through the
+	// table. Selecting a row beyond the cap deselects the least recently
+	// selected one and emits SelectionEvictedMsg. SelectAllCmd is ignored
+	// while a cap is set, and SelectAllToggleCmd only clears the selection.
+	MaxSelections int
 
 	// KeyMap defines the keybindings for navigation and actions.
 	KeyMap NavigationKeyMap
+
+	// OnCursorChange, if set, is called when the cursor moves to another row,
+	// after the table state is updated and before it is rendered. row is the
+	// zero TableRow when the new row is not loaded yet.
+	OnCursorChange func(oldIndex, newIndex int, row TableRow)
+
+	// OnSelectionChange, if set, is called with the number of selected rows
+	// each time it changes. The count comes from the DataSource when it
+	// implements SelectionCounter, otherwise from the loaded chunks once they
+	// are reloaded after the change.
+	OnSelectionChange func(selectedCount int)
+
+	// MouseEnabled makes the table handle tea.MouseMsg: a click moves the
+	// cursor to the row under it, a double click emits ItemActivatedMsg and the
+	// wheel moves the cursor. Coordinates are relative to the table's top-left
+	// corner, so programs placing the table elsewhere must translate them.
+	MouseEnabled bool
 }
 
 // ListConfig contains all configuration options for a list component.
@@ -701,12 +1381,18 @@ type ListConfig struct {
 
 	// SelectionMode defines the selection behavior.
 	SelectionMode SelectionMode
+	// Selection controls how the cursor and the selection interact.
+	Selection SelectionConfig
 
 	// KeyMap defines the keybindings for navigation and actions.
 	KeyMap NavigationKeyMap
 
 	// MaxWidth is the maximum width of the list.
 	MaxWidth int
+
+	// MouseEnabled makes the list handle tea.MouseMsg like the table does; see
+	// TableConfig.MouseEnabled.
+	MouseEnabled bool
 }
 
 // ListRenderConfig contains the configuration for the component-based list
@@ -724,6 +1410,9 @@ type ListRenderConfig struct {
 	ContentConfig     ListContentConfig
 	PostSpacingConfig ListSpacingConfig
 	BackgroundConfig  ListBackgroundConfig
+
+	// GroupConfig shows section headers between groups of consecutive items.
+	GroupConfig ListGroupConfig
 }
 
 // ListRenderComponent represents a single, pluggable piece of the list item
@@ -840,6 +1529,10 @@ type ListContentConfig struct {
 	WrapText  bool
 	MaxWidth  int
 
+	// Overflow handling when content exceeds MaxWidth (ignored when WrapText is enabled)
+	TruncateMarker string           // Marker shown where content was cut, "..." when empty
+	TruncateSide   ListTruncateSide // Side of the content that is cut off
+
 	// Background styling for different states
 	CursorBackground   lipgloss.Style // Background when this item has cursor
 	SelectedBackground lipgloss.Style // Background when this item is selected
@@ -857,6 +1550,25 @@ type ListBackgroundConfig struct {
 	Mode              ListBackgroundMode
 }
 
+// ListGroupConfig configures section headers between groups of consecutive
+// list items, such as items sorted by category. A header line is drawn above
+// the first item of each group and the header of the group at the top of the
+// viewport stays pinned to the first line while scrolling.
+//
+// Headers are drawn between items rather than stored as items, so the cursor
+// never lands on them and GetState().CursorIndex is always the index of a data
+// item in the data source. They take up viewport lines, so fewer items are
+// shown per page while grouping is on.
+type ListGroupConfig struct {
+	// GroupKeyFunc returns the group of an item. Grouping is off when nil.
+	GroupKeyFunc func(item Data[any]) string
+	// HeaderFormatter renders the header of a group. The key is shown as-is
+	// when nil.
+	HeaderFormatter func(key string) string
+	// HeaderStyle styles the header lines.
+	HeaderStyle lipgloss.Style
+}
+
 // ListEnumeratorAlignment defines the text alignment for enumerators.
 type ListEnumeratorAlignment int
 
@@ -867,6 +1579,17 @@ const (
 	ListAlignmentRight
 )
 
+// ListTruncateSide defines which side of overflowing list content is cut off.
+type ListTruncateSide int
+
+// Constants for list content truncation sides.
+const (
+	// ListTruncateRight keeps the beginning of the content and cuts the end.
+	ListTruncateRight ListTruncateSide = iota
+	// ListTruncateLeft keeps the end of the content and cuts the beginning.
+	ListTruncateLeft
+)
+
 // ListBackgroundMode defines how background styling is applied.
 type ListBackgroundMode int
 
@@ -908,7 +1631,10 @@ func DefaultNavigationKeyMap() NavigationKeyMap {
 		SelectAll: []string{"ctrl+a"},
 		Filter:    []string{"/"},
 		Sort:      []string{"s"},
+		Search:    []string{"ctrl+f"},
 		Quit:      []string{"q", "ctrl+c"},
+		Tab:       []string{"tab"},
+		ShiftTab:  []string{"shift+tab"},
 	}
 }
 
@@ -5,6 +5,8 @@
 package data
 
 import (
+	"cmp"
+	"slices"
 	"time"
 
 	"github.com/davidroman0O/vtable/core"
@@ -62,6 +64,10 @@ func CalculateVisibleItemsBounds(viewport core.ViewportState, viewportConfig cor
 	if maxStart < 0 {
 		maxStart = 0
 	}
+	// Paged viewports may start on a partial last page
+	if viewportConfig.PagedMode && viewportConfig.Height > 0 && totalItems > 0 {
+		maxStart = (totalItems - 1) / viewportConfig.Height * viewportConfig.Height
+	}
 
 	// Calculate endpoint of visible area (exclusive)
 	viewportEnd = viewport.ViewportStartIndex + maxVisibleItems
@@ -79,6 +85,15 @@ func CalculateChunkStartIndex(itemIndex, chunkSize int) int {
 	return (itemIndex / chunkSize) * chunkSize
 }
 
+// IsIndexLoading reports whether the chunk containing an item index has been
+// requested from the data source and has not arrived yet.
+func IsIndexLoading(index, chunkSize int, loadingChunks map[int]bool) bool {
+	if index < 0 || chunkSize <= 0 {
+		return false
+	}
+	return loadingChunks[CalculateChunkStartIndex(index, chunkSize)]
+}
+
 // ShouldUnloadChunk determines whether a specific chunk should be unloaded based
 // on pre-calculated keep-alive bounds. This is used in memory management routines
 // to decide which chunks to discard.
@@ -125,6 +140,50 @@ func GetItemAtIndex[T any](index int, chunks map[int]core.Chunk[T], totalItems i
 	return core.Data[T]{}, false
 }
 
+// ChunksToEvict returns the chunks to unload so that at most maxChunks stay
+// loaded, least recently accessed first according to accessTime. Ties go to
+// the chunk farthest from keepNear. Chunks for which protected returns true
+// are never returned, so fewer may be returned than needed.
+func ChunksToEvict[T any](chunks map[int]core.Chunk[T], accessTime map[int]time.Time, maxChunks, keepNear int, protected func(chunkStart int) bool) []int {
+	if maxChunks <= 0 || len(chunks) <= maxChunks {
+		return nil
+	}
+
+	var candidates []int
+	for chunkStart := range chunks {
+		if !protected(chunkStart) {
+			candidates = append(candidates, chunkStart)
+		}
+	}
+	distance := func(chunkStart int) int {
+		if chunkStart > keepNear {
+			return chunkStart - keepNear
+		}
+		return keepNear - chunkStart
+	}
+	slices.SortFunc(candidates, func(a, b int) int {
+		if c := accessTime[a].Compare(accessTime[b]); c != 0 {
+			return c
+		}
+		return cmp.Compare(distance(b), distance(a))
+	})
+
+	return candidates[:min(len(candidates), len(chunks)-maxChunks)]
+}
+
+// NextEnabledIndex returns the index of the first item after from, moving in
+// the direction of step (1 or -1), that is not disabled. Items whose chunk is
+// not loaded count as enabled, since their state is unknown. It returns false
+// when every item in that direction is disabled.
+func NextEnabledIndex[T any](from, step int, chunks map[int]core.Chunk[T], totalItems int) (int, bool) {
+	for index := from + step; index >= 0 && index < totalItems; index += step {
+		if item, ok := GetItemAtIndex(index, chunks, totalItems, nil); !ok || !item.Disabled {
+			return index, true
+		}
+	}
+	return from, false
+}
+
 // FindItemIndex searches for an item by its unique ID across all loaded chunks.
 // It returns the absolute index of the item if found, or -1 if the item is not
 // present in any of the currently loaded chunks.
@@ -138,3 +197,28 @@ func FindItemIndex[T any](id string, chunks map[int]core.Chunk[T]) int {
 	}
 	return -1
 }
+
+// ReportChunkEvent sends a chunk lifecycle event to the given logger. Load start
+// times are recorded in loadStarted so that the matching completion or failure
+// event carries the load duration. It does nothing when logger is nil.
+func ReportChunkEvent(logger func(core.ChunkEvent), loadStarted map[int]time.Time, event core.ChunkEvent) {
+	if logger == nil {
+		return
+	}
+
+	if event.Timestamp.IsZero() {
+		event.Timestamp = time.Now()
+	}
+
+	switch event.Type {
+	case core.ChunkEventLoadStarted:
+		loadStarted[event.StartIndex] = event.Timestamp
+	case core.ChunkEventLoadCompleted, core.ChunkEventLoadFailed:
+		if started, ok := loadStarted[event.StartIndex]; ok {
+			event.Duration = event.Timestamp.Sub(started)
+			delete(loadStarted, event.StartIndex)
+		}
+	}
+
+	logger(event)
+}
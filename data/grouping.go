@@ -0,0 +1,172 @@
+// Package data provides the core data handling capabilities for the vtable component.
+// It includes functionalities for managing data requests, chunking, sorting, and caching,
+// forming the backbone of the data virtualization layer. This package is designed to
+// efficiently handle large datasets by loading data in manageable chunks, only when needed.
+package data
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/davidroman0O/vtable/core"
+)
+
+// GroupRows arranges table rows into nested groups by the given fields and
+// returns the flattened result with group header rows, a subtotal row per group
+// and a trailing grand total row. It is an in-memory helper for data sources
+// implementing core.GroupingDataSource.
+//
+// Rows are stably sorted by the group fields first, so grouping implies a
+// leading sort on those fields. Subtotal and grand total rows are only added
+// when at least one column has an Aggregate. Groups whose key is in collapsed
+// keep their header and subtotal rows but hide their children. Fields that do
+// not match a column are ignored.
+func GroupRows(rows []core.TableRow, columns []core.TableColumn, groupBy []string, collapsed map[string]bool) []core.TableRow {
+	var groupColumns []int
+	for _, field := range groupBy {
+		for i, col := range columns {
+			if col.Field == field {
+				groupColumns = append(groupColumns, i)
+				break
+			}
+		}
+	}
+
+	if len(groupColumns) == 0 {
+		return rows
+	}
+
+	sorted := make([]core.TableRow, len(rows))
+	copy(sorted, rows)
+	sort.SliceStable(sorted, func(a, b int) bool {
+		for _, colIdx := range groupColumns {
+			cmp := core.CompareCells(cellAt(sorted[a], colIdx), cellAt(sorted[b], colIdx), columns[colIdx].Type)
+			if cmp != 0 {
+				return cmp < 0
+			}
+		}
+		return false
+	})
+
+	hasAggregates := false
+	for _, col := range columns {
+		if col.Aggregate != nil {
+			hasAggregates = true
+			break
+		}
+	}
+
+	result := appendGroups(nil, sorted, columns, groupColumns, 0, "", collapsed, hasAggregates)
+
+	if hasAggregates {
+		total := AggregateRow(sorted, columns, "Total")
+		total.ID = "grandtotal"
+		total.Kind = core.TableRowGrandTotal
+		result = append(result, total)
+	}
+
+	return result
+}
+
+// appendGroups appends the groups of rows at the given nesting level to result.
+func appendGroups(result, rows []core.TableRow, columns []core.TableColumn, groupColumns []int, level int, parentKey string, collapsed map[string]bool, hasAggregates bool) []core.TableRow {
+	colIdx := groupColumns[level]
+	indent := strings.Repeat("  ", level)
+
+	for start := 0; start < len(rows); {
+		value := cellAt(rows[start], colIdx)
+		end := start + 1
+		for end < len(rows) && cellAt(rows[end], colIdx) == value {
+			end++
+		}
+		group := rows[start:end]
+
+		key := value
+		if parentKey != "" {
+			key = parentKey + "/" + value
+		}
+
+		marker := "▼"
+		if collapsed[key] {
+			marker = "▶"
+		}
+		header := core.TableRow{
+			ID:       "group:" + key,
+			Cells:    make([]string, len(columns)),
+			Kind:     core.TableRowGroupHeader,
+			GroupKey: key,
+			Level:    level,
+		}
+		if len(header.Cells) > 0 {
+			header.Cells[0] = fmt.Sprintf("%s%s %s (%d)", indent, marker, value, len(group))
+		}
+		result = append(result, header)
+
+		if !collapsed[key] {
+			if level+1 < len(groupColumns) {
+				result = appendGroups(result, group, columns, groupColumns, level+1, key, collapsed, hasAggregates)
+			} else {
+				result = append(result, group...)
+			}
+		}
+
+		if hasAggregates {
+			subtotal := AggregateRow(group, columns, indent+"Subtotal")
+			subtotal.ID = "subtotal:" + key
+			subtotal.Kind = core.TableRowSubtotal
+			subtotal.GroupKey = key
+			subtotal.Level = level
+			result = append(result, subtotal)
+		}
+
+		start = end
+	}
+
+	return result
+}
+
+// AggregateRow builds a row holding the result of each column's Aggregate over
+// the given rows. Columns without an Aggregate are left empty, except the first
+// column which receives the label when it has no aggregate of its own.
+func AggregateRow(rows []core.TableRow, columns []core.TableColumn, label string) core.TableRow {
+	cells := make([]string, len(columns))
+	for i, col := range columns {
+		if col.Aggregate == nil {
+			continue
+		}
+		values := make([]string, 0, len(rows))
+		for _, row := range rows {
+			if row.Kind == core.TableRowData {
+				values = append(values, cellAt(row, i))
+			}
+		}
+		cells[i] = col.Aggregate(values)
+	}
+	if len(cells) > 0 && columns[0].Aggregate == nil {
+		cells[0] = label
+	}
+	return core.TableRow{Cells: cells}
+}
+
+// cellAt returns the cell at the given column index, or "" if the row is short.
+func cellAt(row core.TableRow, colIdx int) string {
+	if colIdx < len(row.Cells) {
+		return row.Cells[colIdx]
+	}
+	return ""
+}
+
+// AggregateItems feeds the cells of a column from loaded items into an
+// Aggregator. Calling it for every chunk of a (filtered) dataset streams the
+// aggregate without loading all rows at once. Items that are not regular
+// TableRow data rows are skipped.
+func AggregateItems(aggregator core.Aggregator, items []core.Data[any], columnIndex int) {
+	for _, item := range items {
+		row, ok := item.Item.(core.TableRow)
+		if !ok || row.Kind != core.TableRowData {
+			continue
+		}
+		aggregator.Add(cellAt(row, columnIndex))
+	}
+}
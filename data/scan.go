@@ -0,0 +1,107 @@
+package data
+
+import (
+	"fmt"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/davidroman0O/vtable/core"
+)
+
+// LoadChunkNow runs the LoadChunk command of a data source synchronously and
+// returns the chunk it loads. Batched and sequenced results are unwrapped with
+// core.RunCmd. A load error is returned as is, and a result carrying no
+// core.DataChunkLoadedMsg is an error too, so that callers walking the data
+// source never skip rows silently. It belongs in a tea.Cmd.
+func LoadChunkNow(dataSource core.DataSource[any], request core.DataRequest) (core.DataChunkLoadedMsg, error) {
+	msgs := core.RunCmd(dataSource.LoadChunk(request))
+	for _, msg := range msgs {
+		switch msg := msg.(type) {
+		case core.DataChunkLoadedMsg:
+			return msg, nil
+		case core.DataChunkErrorMsg:
+			return core.DataChunkLoadedMsg{}, msg.Error
+		}
+	}
+	return core.DataChunkLoadedMsg{}, unexpectedResult(fmt.Sprintf("chunk at %d", request.Start), msgs)
+}
+
+// LoadTotalNow runs the GetTotal command of a data source synchronously and
+// returns the total it reports, failing like LoadChunkNow when the result
+// carries no core.DataTotalMsg. It belongs in a tea.Cmd.
+func LoadTotalNow(dataSource core.DataSource[any]) (int, error) {
+	msgs := core.RunCmd(dataSource.GetTotal())
+	for _, msg := range msgs {
+		switch msg := msg.(type) {
+		case core.DataTotalMsg:
+			return msg.Total, nil
+		case core.DataLoadErrorMsg:
+			return 0, msg.Error
+		}
+	}
+	return 0, unexpectedResult("total", msgs)
+}
+
+// ScanChunks walks the items matching request from the start of the data
+// source, chunkSize at a time, and calls fn with each chunk loaded. The total
+// is read from the data source when the scan starts rather than taken from a
+// component, and a chunk shorter than requested ends the scan early, as a
+// filtering source may serve fewer items than its total. The scan stops at the
+// first error, from the data source or fn, and returns it; fn returning false
+// stops it without an error. It runs synchronously, so it belongs in a
+// tea.Cmd.
+func ScanChunks(dataSource core.DataSource[any], request core.DataRequest, chunkSize int, fn func(chunk core.DataChunkLoadedMsg) (bool, error)) error {
+	if dataSource == nil {
+		return nil
+	}
+	if chunkSize <= 0 {
+		chunkSize = 100
+	}
+
+	total, err := LoadTotalNow(dataSource)
+	if err != nil {
+		return err
+	}
+	for start := 0; start < total; start += request.Count {
+		request.Start = start
+		request.Count = CalculateActualChunkSize(start, chunkSize, total)
+
+		chunk, err := LoadChunkNow(dataSource, request)
+		if err != nil {
+			return err
+		}
+		if more, err := fn(chunk); err != nil || !more {
+			return err
+		}
+		if len(chunk.Items) < request.Count {
+			break
+		}
+	}
+	return nil
+}
+
+// ScanForItemIndex scans the items matching request, like ScanChunks, for the
+// item with the given ID and returns its absolute index, or -1 when it is not
+// in the data.
+func ScanForItemIndex(dataSource core.DataSource[any], request core.DataRequest, chunkSize int, id string) (int, error) {
+	index := -1
+	err := ScanChunks(dataSource, request, chunkSize, func(chunk core.DataChunkLoadedMsg) (bool, error) {
+		for i, item := range chunk.Items {
+			if item.ID == id {
+				index = chunk.StartIndex + i
+				return false, nil
+			}
+		}
+		return true, nil
+	})
+	return index, err
+}
+
+// unexpectedResult returns the error for a data source command whose messages
+// hold none of the expected results
+func unexpectedResult(what string, msgs []tea.Msg) error {
+	if len(msgs) == 0 {
+		return fmt.Errorf("loading the %s: the data source returned no message", what)
+	}
+	return fmt.Errorf("loading the %s: unexpected %T from the data source", what, msgs[0])
+}
@@ -51,6 +51,52 @@ func ToggleSortField(currentSort SortState, field string) SortState {
 	}
 }
 
+// DefaultSortCycle is the sort cycle used by CycleSortField when none is given.
+var DefaultSortCycle = []string{"asc", "desc", "off"}
+
+// CycleSortField advances the sort direction of a field to the next entry of
+// its cycle, where "off" removes the field from the sort. An unsorted field is
+// treated as "off", and a direction missing from the cycle restarts it at the
+// first entry. The field keeps its priority while it stays sorted and is added
+// last when it starts sorting. An empty cycle uses DefaultSortCycle.
+func CycleSortField(currentSort SortState, field string, cycle []string) SortState {
+	if len(cycle) == 0 {
+		cycle = DefaultSortCycle
+	}
+
+	current := GetSortDirection(currentSort, field)
+	if current == "" {
+		current = "off"
+	}
+
+	next := cycle[0]
+	for i, direction := range cycle {
+		if direction == current {
+			next = cycle[(i+1)%len(cycle)]
+			break
+		}
+	}
+
+	if next == "off" {
+		return RemoveSortField(currentSort, field)
+	}
+
+	priority := GetSortPriority(currentSort, field)
+	if priority < 0 {
+		return SortState{
+			Fields:     append(append([]string{}, currentSort.Fields...), field),
+			Directions: append(append([]string{}, currentSort.Directions...), next),
+		}
+	}
+
+	directions := append([]string{}, currentSort.Directions...)
+	directions[priority] = next
+	return SortState{
+		Fields:     append([]string{}, currentSort.Fields...),
+		Directions: directions,
+	}
+}
+
 // SetSortField creates a new SortState that sorts by a single field in a
 // specified direction, discarding any previous sort configuration.
 func SetSortField(field, direction string) SortState {
@@ -0,0 +1,80 @@
+// Package data provides the core data handling capabilities for the vtable component.
+// It includes functionalities for managing data requests, chunking, sorting, and caching,
+// forming the backbone of the data virtualization layer. This package is designed to
+// efficiently handle large datasets by loading data in manageable chunks, only when needed.
+package data
+
+import "time"
+
+// RefreshThrottle coalesces high-frequency total updates and refresh requests so
+// a component recomputes its viewport at most once per interval. Deferred
+// updates are kept until the component flushes them, typically from a delayed
+// core.RefreshFlushMsg scheduled when the first update was deferred.
+type RefreshThrottle struct {
+	lastApplied    time.Time
+	flushScheduled bool
+	pendingTotal   int
+	hasTotal       bool
+	pendingRefresh bool
+}
+
+// DeferTotal reports whether a total update arriving at now should be deferred,
+// in which case it replaces any earlier pending total. A positive delay means a
+// flush must be scheduled after that duration; zero means one already is.
+func (r *RefreshThrottle) DeferTotal(total int, interval time.Duration, now time.Time) (bool, time.Duration) {
+	deferred, delay := r.deferUpdate(interval, now)
+	if deferred {
+		r.pendingTotal = total
+		r.hasTotal = true
+	}
+	return deferred, delay
+}
+
+// DeferRefresh reports whether a refresh request arriving at now should be
+// deferred, with the same delay semantics as DeferTotal.
+func (r *RefreshThrottle) DeferRefresh(interval time.Duration, now time.Time) (bool, time.Duration) {
+	deferred, delay := r.deferUpdate(interval, now)
+	if deferred {
+		r.pendingRefresh = true
+	}
+	return deferred, delay
+}
+
+// Applied records that an update was applied at now, starting a new interval.
+func (r *RefreshThrottle) Applied(now time.Time) {
+	r.lastApplied = now
+}
+
+// ApplyTotalNow records that a total update was applied at now, bypassing the
+// throttle, and drops any pending total it supersedes. A pending refresh stays
+// queued for the scheduled flush.
+func (r *RefreshThrottle) ApplyTotalNow(now time.Time) {
+	r.lastApplied = now
+	r.pendingTotal, r.hasTotal = 0, false
+}
+
+// Flush returns and clears the pending total and refresh request.
+func (r *RefreshThrottle) Flush() (total int, hasTotal bool, refresh bool) {
+	total, hasTotal, refresh = r.pendingTotal, r.hasTotal, r.pendingRefresh
+	r.pendingTotal, r.hasTotal, r.pendingRefresh = 0, false, false
+	r.flushScheduled = false
+	return total, hasTotal, refresh
+}
+
+// deferUpdate decides whether an update falls within the current interval.
+func (r *RefreshThrottle) deferUpdate(interval time.Duration, now time.Time) (bool, time.Duration) {
+	if interval <= 0 {
+		return false, 0
+	}
+
+	elapsed := now.Sub(r.lastApplied)
+	if elapsed >= interval && !r.flushScheduled {
+		return false, 0
+	}
+
+	if r.flushScheduled {
+		return true, 0
+	}
+	r.flushScheduled = true
+	return true, interval - elapsed
+}
@@ -2,7 +2,6 @@ package main
 
 import (
 	"fmt"
-	"sort"
 	"strconv"
 	"strings"
 	"time"
@@ -384,81 +383,24 @@ func (ds *ExampleTableDataSource) rebuildFilteredData() {
 		}
 	}
 
-	// Apply sorting
-	if len(ds.sortFields) > 0 {
-		sort.Slice(result, func(i, j int) bool {
-			for idx, field := range ds.sortFields {
-				dir := "asc"
-				if idx < len(ds.sortDirs) {
-					dir = ds.sortDirs[idx]
-				}
-
-				var cellI, cellJ string
-				switch field {
-				case "name":
-					if len(result[i].Cells) > 0 {
-						cellI = result[i].Cells[0]
-					}
-					if len(result[j].Cells) > 0 {
-						cellJ = result[j].Cells[0]
-					}
-				case "value":
-					if len(result[i].Cells) > 1 {
-						cellI = result[i].Cells[1]
-					}
-					if len(result[j].Cells) > 1 {
-						cellJ = result[j].Cells[1]
-					}
-				case "status":
-					if len(result[i].Cells) > 2 {
-						cellI = result[i].Cells[2]
-					}
-					if len(result[j].Cells) > 2 {
-						cellJ = result[j].Cells[2]
-					}
-				case "category":
-					if len(result[i].Cells) > 3 {
-						cellI = result[i].Cells[3]
-					}
-					if len(result[j].Cells) > 3 {
-						cellJ = result[j].Cells[3]
-					}
-				}
-
-				var cmp int
-				// For value field, do numeric comparison
-				if field == "value" {
-					valueI := extractValueNumber(cellI)
-					valueJ := extractValueNumber(cellJ)
-					if valueI < valueJ {
-						cmp = -1
-					} else if valueI > valueJ {
-						cmp = 1
-					}
-				} else {
-					// String comparison for other fields
-					if cellI < cellJ {
-						cmp = -1
-					} else if cellI > cellJ {
-						cmp = 1
-					}
-				}
-
-				if cmp != 0 {
-					if dir == "desc" {
-						return cmp > 0
-					}
-					return cmp < 0
-				}
-			}
-			return false
-		})
-	}
+	// Apply sorting; the stable sort keeps rows with equal keys in their
+	// original order, so removing a sort brings that order back
+	core.StableMultiSortColumns(result, sortColumns, ds.sortFields, ds.sortDirs, map[string]func(a, b string) int{
+		"value": func(a, b string) int { return extractValueNumber(a) - extractValueNumber(b) },
+	})
 
 	ds.filteredData = result
 	ds.filteredTotal = len(result)
 }
 
+// sortColumns maps the sortable fields to their cells
+var sortColumns = []core.TableColumn{
+	{Field: "name"},
+	{Field: "value"},
+	{Field: "status"},
+	{Field: "category"},
+}
+
 // extractValueNumber extracts the numeric value from "Value X" strings
 func extractValueNumber(valueStr string) int {
 	if strings.HasPrefix(valueStr, "Value ") {
@@ -590,74 +532,17 @@ func SetTheme(theme TableTheme) {
 
 // convertToVTableTheme converts demo TableTheme to vtable.Theme
 func convertToVTableTheme(theme TableTheme) core.Theme {
-	var borderChars core.BorderChars
-
 	// Create dramatically different visual styles based on theme
+	borderStyle := core.BorderNormal
 	switch theme.Name {
-	case "Default":
-		// Unicode box drawing - clean and modern
-		borderChars = core.BorderChars{
-			Horizontal:  "─",
-			Vertical:    "│",
-			TopLeft:     "┌",
-			TopRight:    "┐",
-			BottomLeft:  "└",
-			BottomRight: "┘",
-			TopT:        "┬",
-			BottomT:     "┴",
-			LeftT:       "├",
-			RightT:      "┤",
-			Cross:       "┼",
-		}
 	case "Heavy":
-		// Double/thick lines - dramatic and bold
-		borderChars = core.BorderChars{
-			Horizontal:  "═",
-			Vertical:    "║",
-			TopLeft:     "╔",
-			TopRight:    "╗",
-			BottomLeft:  "╚",
-			BottomRight: "╝",
-			TopT:        "╦",
-			BottomT:     "╩",
-			LeftT:       "╠",
-			RightT:      "╣",
-			Cross:       "╬",
-		}
+		borderStyle = core.BorderDouble // Double lines - dramatic and bold
 	case "Minimal":
-		// Simple lines - subtle and clean
-		borderChars = core.BorderChars{
-			Horizontal:  " ", // No horizontal borders!
-			Vertical:    " ", // No vertical borders!
-			TopLeft:     " ",
-			TopRight:    " ",
-			BottomLeft:  " ",
-			BottomRight: " ",
-			TopT:        " ",
-			BottomT:     " ",
-			LeftT:       " ",
-			RightT:      " ",
-			Cross:       " ",
-		}
+		borderStyle = core.BorderNone // No borders at all
 	case "Retro":
-		// ASCII retro computing style
-		borderChars = core.BorderChars{
-			Horizontal:  "-",
-			Vertical:    "|",
-			TopLeft:     "+",
-			TopRight:    "+",
-			BottomLeft:  "+",
-			BottomRight: "+",
-			TopT:        "+",
-			BottomT:     "+",
-			LeftT:       "+",
-			RightT:      "+",
-			Cross:       "+",
-		}
-	default:
-		// Fallback to default
-		borderChars = core.DefaultBorderChars()
+		borderStyle = core.BorderASCII // ASCII retro computing style
 	}
+	borderChars := borderStyle.Chars()
 
 	return core.Theme{
 		HeaderStyle:        lipgloss.NewStyle().Bold(true).Foreground(lipgloss.Color(theme.PrimaryText)).Background(lipgloss.Color(theme.HeaderBg)),
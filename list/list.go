@@ -66,7 +66,24 @@ type List struct {
 	// Loading state tracking - CRITICAL for UX!
 	loadingChunks    map[int]bool // Tracks chunks that are currently being loaded.
 	hasLoadingChunks bool         // A quick flag to check if any chunks are loading.
+	loadingTicking   bool         // A LoadingTickMsg is scheduled.
 	canScroll        bool         // Whether scrolling is allowed (blocked during critical data loads).
+
+	chunkLoadStarted map[int]time.Time // Load start times for chunk lifecycle logging.
+
+	refreshThrottle data.RefreshThrottle // Coalesces total updates and refreshes under MinRefreshInterval.
+
+	// Mouse handling
+	lineItems []int      // Absolute item index of each rendered line, -1 for group headers.
+	lastClick mouseClick // The last click, for double click detection.
+
+	// Change reporting
+	reportedSelection int // The selection count last reported with SelectionChangedMsg.
+	selectionReloads  int // Chunk reloads to wait for before counting the selection again.
+
+	// State restoration
+	pendingCursorIndex int    // Cursor position to restore once the data reloads (-1 = none).
+	pendingCursorID    string // ID of the item the cursor moves to once found ("" = none).
 }
 
 // NewList creates a new List component with the given configuration and data
@@ -81,17 +98,19 @@ func NewList(listConfig core.ListConfig, dataSource core.DataSource[any]) *List
 	}
 
 	list := &List{
-		dataSource:       dataSource,
-		chunks:           make(map[int]core.Chunk[any]),
-		config:           listConfig,
-		selectedItems:    make(map[string]bool),
-		selectedOrder:    make([]string, 0),
-		filters:          make(map[string]any),
-		chunkAccessTime:  make(map[int]time.Time),
-		visibleItems:     make([]core.Data[any], 0), // Initialize visible items
-		loadingChunks:    make(map[int]bool),        // Initialize loading state tracking
-		hasLoadingChunks: false,
-		canScroll:        true, // Allow scrolling initially
+		dataSource:         dataSource,
+		chunks:             make(map[int]core.Chunk[any]),
+		config:             listConfig,
+		selectedItems:      make(map[string]bool),
+		selectedOrder:      make([]string, 0),
+		filters:            make(map[string]any),
+		chunkAccessTime:    make(map[int]time.Time),
+		visibleItems:       make([]core.Data[any], 0), // Initialize visible items
+		loadingChunks:      make(map[int]bool),        // Initialize loading state tracking
+		chunkLoadStarted:   make(map[int]time.Time),
+		hasLoadingChunks:   false,
+		canScroll:          true, // Allow scrolling initially
+		pendingCursorIndex: -1,
 		viewport: core.ViewportState{
 			ViewportStartIndex:  0,
 			CursorIndex:         listConfig.ViewportConfig.InitialIndex,
@@ -120,6 +139,20 @@ func (l *List) Init() tea.Cmd {
 // returning an updated model and any necessary commands. It is the core of the
 // component's logic and implements the bubbletea.Model interface.
 func (l *List) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	previousCursor := l.viewport.CursorIndex
+	model, cmd := l.update(msg)
+	if followCmd := l.selectOnCursorMove(previousCursor); followCmd != nil {
+		cmd = tea.Batch(cmd, followCmd)
+	}
+	if changeCmd := l.changeCmds(msg, previousCursor); changeCmd != nil {
+		cmd = tea.Batch(cmd, changeCmd)
+	}
+	return model, cmd
+}
+
+// update dispatches a message to its handler. Update wraps it to apply the
+// cursor-follows-selection behavior once per message.
+func (l *List) update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	var cmds []tea.Cmd
 
 	switch msg := msg.(type) {
@@ -163,11 +196,25 @@ func (l *List) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		cmd := l.handleJumpTo(msg.Index)
 		return l, cmd
 
+	case core.JumpToPercentMsg:
+		if l.totalItems == 0 {
+			return l, nil
+		}
+		return l, core.JumpToCmd(viewport.PercentIndex(msg.Percent, l.totalItems))
+
 	// ===== Data Messages =====
 	case core.DataRefreshMsg:
+		if deferred, delay := l.refreshThrottle.DeferRefresh(l.config.ViewportConfig.MinRefreshInterval, time.Now()); deferred {
+			return l, l.scheduleRefreshFlush(delay)
+		}
+		l.refreshThrottle.Applied(time.Now())
 		cmd := l.handleDataRefresh()
 		return l, cmd
 
+	case core.RefreshFlushMsg:
+		cmd := l.handleRefreshFlush()
+		return l, cmd
+
 	case core.DataChunksRefreshMsg:
 		// Refresh chunks while preserving cursor position
 		l.chunks = make(map[int]core.Chunk[any])
@@ -181,8 +228,19 @@ func (l *List) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		cmd := l.handleDataChunkLoaded(msg)
 		return l, cmd
 
+	case core.LoadingTickMsg:
+		cmd := l.handleLoadingTick()
+		return l, cmd
+
 	case core.DataChunkErrorMsg:
 		l.lastError = msg.Error
+		l.logChunkEvent(core.ChunkEvent{
+			Type:       core.ChunkEventLoadFailed,
+			StartIndex: msg.StartIndex,
+			Size:       msg.Request.Count,
+			Request:    msg.Request,
+			Error:      msg.Error,
+		})
 		return l, core.ErrorCmd(msg.Error, "chunk_load")
 
 	case core.DataTotalMsg:
@@ -192,31 +250,32 @@ func (l *List) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		l.viewport.ViewportStartIndex = 0
 		l.viewport.CursorIndex = l.config.ViewportConfig.InitialIndex
 		l.viewport.CursorViewportIndex = l.config.ViewportConfig.InitialIndex
+		// Restore the cursor of a state applied with RestoreState
+		if l.pendingCursorIndex >= 0 {
+			l.viewport = viewport.CalculateJumpTo(l.pendingCursorIndex, l.config.ViewportConfig, l.totalItems)
+			l.pendingCursorIndex = -1
+		}
 		// After getting total, load the initial chunks using smart chunk management
-		return l, l.smartChunkManagement()
+		return l, tea.Batch(l.smartChunkManagement(), l.locateRestoredCursor())
 
-	case core.DataTotalUpdateMsg:
-		// Update total while preserving cursor position
-		oldTotal := l.totalItems
-		l.totalItems = msg.Total
-		l.updateViewportBounds()
+	case core.StateRestoreMsg:
+		cmd := l.handleStateRestore(msg.State)
+		return l, cmd
 
-		// Ensure cursor stays within bounds if total decreased
-		if l.viewport.CursorIndex >= l.totalItems && l.totalItems > 0 {
-			l.viewport.CursorIndex = l.totalItems - 1
-			// Recalculate viewport position based on new cursor
-			l.viewport.CursorViewportIndex = l.viewport.CursorIndex - l.viewport.ViewportStartIndex
-			if l.viewport.CursorViewportIndex < 0 {
-				l.viewport.ViewportStartIndex = l.viewport.CursorIndex
-				l.viewport.CursorViewportIndex = 0
-			}
-		}
+	case restoredCursorMsg:
+		cmd := l.handleRestoredCursor(msg)
+		return l, cmd
 
-		// Only reload chunks if we need to refresh data (not just for cursor preservation)
-		if oldTotal != l.totalItems {
-			return l, l.smartChunkManagement()
+	case core.DataTotalUpdateMsg:
+		// Shrinking past the cursor is applied immediately, bypassing the throttle
+		if msg.Total > l.viewport.CursorIndex {
+			if deferred, delay := l.refreshThrottle.DeferTotal(msg.Total, l.config.ViewportConfig.MinRefreshInterval, time.Now()); deferred {
+				return l, l.scheduleRefreshFlush(delay)
+			}
 		}
-		return l, nil
+		l.refreshThrottle.ApplyTotalNow(time.Now())
+		cmd := l.handleDataTotalUpdate(msg.Total)
+		return l, cmd
 
 	case core.DataLoadErrorMsg:
 		l.lastError = msg.Error
@@ -250,6 +309,10 @@ func (l *List) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		cmd := l.handleSelectAll()
 		return l, cmd
 
+	case core.SelectAllToggleMsg:
+		cmd := l.handleSelectAllToggle()
+		return l, cmd
+
 	case core.SelectClearMsg:
 		if l.dataSource == nil {
 			return l, nil
@@ -277,6 +340,12 @@ func (l *List) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 
 	// ===== Filter Messages =====
 	case core.FilterSetMsg:
+		if regex, ok := msg.Value.(core.RegexFilter); ok {
+			// An invalid pattern is reported instead of reaching the data source
+			if err := core.ValidateFilter(regex); err != nil {
+				return l, core.DataLoadErrorCmd(err)
+			}
+		}
 		l.filters[msg.Field] = msg.Value
 		cmd := l.handleFilterChange()
 		return l, cmd
@@ -374,7 +443,7 @@ func (l *List) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	case core.BatchMsg:
 		for _, subMsg := range msg.Messages {
 			var cmd tea.Cmd
-			_, cmd = l.Update(subMsg)
+			_, cmd = l.update(subMsg)
 			if cmd != nil {
 				cmds = append(cmds, cmd)
 			}
@@ -382,10 +451,18 @@ func (l *List) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		return l, tea.Batch(cmds...)
 
 	// ===== Keyboard Input =====
+	case tea.WindowSizeMsg:
+		cmd := l.applyAutoHeight(msg.Height)
+		return l, cmd
+
 	case tea.KeyMsg:
 		cmd := l.handleKeyPress(msg)
 		return l, cmd
 
+	case tea.MouseMsg:
+		cmd := l.handleMouse(msg)
+		return l, cmd
+
 	case core.AriaLabelSetMsg:
 		l.renderContext.ScreenReader = true
 		// Store the label in metadata or render context as needed
@@ -402,6 +479,23 @@ func (l *List) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	case core.SetComponentBackgroundMsg:
 		l.SetComponentBackgroundStyling(msg.ComponentType, msg.CursorBg, msg.SelectedBg, msg.NormalBg, msg.ApplyCursor, msg.ApplySelected, msg.ApplyNormal)
 		return l, nil
+
+	case core.ListBackgroundModeSetMsg:
+		l.SetBackgroundMode(msg.Mode)
+		return l, nil
+
+	case core.ListCursorIndicatorSetMsg:
+		l.SetCursorIndicator(msg.Indicator)
+		return l, nil
+
+	case core.ListEnumeratorAlignmentSetMsg:
+		l.config.RenderConfig.EnumeratorConfig.Alignment = msg.Alignment
+		l.config.RenderConfig.EnumeratorConfig.MaxWidth = msg.Width
+		return l, nil
+
+	case core.ListTextWrapEnableMsg:
+		l.SetTextWrapping(msg.Enabled)
+		return l, nil
 	}
 
 	return l, nil
@@ -427,7 +521,13 @@ func (l *List) View() string {
 		// The data will appear automatically when chunks load
 	}
 
+	// Grouped lists interleave section headers with the items
+	if l.config.RenderConfig.GroupConfig.GroupKeyFunc != nil {
+		return l.withScrollbar(l.renderGrouped())
+	}
+
 	// Render each visible item
+	l.lineItems = l.lineItems[:0]
 	for i, item := range l.visibleItems {
 		absoluteIndex := l.viewport.ViewportStartIndex + i
 
@@ -436,28 +536,8 @@ func (l *List) View() string {
 			break
 		}
 
-		isCursor := i == l.viewport.CursorViewportIndex
-		isSelected := item.Selected
-
-		var renderedItem string
-
-		// Always use component-based rendering system
-		enhancedFormatter := EnhancedListFormatter(l.config.RenderConfig)
-		ctx := l.renderContext
-		ctx.MaxWidth = l.config.RenderConfig.ContentConfig.MaxWidth
-
-		renderedItem = enhancedFormatter(
-			item,
-			absoluteIndex,
-			ctx,
-			isCursor,
-			l.viewport.IsAtTopThreshold,
-			l.viewport.IsAtBottomThreshold,
-		)
-
-		// Apply item styling
-		renderedItem = l.applyItemStyle(renderedItem, isCursor, isSelected, item)
-
+		renderedItem := l.renderVisibleItem(item, i)
+		l.recordItemLines(absoluteIndex, renderedItem)
 		builder.WriteString(renderedItem)
 
 		// Add a newline unless it's the last actual item
@@ -466,7 +546,43 @@ func (l *List) View() string {
 		}
 	}
 
-	return builder.String()
+	return l.withScrollbar(builder.String())
+}
+
+// withScrollbar draws the scrollbar to the right of the rendered items when
+// ViewportConfig.ShowScrollbar is set
+func (l *List) withScrollbar(content string) string {
+	if !l.config.ViewportConfig.ShowScrollbar {
+		return content
+	}
+	track := strings.Count(content, "\n") + 1
+	offset, length := viewport.CalculateScrollbarThumb(l.viewport, l.config.ViewportConfig, l.totalItems, track)
+	return render.AppendScrollbar(content, offset, length, l.config.StyleConfig.ScrollbarStyle, l.config.StyleConfig.ScrollbarThumbStyle)
+}
+
+// renderVisibleItem renders the item at a viewport position
+func (l *List) renderVisibleItem(item core.Data[any], viewportIndex int) string {
+	absoluteIndex := l.viewport.ViewportStartIndex + viewportIndex
+	isCursor := viewportIndex == l.viewport.CursorViewportIndex
+
+	// Always use component-based rendering system
+	enhancedFormatter := EnhancedListFormatter(l.config.RenderConfig)
+	ctx := l.renderContext
+	ctx.MaxWidth = l.config.RenderConfig.ContentConfig.MaxWidth
+	ctx.ItemIndex = absoluteIndex
+	ctx.ViewportIndex = viewportIndex
+
+	renderedItem := enhancedFormatter(
+		item,
+		absoluteIndex,
+		ctx,
+		isCursor,
+		l.viewport.IsAtTopThreshold,
+		l.viewport.IsAtBottomThreshold,
+	)
+
+	// Apply item styling
+	return l.applyItemStyle(renderedItem, isCursor, item.Selected, item)
 }
 
 // Focus sets the list to a focused state, allowing it to receive and handle
@@ -493,6 +609,37 @@ func (l *List) GetState() core.ViewportState {
 	return l.viewport
 }
 
+// DebugSnapshot returns the viewport math and loaded chunks of the list, to
+// attach to bug reports about scrolling and chunk loading
+func (l *List) DebugSnapshot() core.DebugSnapshot {
+	return core.NewDebugSnapshot(l.viewport, l.config.ViewportConfig, l.totalItems, l.chunks, l.loadingChunks)
+}
+
+// VisibleItems returns the items View renders, top to bottom. Items whose
+// chunk is still loading are returned as their placeholder.
+func (l *List) VisibleItems() []core.VisibleItem {
+	if l.totalItems == 0 {
+		return nil
+	}
+	l.updateVisibleItems()
+
+	items := make([]core.VisibleItem, 0, len(l.visibleItems))
+	for i, item := range l.visibleItems {
+		absoluteIndex := l.viewport.ViewportStartIndex + i
+		if absoluteIndex >= l.totalItems {
+			break
+		}
+		items = append(items, core.VisibleItem{
+			Index:      absoluteIndex,
+			ID:         item.ID,
+			Item:       item,
+			IsCursor:   i == l.viewport.CursorViewportIndex,
+			IsSelected: item.Selected,
+		})
+	}
+	return items
+}
+
 // GetTotalItems returns the total number of items in the dataset.
 func (l *List) GetTotalItems() int {
 	return l.totalItems
@@ -640,13 +787,27 @@ func (l *List) handleCursorUp() tea.Cmd {
 		return nil
 	}
 
-	// Can't move up if already at the beginning
+	// Can't move up if already at the beginning, unless wrapping around
 	if l.viewport.CursorIndex <= 0 {
+		if l.config.ViewportConfig.WrapNavigation && l.totalItems > 1 {
+			return tea.Batch(l.handleJumpToEnd(), core.NavigationWrappedCmd("up"))
+		}
 		return nil
 	}
 
+	steps := 1
+	if l.config.ViewportConfig.SkipDisabled {
+		target, ok := data.NextEnabledIndex(l.viewport.CursorIndex, -1, l.chunks, l.totalItems)
+		if !ok {
+			return core.BoundaryReachedCmd(true)
+		}
+		steps = l.viewport.CursorIndex - target
+	}
+
 	previousState := l.viewport
-	l.viewport = viewport.CalculateCursorUp(l.viewport, l.config.ViewportConfig, l.totalItems)
+	for range steps {
+		l.viewport = viewport.CalculateCursorUp(l.viewport, l.config.ViewportConfig, l.totalItems)
+	}
 
 	// Update visible items if viewport changed
 	if l.viewport.ViewportStartIndex != previousState.ViewportStartIndex {
@@ -664,13 +825,27 @@ func (l *List) handleCursorDown() tea.Cmd {
 		return nil
 	}
 
-	// Can't move down if already at the end
+	// Can't move down if already at the end, unless wrapping around
 	if l.viewport.CursorIndex >= l.totalItems-1 {
+		if l.config.ViewportConfig.WrapNavigation && l.totalItems > 1 {
+			return tea.Batch(l.handleJumpToStart(), core.NavigationWrappedCmd("down"))
+		}
 		return nil
 	}
 
+	steps := 1
+	if l.config.ViewportConfig.SkipDisabled {
+		target, ok := data.NextEnabledIndex(l.viewport.CursorIndex, 1, l.chunks, l.totalItems)
+		if !ok {
+			return core.BoundaryReachedCmd(false)
+		}
+		steps = target - l.viewport.CursorIndex
+	}
+
 	previousState := l.viewport
-	l.viewport = viewport.CalculateCursorDown(l.viewport, l.config.ViewportConfig, l.totalItems)
+	for range steps {
+		l.viewport = viewport.CalculateCursorDown(l.viewport, l.config.ViewportConfig, l.totalItems)
+	}
 
 	// Update visible items if viewport changed
 	if l.viewport.ViewportStartIndex != previousState.ViewportStartIndex {
@@ -687,6 +862,9 @@ func (l *List) handlePageUp() tea.Cmd {
 	if l.totalItems == 0 || !l.canScroll {
 		return nil
 	}
+	if l.viewport.CursorIndex <= 0 && l.config.ViewportConfig.WrapPageNavigation && l.totalItems > 1 {
+		return tea.Batch(l.handleJumpToEnd(), core.NavigationWrappedCmd("up"))
+	}
 
 	previousState := l.viewport
 	l.viewport = viewport.CalculatePageUp(l.viewport, l.config.ViewportConfig, l.totalItems)
@@ -703,6 +881,9 @@ func (l *List) handlePageUp() tea.Cmd {
 // down by one page.
 func (l *List) handlePageDown() tea.Cmd {
 	if l.viewport.CursorIndex >= l.totalItems-1 {
+		if l.config.ViewportConfig.WrapPageNavigation && l.totalItems > 1 {
+			return tea.Batch(l.handleJumpToStart(), core.NavigationWrappedCmd("down"))
+		}
 		return nil
 	}
 
@@ -755,6 +936,56 @@ func (l *List) handleJumpTo(index int) tea.Cmd {
 	return l.smartChunkManagement()
 }
 
+// handleDataTotalUpdate updates the total while preserving the cursor position.
+func (l *List) handleDataTotalUpdate(total int) tea.Cmd {
+	oldTotal := l.totalItems
+	l.totalItems = total
+	l.updateViewportBounds()
+
+	// Ensure cursor stays within bounds if total decreased
+	if l.viewport.CursorIndex >= l.totalItems && l.totalItems > 0 {
+		l.viewport.CursorIndex = l.totalItems - 1
+		// Recalculate viewport position based on new cursor
+		l.viewport.CursorViewportIndex = l.viewport.CursorIndex - l.viewport.ViewportStartIndex
+		if l.viewport.CursorViewportIndex < 0 {
+			l.viewport.ViewportStartIndex = l.viewport.CursorIndex
+			l.viewport.CursorViewportIndex = 0
+		}
+	}
+
+	// Only reload chunks if we need to refresh data (not just for cursor preservation)
+	if oldTotal != l.totalItems {
+		return l.smartChunkManagement()
+	}
+	return nil
+}
+
+// scheduleRefreshFlush returns a delayed RefreshFlushMsg when a flush needs scheduling.
+func (l *List) scheduleRefreshFlush(delay time.Duration) tea.Cmd {
+	if delay <= 0 {
+		return nil
+	}
+	return core.DelayCmd(delay, core.RefreshFlushMsg{})
+}
+
+// handleRefreshFlush applies the total update and refresh deferred by MinRefreshInterval.
+func (l *List) handleRefreshFlush() tea.Cmd {
+	total, hasTotal, refresh := l.refreshThrottle.Flush()
+	if !hasTotal && !refresh {
+		return nil
+	}
+	l.refreshThrottle.Applied(time.Now())
+
+	var cmds []tea.Cmd
+	if hasTotal {
+		cmds = append(cmds, l.handleDataTotalUpdate(total))
+	}
+	if refresh {
+		cmds = append(cmds, l.handleDataRefresh())
+	}
+	return tea.Batch(cmds...)
+}
+
 // handleDataRefresh performs a hard refresh of the list's data. It clears all
 // local caches and re-initiates the data loading process.
 func (l *List) handleDataRefresh() tea.Cmd {
@@ -803,6 +1034,12 @@ func (l *List) handleDataChunkLoaded(msg core.DataChunkLoadedMsg) tea.Cmd {
 
 	// Emit chunk loading completed message for observability
 	cmds = append(cmds, core.ChunkLoadingCompletedCmd(msg.StartIndex, len(msg.Items), msg.Request))
+	l.logChunkEvent(core.ChunkEvent{
+		Type:       core.ChunkEventLoadCompleted,
+		StartIndex: msg.StartIndex,
+		Size:       len(msg.Items),
+		Request:    msg.Request,
+	})
 
 	// Unload old chunks
 	if unloadCmd := l.unloadOldChunks(); unloadCmd != nil {
@@ -823,8 +1060,11 @@ func (l *List) handleSelectCurrent() tea.Cmd {
 	if !exists {
 		return nil
 	}
+	if item.Disabled {
+		return core.SelectionRejectedCmd(l.viewport.CursorIndex, "disabled")
+	}
 
-	return l.toggleItemSelection(item.ID)
+	return l.advanceAfterSelect(l.toggleItemSelection(item.ID))
 }
 
 // handleSelectToggle toggles the selection state of an item at a specific index.
@@ -837,6 +1077,9 @@ func (l *List) handleSelectToggle(index int) tea.Cmd {
 	if !exists {
 		return nil
 	}
+	if item.Disabled {
+		return core.SelectionRejectedCmd(index, "disabled")
+	}
 
 	return l.toggleItemSelection(item.ID)
 }
@@ -851,6 +1094,27 @@ func (l *List) handleSelectAll() tea.Cmd {
 	return l.dataSource.SelectAll()
 }
 
+// handleSelectAllToggle selects all items, or clears the selection if every item
+// is already selected. The selection count comes from the DataSource when it
+// implements core.SelectionCounter, otherwise from the loaded chunks.
+func (l *List) handleSelectAllToggle() tea.Cmd {
+	if l.config.SelectionMode != core.SelectionMultiple || l.dataSource == nil {
+		return nil
+	}
+
+	var selectedCount int
+	if counter, ok := l.dataSource.(core.SelectionCounter); ok {
+		selectedCount = counter.GetSelectionCount()
+	} else {
+		selectedCount = data.GetSelectionCount(l.chunks)
+	}
+
+	if l.totalItems > 0 && selectedCount >= l.totalItems {
+		return l.dataSource.ClearSelection()
+	}
+	return l.dataSource.SelectAll()
+}
+
 // handleSelectRange selects a range of items between a start and end ID.
 func (l *List) handleSelectRange(startID, endID string) tea.Cmd {
 	if l.config.SelectionMode != core.SelectionMultiple {
@@ -1031,7 +1295,7 @@ func (l *List) handleKeyPress(msg tea.KeyMsg) tea.Cmd {
 func (l *List) renderItem(absoluteIndex, viewportIndex int) string {
 	item, exists := l.getItemAtIndex(absoluteIndex)
 	if !exists {
-		return render.RenderLoadingPlaceholder(l.config.StyleConfig)
+		return l.renderLoadingPlaceholder(absoluteIndex)
 	}
 
 	isCursor := absoluteIndex == l.viewport.CursorIndex
@@ -1046,6 +1310,8 @@ func (l *List) renderItem(absoluteIndex, viewportIndex int) string {
 	enhancedFormatter := EnhancedListFormatter(l.config.RenderConfig)
 	ctx := l.renderContext
 	ctx.MaxWidth = l.config.RenderConfig.ContentConfig.MaxWidth
+	ctx.ItemIndex = absoluteIndex
+	ctx.ViewportIndex = viewportIndex
 
 	content := enhancedFormatter(
 		item,
@@ -1075,6 +1341,22 @@ func (l *List) updateViewportBounds() {
 	l.viewport = viewport.UpdateViewportBounds(l.viewport, l.config.ViewportConfig, l.totalItems)
 }
 
+// applyAutoHeight fits the viewport to the terminal height when AutoHeight is
+// enabled and loads the chunks the resized viewport needs.
+func (l *List) applyAutoHeight(terminalHeight int) tea.Cmd {
+	if !l.config.ViewportConfig.AutoHeight || terminalHeight <= 0 {
+		return nil
+	}
+
+	height := viewport.AutoHeight(terminalHeight, 0, l.config.ViewportConfig)
+	if height == l.config.ViewportConfig.Height {
+		return nil
+	}
+	l.config.ViewportConfig.Height = height
+	l.viewport = viewport.Resize(l.viewport, l.config.ViewportConfig, l.totalItems)
+	return l.smartChunkManagement()
+}
+
 // calculateBoundingArea determines the range of data that should be pre-fetched
 // around the current viewport.
 func (l *List) calculateBoundingArea() core.BoundingArea {
@@ -1094,6 +1376,7 @@ func (l *List) unloadChunksOutsideBoundingArea() tea.Cmd {
 		delete(l.chunks, chunkStart)
 		delete(l.chunkAccessTime, chunkStart)
 		cmds = append(cmds, core.ChunkUnloadedCmd(chunkStart))
+		l.logChunkEvent(core.ChunkEvent{Type: core.ChunkEventUnloaded, StartIndex: chunkStart, Size: chunkSize})
 	}
 
 	return tea.Batch(cmds...)
@@ -1145,6 +1428,86 @@ func (l *List) toggleItemSelection(id string) tea.Cmd {
 	return nil
 }
 
+// advanceAfterSelect moves the cursor down one row after a selection when
+// Selection.MoveCursorOnSelect is enabled.
+func (l *List) advanceAfterSelect(selectCmd tea.Cmd) tea.Cmd {
+	selection := l.config.Selection
+	if !selection.MoveCursorOnSelect || selection.SelectOnCursorMove {
+		return selectCmd
+	}
+	return tea.Batch(selectCmd, l.handleCursorDown())
+}
+
+// selectOnCursorMove deselects the item the cursor left and selects the item it
+// landed on when Selection.SelectOnCursorMove is enabled.
+func (l *List) selectOnCursorMove(previousCursor int) tea.Cmd {
+	if !l.config.Selection.SelectOnCursorMove || l.config.SelectionMode == core.SelectionNone ||
+		l.dataSource == nil || l.viewport.CursorIndex == previousCursor || l.totalItems == 0 {
+		return nil
+	}
+
+	var cmds []tea.Cmd
+	if previousCursor >= 0 && previousCursor < l.totalItems {
+		cmds = append(cmds, l.dataSource.SetSelected(previousCursor, false))
+	}
+	cmds = append(cmds, l.dataSource.SetSelected(l.viewport.CursorIndex, true))
+
+	return tea.Batch(cmds...)
+}
+
+// changeCmds reports the changes made while handling msg: a cursor that moved
+// to another item with CursorChangedMsg and a new selection count with
+// SelectionChangedMsg.
+func (l *List) changeCmds(msg tea.Msg, previousCursor int) tea.Cmd {
+	var cmds []tea.Cmd
+	if l.viewport.CursorIndex != previousCursor {
+		var id string
+		if item, ok := l.getItemAtIndex(l.viewport.CursorIndex); ok {
+			id = item.ID
+		}
+		cmds = append(cmds, core.CursorChangedCmd(previousCursor, l.viewport.CursorIndex, id))
+	}
+	if count, changed := l.selectionChange(msg); changed {
+		cmds = append(cmds, core.SelectionChangedCmd(nil, nil, count))
+	}
+	return tea.Batch(cmds...)
+}
+
+// selectionChange reports the selection count when it changed. A
+// core.SelectionCounter is asked right after the DataSource answers a selection
+// operation; otherwise the count is taken from the chunks once those reloaded
+// for the operation arrive, so each change is reported once.
+func (l *List) selectionChange(msg tea.Msg) (int, bool) {
+	switch msg.(type) {
+	case core.SelectionResponseMsg:
+		if _, ok := l.dataSource.(core.SelectionCounter); !ok && len(l.chunks) > 0 {
+			l.selectionReloads += len(l.chunks)
+			return 0, false
+		}
+	case core.DataChunkLoadedMsg:
+		if l.selectionReloads == 0 {
+			return 0, false
+		}
+		if l.selectionReloads--; l.selectionReloads > 0 {
+			return 0, false
+		}
+	default:
+		return 0, false
+	}
+
+	var count int
+	if counter, ok := l.dataSource.(core.SelectionCounter); ok {
+		count = counter.GetSelectionCount()
+	} else {
+		count = data.GetSelectionCount(l.chunks)
+	}
+	if count == l.reportedSelection {
+		return count, false
+	}
+	l.reportedSelection = count
+	return count, true
+}
+
 // clearSelection deselects all currently selected items via the data source.
 func (l *List) clearSelection() {
 	if l.dataSource == nil {
@@ -1180,6 +1543,11 @@ func (l *List) unloadOldChunks() tea.Cmd {
 	var cmds []tea.Cmd
 	for _, chunkStart := range unloadedChunks {
 		cmds = append(cmds, core.ChunkUnloadedCmd(chunkStart))
+		l.logChunkEvent(core.ChunkEvent{
+			Type:       core.ChunkEventUnloaded,
+			StartIndex: chunkStart,
+			Size:       l.config.ViewportConfig.ChunkSize,
+		})
 	}
 
 	if len(cmds) > 0 {
@@ -1276,6 +1644,12 @@ func (l *List) smartChunkManagement() tea.Cmd {
 			// Emit chunk loading started message for observability
 			cmds = append(cmds, core.ChunkLoadingStartedCmd(chunkStart, request))
 			cmds = append(cmds, l.dataSource.LoadChunk(request))
+			l.logChunkEvent(core.ChunkEvent{
+				Type:       core.ChunkEventLoadStarted,
+				StartIndex: chunkStart,
+				Size:       request.Count,
+				Request:    request,
+			})
 		}
 	}
 
@@ -1284,6 +1658,7 @@ func (l *List) smartChunkManagement() tea.Cmd {
 		l.hasLoadingChunks = true
 		// Block scrolling if we're loading chunks that affect current viewport
 		l.canScroll = !l.isLoadingCriticalChunks()
+		cmds = append(cmds, l.startLoadingAnimation())
 	}
 
 	// Unload chunks outside bounding area
@@ -1292,11 +1667,17 @@ func (l *List) smartChunkManagement() tea.Cmd {
 		delete(l.chunks, chunkStart)
 		delete(l.chunkAccessTime, chunkStart)
 		cmds = append(cmds, core.ChunkUnloadedCmd(chunkStart))
+		l.logChunkEvent(core.ChunkEvent{Type: core.ChunkEventUnloaded, StartIndex: chunkStart, Size: chunkSize})
 	}
 
 	return tea.Batch(cmds...)
 }
 
+// logChunkEvent reports a chunk lifecycle event to the configured ChunkLogger.
+func (l *List) logChunkEvent(event core.ChunkEvent) {
+	data.ReportChunkEvent(l.config.ViewportConfig.ChunkLogger, l.chunkLoadStarted, event)
+}
+
 // isLoadingCriticalChunks checks if any chunks currently being loaded are
 // within the visible viewport.
 func (l *List) isLoadingCriticalChunks() bool {
@@ -1402,6 +1783,20 @@ func (l *List) SetEnumeratorAlignment(align bool) {
 	}
 }
 
+// SetBackgroundMode turns on the background styling in the given mode,
+// keeping the background style.
+func (l *List) SetBackgroundMode(mode core.ListBackgroundMode) {
+	l.config.RenderConfig.BackgroundConfig.Enabled = true
+	l.config.RenderConfig.BackgroundConfig.Mode = mode
+}
+
+// SetCursorIndicator sets the string shown before the item under the cursor.
+// The other items are indented by as many spaces as it is wide.
+func (l *List) SetCursorIndicator(indicator string) {
+	l.config.RenderConfig.CursorConfig.CursorIndicator = indicator
+	l.config.RenderConfig.CursorConfig.NormalSpacing = strings.Repeat(" ", lipgloss.Width(indicator))
+}
+
 // SetTextWrapping enables or disables text wrapping for item content.
 func (l *List) SetTextWrapping(wrap bool) {
 	l.config.RenderConfig.ContentConfig.WrapText = wrap
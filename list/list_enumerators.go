@@ -43,6 +43,39 @@ func ArabicEnumerator(item core.Data[any], index int, ctx core.RenderContext) st
 	return fmt.Sprintf("%d. ", index+1)
 }
 
+// NumberedEnumeratorConfig configures a NumberedEnumerator.
+type NumberedEnumeratorConfig struct {
+	// StartAt is the number of the first item. A zero StartAt numbers from 1
+	// unless ExplicitStart is set.
+	StartAt int
+	// ExplicitStart uses StartAt as given, so that a StartAt of 0 numbers
+	// from 0.
+	ExplicitStart bool
+	// UseAbsoluteIndex numbers the items by their position in the filtered
+	// data, so numbers carry on across chunks and while scrolling. Otherwise
+	// the item at the top of the viewport is numbered StartAt.
+	UseAbsoluteIndex bool
+	// Padding is the width the numbers are padded to with leading spaces, so
+	// the dots line up, e.g. 4 for lists of up to 9999 items.
+	Padding int
+}
+
+// NumberedEnumerator creates a `ListEnumerator` numbering items as configured
+// (e.g., "   1. ", " 500. "), from the index the `RenderContext` carries.
+func NumberedEnumerator(cfg NumberedEnumeratorConfig) core.ListEnumerator {
+	start := cfg.StartAt
+	if start == 0 && !cfg.ExplicitStart {
+		start = 1
+	}
+	return func(item core.Data[any], index int, ctx core.RenderContext) string {
+		position := ctx.ViewportIndex
+		if cfg.UseAbsoluteIndex {
+			position = ctx.ItemIndex
+		}
+		return fmt.Sprintf("%*d. ", cfg.Padding, start+position)
+	}
+}
+
 // AlphabetEnumerator is a `ListEnumerator` that creates an alphabetical list
 // (e.g., "a. ", "b. ", "z. ", "aa. "). It supports single, double, and triple
 // character representations for large lists.
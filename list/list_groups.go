@@ -0,0 +1,86 @@
+package list
+
+import (
+	"strings"
+)
+
+// renderGrouped renders the visible items with a header line above the first
+// item of each group. The header of the group at the top stays on the first
+// line, and leading items are dropped when the headers push the cursor item
+// past the viewport height.
+func (l *List) renderGrouped() string {
+	groupConfig := l.config.RenderConfig.GroupConfig
+
+	var keys, items []string
+	for i, item := range l.visibleItems {
+		if l.viewport.ViewportStartIndex+i >= l.totalItems {
+			break
+		}
+		keys = append(keys, groupConfig.GroupKeyFunc(item))
+		items = append(items, l.renderVisibleItem(item, i))
+	}
+	if len(items) == 0 {
+		return ""
+	}
+
+	header := func(key string) string {
+		if groupConfig.HeaderFormatter != nil {
+			key = groupConfig.HeaderFormatter(key)
+		}
+		return groupConfig.HeaderStyle.Render(key)
+	}
+
+	// Line count of each item, and whether it starts a new group
+	heights := make([]int, len(items))
+	starts := make([]bool, len(items))
+	for i, item := range items {
+		heights[i] = strings.Count(item, "\n") + 1
+		starts[i] = i > 0 && keys[i] != keys[i-1]
+	}
+
+	// The pinned header takes the first line
+	height := l.config.ViewportConfig.Height - 1
+	if height < 1 {
+		height = 1
+	}
+	cursor := l.viewport.CursorViewportIndex
+	if cursor < 0 || cursor >= len(items) {
+		cursor = 0
+	}
+
+	// Show items from as far up as fits with the cursor item. The first shown
+	// item's header is the pinned one, so moving up also reveals the header of
+	// the item that was first.
+	start, used := cursor, heights[cursor]
+	for start > 0 {
+		cost := heights[start-1]
+		if starts[start] {
+			cost++
+		}
+		if used+cost > height {
+			break
+		}
+		start--
+		used += cost
+	}
+
+	lines := []string{header(keys[start])}
+	l.lineItems = append(l.lineItems[:0], -1)
+	for i := start; i < len(items); i++ {
+		if i > start && keys[i] != keys[i-1] {
+			lines = append(lines, header(keys[i]))
+			l.lineItems = append(l.lineItems, -1)
+		}
+		lines = append(lines, strings.Split(items[i], "\n")...)
+		l.recordItemLines(l.viewport.ViewportStartIndex+i, items[i])
+		if len(lines) > height {
+			break
+		}
+	}
+	if len(lines) > height+1 {
+		lines = lines[:height+1]
+		l.lineItems = l.lineItems[:height+1]
+	}
+
+	return strings.Join(lines, "\n")
+}
@@ -0,0 +1,80 @@
+package list
+
+import (
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/davidroman0O/vtable/core"
+	"github.com/davidroman0O/vtable/viewport"
+)
+
+// doubleClickInterval is the longest delay between two clicks on the same item
+// that still counts as a double click
+const doubleClickInterval = 500 * time.Millisecond
+
+// mouseClick remembers the last click to detect double clicks
+type mouseClick struct {
+	index int
+	at    time.Time
+}
+
+// recordItemLines remembers that the lines of a rendered item belong to it
+func (l *List) recordItemLines(absoluteIndex int, rendered string) {
+	for range strings.Count(rendered, "\n") + 1 {
+		l.lineItems = append(l.lineItems, absoluteIndex)
+	}
+}
+
+// handleMouse moves the cursor to a clicked item, reports double clicks with
+// ItemActivatedMsg and turns the wheel into cursor movement. Clicks on group
+// headers are ignored.
+func (l *List) handleMouse(msg tea.MouseMsg) tea.Cmd {
+	if !l.config.MouseEnabled || !l.focused || msg.Action != tea.MouseActionPress {
+		return nil
+	}
+
+	switch msg.Button {
+	case tea.MouseButtonWheelUp:
+		return l.handleCursorUp()
+	case tea.MouseButtonWheelDown:
+		return l.handleCursorDown()
+	case tea.MouseButtonLeft:
+	default:
+		return nil
+	}
+
+	if msg.Y < 0 || msg.Y >= len(l.lineItems) || l.lineItems[msg.Y] < 0 {
+		return nil
+	}
+	index := l.lineItems[msg.Y]
+
+	now := time.Now()
+	double := l.lastClick.index == index && now.Sub(l.lastClick.at) <= doubleClickInterval
+	l.lastClick = mouseClick{index: index, at: now}
+
+	l.moveCursorInView(index)
+	if !double {
+		return nil
+	}
+
+	// A third click starts a new double click
+	l.lastClick = mouseClick{index: -1}
+	var id string
+	if item, ok := l.getItemAtIndex(index); ok {
+		id = item.ID
+	}
+	return core.ItemActivatedCmd(index, id)
+}
+
+// moveCursorInView moves the cursor to an item of the current viewport without
+// scrolling it
+func (l *List) moveCursorInView(index int) {
+	if index < l.viewport.ViewportStartIndex || index >= l.totalItems || index == l.viewport.CursorIndex {
+		return
+	}
+	l.viewport.CursorIndex = index
+	l.viewport.CursorViewportIndex = index - l.viewport.ViewportStartIndex
+	l.viewport = viewport.UpdateViewportBounds(l.viewport, l.config.ViewportConfig, l.totalItems)
+}
@@ -276,15 +276,26 @@ func (c *ListContentComponent) Render(ctx core.ListComponentContext) string {
 			// For styled text: use lipgloss for ANSI-aware width measurement
 			measureWidth = lipgloss.Width
 			truncateFunc = ansiTruncateList
+			if c.config.TruncateSide == core.ListTruncateLeft {
+				truncateFunc = ansiTruncateLeftList
+			}
 		} else {
 			// For plain text: use runewidth for proper Unicode handling
 			measureWidth = runewidth.StringWidth
 			truncateFunc = ansiTruncateWithRunewidthList
+			if c.config.TruncateSide == core.ListTruncateLeft {
+				truncateFunc = truncateLeftWithRunewidthList
+			}
+		}
+
+		marker := c.config.TruncateMarker
+		if marker == "" {
+			marker = "..."
 		}
 
 		// Check if we need to truncate
 		if measureWidth(content) > c.config.MaxWidth {
-			content = truncateFunc(content, c.config.MaxWidth, "...")
+			content = truncateFunc(content, c.config.MaxWidth, marker)
 		}
 	}
 
@@ -830,3 +841,105 @@ func ansiTruncateWithRunewidthList(text string, maxWidth int, suffix string) str
 	result.WriteString(suffix)
 	return result.String()
 }
+
+// ansiTruncateLeftList cuts the beginning of styled text so the end stays visible.
+// The marker is placed before any escape sequences, and the escape sequences that
+// were cut are kept so the remaining text keeps its styling.
+func ansiTruncateLeftList(text string, maxWidth int, marker string) string {
+	if maxWidth <= 0 {
+		return ""
+	}
+
+	if lipgloss.Width(text) <= maxWidth {
+		return text
+	}
+
+	markerWidth := lipgloss.Width(marker)
+	if maxWidth <= markerWidth {
+		// If there's no room for content, just return dots
+		return strings.Repeat(".", maxWidth)
+	}
+
+	// Split into escape sequences and visible runes
+	type token struct {
+		text   string
+		isANSI bool
+		width  int
+	}
+	var tokens []token
+	runes := []rune(text)
+	for i := 0; i < len(runes); i++ {
+		if runes[i] == '\x1b' {
+			start := i
+			for i < len(runes) && runes[i] != 'm' {
+				i++
+			}
+			end := i + 1
+			if end > len(runes) {
+				end = len(runes)
+			}
+			tokens = append(tokens, token{text: string(runes[start:end]), isANSI: true})
+			continue
+		}
+		tokens = append(tokens, token{text: string(runes[i]), width: runewidth.RuneWidth(runes[i])})
+	}
+
+	// Walk backwards to find the first visible token that still fits
+	targetWidth := maxWidth - markerWidth
+	currentWidth := 0
+	cut := len(tokens)
+	for i := len(tokens) - 1; i >= 0; i-- {
+		if tokens[i].isANSI {
+			continue
+		}
+		if currentWidth+tokens[i].width > targetWidth {
+			break
+		}
+		currentWidth += tokens[i].width
+		cut = i
+	}
+
+	var result strings.Builder
+	result.WriteString(marker)
+	for i, tok := range tokens {
+		// Keep escape sequences from the cut part so styling carries over
+		if i < cut && !tok.isANSI {
+			continue
+		}
+		result.WriteString(tok.text)
+	}
+	return result.String()
+}
+
+// truncateLeftWithRunewidthList cuts the beginning of plain text so the end stays visible
+func truncateLeftWithRunewidthList(text string, maxWidth int, marker string) string {
+	if maxWidth <= 0 {
+		return ""
+	}
+
+	if runewidth.StringWidth(text) <= maxWidth {
+		return text
+	}
+
+	markerWidth := runewidth.StringWidth(marker)
+	if maxWidth <= markerWidth {
+		// If there's no room for content, just return dots
+		return strings.Repeat(".", maxWidth)
+	}
+
+	// Keep as many trailing runes as fit next to the marker
+	targetWidth := maxWidth - markerWidth
+	runes := []rune(text)
+	currentWidth := 0
+	start := len(runes)
+	for i := len(runes) - 1; i >= 0; i-- {
+		charWidth := runewidth.RuneWidth(runes[i])
+		if currentWidth+charWidth > targetWidth {
+			break
+		}
+		currentWidth += charWidth
+		start = i
+	}
+
+	return marker + string(runes[start:])
+}
@@ -0,0 +1,103 @@
+package list
+
+import (
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/davidroman0O/vtable/core"
+	"github.com/davidroman0O/vtable/data"
+)
+
+// restoredCursorMsg reports where a data source scan found the cursor item of
+// a restored state, or an index of -1 when it is no longer in the data.
+type restoredCursorMsg struct {
+	id    string
+	index int
+	err   error
+}
+
+// SaveState captures the view state of the list: the cursor item, sorting,
+// filters and selection. Selected IDs come from the data source when it
+// implements GetSelectedIDs, otherwise from the loaded items.
+func (l *List) SaveState() core.PersistedState {
+	state := core.PersistedState{
+		CursorIndex:    l.viewport.CursorIndex,
+		SortFields:     append([]string(nil), l.sortFields...),
+		SortDirections: append([]string(nil), l.sortDirs...),
+		Filters:        core.PersistFilters(l.filters),
+	}
+
+	if item, ok := l.getItemAtIndex(l.viewport.CursorIndex); ok {
+		state.CursorID = item.ID
+	}
+
+	if source, ok := l.dataSource.(interface{ GetSelectedIDs() []string }); ok {
+		state.SelectedIDs = source.GetSelectedIDs()
+	} else {
+		for _, chunk := range l.chunks {
+			for _, item := range chunk.Items {
+				if item.Selected {
+					state.SelectedIDs = append(state.SelectedIDs, item.ID)
+				}
+			}
+		}
+	}
+
+	return state
+}
+
+// RestoreState applies a state saved with SaveState and reloads the data. The
+// cursor returns to the saved item, found by ID with the restored sort and
+// filters; when the item is gone it stays at the saved index, clamped to the
+// items.
+func (l *List) RestoreState(state core.PersistedState) tea.Cmd {
+	return core.StateRestoreCmd(state)
+}
+
+// handleStateRestore applies a saved state. The selection is replaced through
+// the data source before the data reloads.
+func (l *List) handleStateRestore(state core.PersistedState) tea.Cmd {
+	l.sortFields = append([]string(nil), state.SortFields...)
+	l.sortDirs = append([]string(nil), state.SortDirections...)
+	l.filters = core.RestoreFilters(state.Filters)
+	l.pendingCursorIndex = state.CursorIndex
+	l.pendingCursorID = state.CursorID
+
+	reload := l.handleDataRefresh()
+	if l.dataSource != nil && l.config.SelectionMode != core.SelectionNone {
+		sequence := []tea.Cmd{l.dataSource.ClearSelection()}
+		for _, id := range state.SelectedIDs {
+			sequence = append(sequence, l.dataSource.SetSelectedByID(id, true))
+		}
+		reload = tea.Sequence(append(sequence, reload)...)
+	}
+	return reload
+}
+
+// locateRestoredCursor returns a command scanning the data source for the
+// cursor item of a restored state, once its total is known.
+func (l *List) locateRestoredCursor() tea.Cmd {
+	id := l.pendingCursorID
+	if id == "" {
+		return nil
+	}
+
+	request := data.CreateDataRequest(0, 0, l.sortFields, l.sortDirs, l.filters)
+	dataSource, chunkSize := l.dataSource, l.config.ViewportConfig.ChunkSize
+	return func() tea.Msg {
+		index, err := data.ScanForItemIndex(dataSource, request, chunkSize, id)
+		return restoredCursorMsg{id: id, index: index, err: err}
+	}
+}
+
+// handleRestoredCursor moves the cursor to the item found by the scan, unless
+// another state was restored meanwhile.
+func (l *List) handleRestoredCursor(msg restoredCursorMsg) tea.Cmd {
+	if msg.id != l.pendingCursorID {
+		return nil
+	}
+	l.pendingCursorID = ""
+	if msg.err != nil {
+		return core.DataLoadErrorCmd(msg.err)
+	}
+	return l.handleJumpTo(msg.index)
+}
@@ -0,0 +1,301 @@
+package list
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/muesli/termenv"
+
+	"github.com/davidroman0O/vtable/config"
+	"github.com/davidroman0O/vtable/core"
+	"github.com/davidroman0O/vtable/testutil"
+)
+
+// testListSource serves a fixed slice of strings, with IDs "item-<index>"
+type testListSource struct {
+	items    []string
+	selected map[string]bool
+}
+
+func newTestListSource(count int) *testListSource {
+	source := &testListSource{selected: make(map[string]bool)}
+	for i := 0; i < count; i++ {
+		source.items = append(source.items, fmt.Sprintf("Item %d", i+1))
+	}
+	return source
+}
+
+func (s *testListSource) LoadChunk(request core.DataRequest) tea.Cmd {
+	return func() tea.Msg {
+		var items []core.Data[any]
+		for i := request.Start; i < request.Start+request.Count && i < len(s.items); i++ {
+			id := fmt.Sprintf("item-%d", i)
+			items = append(items, core.Data[any]{ID: id, Item: s.items[i], Selected: s.selected[id]})
+		}
+		return core.DataChunkLoadedMsg{StartIndex: request.Start, Items: items, Request: request}
+	}
+}
+
+func (s *testListSource) GetTotal() tea.Cmd {
+	return func() tea.Msg { return core.DataTotalMsg{Total: len(s.items)} }
+}
+
+func (s *testListSource) RefreshTotal() tea.Cmd { return s.GetTotal() }
+
+func (s *testListSource) SetSelected(index int, selected bool) tea.Cmd {
+	return s.SetSelectedByID(fmt.Sprintf("item-%d", index), selected)
+}
+
+func (s *testListSource) SetSelectedByID(id string, selected bool) tea.Cmd {
+	return func() tea.Msg {
+		s.selected[id] = selected
+		return core.SelectionResponseMsg{Success: true, ID: id, Selected: selected}
+	}
+}
+
+func (s *testListSource) SelectAll() tea.Cmd                           { return nil }
+func (s *testListSource) ClearSelection() tea.Cmd                      { return nil }
+func (s *testListSource) SelectRange(startIndex, endIndex int) tea.Cmd { return nil }
+func (s *testListSource) GetItemID(item any) string                    { return "" }
+
+// createTestList returns an initialized list over source, 5 lines high
+func createTestList(source *testListSource) *List {
+	listConfig := config.DefaultListConfig()
+	listConfig.ViewportConfig.Height = 5
+	listConfig.ViewportConfig.ChunkSize = 20
+	l := NewList(listConfig, source)
+	testutil.Drive(l, l.Init())
+	return l
+}
+
+// send updates the list with a command's messages and the commands they lead to
+func send(l *List, cmd tea.Cmd) {
+	testutil.Drive(l, cmd)
+}
+
+// viewLines returns the lines of the view without styling or trailing spaces
+func viewLines(l *List) []string {
+	lines := strings.Split(core.StripANSI(l.View()), "\n")
+	for i, line := range lines {
+		lines[i] = strings.TrimRight(line, " ")
+	}
+	return lines
+}
+
+func TestListContent_Truncation(t *testing.T) {
+	previousProfile := lipgloss.ColorProfile()
+	lipgloss.SetColorProfile(termenv.TrueColor)
+	defer lipgloss.SetColorProfile(previousProfile)
+
+	red := lipgloss.NewStyle().Foreground(lipgloss.Color("#ff0000"))
+	tests := []struct {
+		name     string
+		content  string
+		config   core.ListContentConfig
+		expected string
+	}{
+		{"right", "Hello wonderful world", core.ListContentConfig{MaxWidth: 10, TruncateMarker: "…"}, "Hello won…"},
+		{"left", "Hello wonderful world", core.ListContentConfig{MaxWidth: 10, TruncateMarker: "…", TruncateSide: core.ListTruncateLeft}, "…ful world"},
+		{"default marker", "Hello wonderful world", core.ListContentConfig{MaxWidth: 10}, "Hello w..."},
+		{"fits", "Hello", core.ListContentConfig{MaxWidth: 10, TruncateMarker: "…"}, "Hello"},
+		{"wide runes right", "日本語のテキスト", core.ListContentConfig{MaxWidth: 7, TruncateMarker: "…"}, "日本語…"},
+		{"wide runes left", "日本語のテキスト", core.ListContentConfig{MaxWidth: 7, TruncateMarker: "…", TruncateSide: core.ListTruncateLeft}, "…キスト"},
+		{"styled right", red.Render("Hello wonderful world"), core.ListContentConfig{MaxWidth: 10, TruncateMarker: "…"}, "Hello won…"},
+		{"styled left", red.Render("Hello wonderful world"), core.ListContentConfig{MaxWidth: 10, TruncateMarker: "…", TruncateSide: core.ListTruncateLeft}, "…ful world"},
+		{"wrapping ignores the marker", "Hello wonderful world", core.ListContentConfig{MaxWidth: 10, TruncateMarker: "…", WrapText: true}, "Hello\nwonderful\nworld"},
+	}
+
+	renderContext := createTestList(newTestListSource(1)).renderContext
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := tt.config
+			cfg.Formatter = func(item core.Data[any], index int, ctx core.RenderContext, isCursor, isTopThreshold, isBottomThreshold bool) string {
+				return tt.content
+			}
+			got := NewListContentComponent(cfg).Render(core.ListComponentContext{RenderContext: renderContext})
+			// The marker lands outside the escape sequences of styled content,
+			// which keeps its styling
+			if plain := core.StripANSI(got); plain != tt.expected {
+				t.Errorf("Expected %q, got %q", tt.expected, plain)
+			}
+			if strings.Contains(tt.content, "\x1b[") != strings.Contains(got, "\x1b[") {
+				t.Errorf("Expected the styling of the content kept, got %q", got)
+			}
+		})
+	}
+}
+
+func TestList_StickyGroupHeaders(t *testing.T) {
+	l := createTestList(newTestListSource(20))
+	l.config.RenderConfig.GroupConfig.GroupKeyFunc = func(item core.Data[any]) string {
+		var number int
+		fmt.Sscanf(item.Item.(string), "Item %d", &number)
+		return fmt.Sprintf("Group %d", (number-1)/4+1)
+	}
+	l.config.RenderConfig.GroupConfig.HeaderFormatter = func(key string) string {
+		return "== " + key + " =="
+	}
+
+	// Moving down never lands on a header: the cursor index stays the data
+	// index, and the first line always holds the group of the first item
+	check := func(cursor int) {
+		t.Helper()
+		lines := viewLines(l)
+		if len(lines) != 5 {
+			t.Fatalf("Expected 5 lines, got:\n%s", strings.Join(lines, "\n"))
+		}
+		if got := l.GetState().CursorIndex; got != cursor {
+			t.Errorf("Expected the cursor on data index %d, got %d", cursor, got)
+		}
+		if !slices.Contains(lines, fmt.Sprintf("► Item %d", cursor+1)) {
+			t.Errorf("Expected the cursor on Item %d, got:\n%s", cursor+1, strings.Join(lines, "\n"))
+		}
+		var first int
+		fmt.Sscanf(strings.TrimSpace(lines[1]), "Item %d", &first)
+		if want := fmt.Sprintf("== Group %d ==", (first-1)/4+1); lines[0] != want {
+			t.Errorf("Expected %q pinned above Item %d, got:\n%s", want, first, strings.Join(lines, "\n"))
+		}
+	}
+	for cursor := 0; cursor < 10; cursor++ {
+		check(cursor)
+		send(l, core.CursorDownCmd())
+	}
+	for cursor := 10; cursor > 2; cursor-- {
+		check(cursor)
+		send(l, core.CursorUpCmd())
+	}
+}
+
+func TestList_RenderConfigCommands(t *testing.T) {
+	tests := []struct {
+		name   string
+		cmd    tea.Cmd
+		manual func(config *core.ListRenderConfig)
+	}{
+		{
+			name: "background mode",
+			cmd:  core.ListBackgroundModeSetCmd(core.ListBackgroundContentOnly),
+			manual: func(config *core.ListRenderConfig) {
+				config.BackgroundConfig.Enabled = true
+				config.BackgroundConfig.Mode = core.ListBackgroundContentOnly
+			},
+		},
+		{
+			name: "cursor indicator",
+			cmd:  core.ListCursorIndicatorSetCmd("→ "),
+			manual: func(config *core.ListRenderConfig) {
+				config.CursorConfig.CursorIndicator = "→ "
+				config.CursorConfig.NormalSpacing = "  "
+			},
+		},
+		{
+			name: "no cursor indicator",
+			cmd:  core.ListCursorIndicatorSetCmd(""),
+			manual: func(config *core.ListRenderConfig) {
+				config.CursorConfig.CursorIndicator = ""
+				config.CursorConfig.NormalSpacing = ""
+			},
+		},
+		{
+			name: "enumerator alignment",
+			cmd:  core.ListEnumeratorAlignmentSetCmd(core.ListAlignmentRight, 8),
+			manual: func(config *core.ListRenderConfig) {
+				config.EnumeratorConfig.Alignment = core.ListAlignmentRight
+				config.EnumeratorConfig.MaxWidth = 8
+			},
+		},
+		{
+			name: "text wrapping",
+			cmd:  core.ListTextWrapEnableCmd(true),
+			manual: func(config *core.ListRenderConfig) {
+				config.ContentConfig.WrapText = true
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			manual := createTestList(newTestListSource(10))
+			manual.SetEnumerator(ArabicEnumerator)
+			config := manual.GetRenderConfig()
+			tt.manual(&config)
+			manual.SetRenderConfig(config)
+
+			commanded := createTestList(newTestListSource(10))
+			commanded.SetEnumerator(ArabicEnumerator)
+			send(commanded, tt.cmd)
+
+			want, got := manual.GetRenderConfig(), commanded.GetRenderConfig()
+			if want.BackgroundConfig.Enabled != got.BackgroundConfig.Enabled || want.BackgroundConfig.Mode != got.BackgroundConfig.Mode {
+				t.Errorf("Expected background %+v, got %+v", want.BackgroundConfig, got.BackgroundConfig)
+			}
+			if want.CursorConfig.CursorIndicator != got.CursorConfig.CursorIndicator || want.CursorConfig.NormalSpacing != got.CursorConfig.NormalSpacing {
+				t.Errorf("Expected cursor indicator %q and spacing %q, got %q and %q",
+					want.CursorConfig.CursorIndicator, want.CursorConfig.NormalSpacing, got.CursorConfig.CursorIndicator, got.CursorConfig.NormalSpacing)
+			}
+			if want.EnumeratorConfig.Alignment != got.EnumeratorConfig.Alignment || want.EnumeratorConfig.MaxWidth != got.EnumeratorConfig.MaxWidth {
+				t.Errorf("Expected enumerator alignment %v within %d, got %v within %d",
+					want.EnumeratorConfig.Alignment, want.EnumeratorConfig.MaxWidth, got.EnumeratorConfig.Alignment, got.EnumeratorConfig.MaxWidth)
+			}
+			if want.ContentConfig.WrapText != got.ContentConfig.WrapText {
+				t.Errorf("Expected text wrapping %v, got %v", want.ContentConfig.WrapText, got.ContentConfig.WrapText)
+			}
+			if manual.View() != commanded.View() {
+				t.Errorf("Expected the same view, got:\n%s\nand:\n%s", manual.View(), commanded.View())
+			}
+		})
+	}
+}
+
+func TestNumberedEnumerator_AbsoluteIndex(t *testing.T) {
+	l := createTestList(newTestListSource(1000))
+	l.SetEnumerator(NumberedEnumerator(NumberedEnumeratorConfig{UseAbsoluteIndex: true, Padding: 4}))
+
+	// Numbers carry on across chunks instead of restarting
+	send(l, core.JumpToCmd(499))
+	lines := viewLines(l)
+	if !strings.Contains(strings.Join(lines, "\n"), "►  500. Item 500") {
+		t.Errorf("Expected item 500 numbered 500., got:\n%s", strings.Join(lines, "\n"))
+	}
+
+	// Padding lines the dots up from 1 to 1000
+	send(l, core.JumpToStartCmd())
+	first := viewLines(l)[0]
+	send(l, core.JumpToEndCmd())
+	last := viewLines(l)[4]
+	if !strings.HasSuffix(first, "   1. Item 1") || !strings.HasSuffix(last, "1000. Item 1000") {
+		t.Fatalf("Expected the first and last items numbered, got %q and %q", first, last)
+	}
+	if strings.Index(first, ".") != strings.Index(last, ".") {
+		t.Errorf("Expected the dots of 1 and 1000 aligned, got %q and %q", first, last)
+	}
+}
+
+func TestNumberedEnumerator(t *testing.T) {
+	tests := []struct {
+		name     string
+		cfg      NumberedEnumeratorConfig
+		ctx      core.RenderContext
+		expected string
+	}{
+		{"defaults to 1", NumberedEnumeratorConfig{}, core.RenderContext{ItemIndex: 9, ViewportIndex: 0}, "1. "},
+		{"absolute index", NumberedEnumeratorConfig{UseAbsoluteIndex: true}, core.RenderContext{ItemIndex: 499, ViewportIndex: 2}, "500. "},
+		{"start at", NumberedEnumeratorConfig{StartAt: 10, UseAbsoluteIndex: true}, core.RenderContext{ItemIndex: 2}, "12. "},
+		{"zero ignored without explicit start", NumberedEnumeratorConfig{StartAt: 0}, core.RenderContext{ViewportIndex: 0}, "1. "},
+		{"explicit zero start", NumberedEnumeratorConfig{StartAt: 0, ExplicitStart: true}, core.RenderContext{ViewportIndex: 0}, "0. "},
+		{"padding", NumberedEnumeratorConfig{UseAbsoluteIndex: true, Padding: 4}, core.RenderContext{ItemIndex: 0}, "   1. "},
+		{"padding at the width", NumberedEnumeratorConfig{UseAbsoluteIndex: true, Padding: 4}, core.RenderContext{ItemIndex: 999}, "1000. "},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := NumberedEnumerator(tt.cfg)(core.Data[any]{}, 0, tt.ctx); got != tt.expected {
+				t.Errorf("Expected %q, got %q", tt.expected, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,50 @@
+package list
+
+import (
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/davidroman0O/vtable/core"
+	"github.com/davidroman0O/vtable/data"
+)
+
+// IsIndexLoading reports whether the item at index belongs to a chunk that has
+// been requested from the data source and has not arrived yet.
+func (l *List) IsIndexLoading(index int) bool {
+	return data.IsIndexLoading(index, l.config.ViewportConfig.ChunkSize, l.loadingChunks)
+}
+
+// startLoadingAnimation schedules the first LoadingTickMsg when placeholders
+// are animated and no tick is pending.
+func (l *List) startLoadingAnimation() tea.Cmd {
+	interval := l.config.ViewportConfig.LoadingAnimationInterval
+	if interval <= 0 || l.loadingTicking {
+		return nil
+	}
+	l.loadingTicking = true
+	return core.LoadingTickCmd(interval)
+}
+
+// handleLoadingTick advances the loading animation and keeps ticking while
+// chunks are loading.
+func (l *List) handleLoadingTick() tea.Cmd {
+	l.loadingTicking = false
+	if !l.hasLoadingChunks {
+		return nil
+	}
+	l.renderContext.LoadingFrame++
+	return l.startLoadingAnimation()
+}
+
+// renderLoadingPlaceholder renders the line of an item that is not loaded:
+// the ViewportConfig.LoadingPlaceholder while its chunk is loading, and an
+// empty line otherwise.
+func (l *List) renderLoadingPlaceholder(index int) string {
+	if !l.IsIndexLoading(index) {
+		return ""
+	}
+	placeholder := l.config.ViewportConfig.LoadingPlaceholder
+	if placeholder == nil {
+		placeholder = core.DefaultLoadingPlaceholder
+	}
+	return placeholder(index, l.renderContext)
+}
@@ -0,0 +1,45 @@
+// Package render provides a collection of utility functions for rendering vtable
+// components. It encapsulates common rendering logic, such as applying styles,
+// formatting content, and handling different item states (e.g., loading, error,
+// selected). This package promotes consistency and simplifies the rendering
+// process within individual components like List and Table.
+package render
+
+import (
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+)
+
+// Characters drawn for the scrollbar track and thumb
+const (
+	ScrollbarTrackChar = "░"
+	ScrollbarThumbChar = "█"
+)
+
+// AppendScrollbar draws a vertical scrollbar to the right of content, one
+// character per line. Lines are padded to the width of the widest one so the
+// scrollbar forms a straight column. The thumb covers length lines starting at
+// offset, and the remaining lines show the track.
+func AppendScrollbar(content string, offset, length int, trackStyle, thumbStyle lipgloss.Style) string {
+	lines := strings.Split(content, "\n")
+
+	width := 0
+	for _, line := range lines {
+		width = max(width, lipgloss.Width(line))
+	}
+
+	track := trackStyle.Render(ScrollbarTrackChar)
+	thumb := thumbStyle.Render(ScrollbarThumbChar)
+	for i, line := range lines {
+		if pad := width - lipgloss.Width(line); pad > 0 {
+			line += strings.Repeat(" ", pad)
+		}
+		if i >= offset && i < offset+length {
+			lines[i] = line + thumb
+		} else {
+			lines[i] = line + track
+		}
+	}
+	return strings.Join(lines, "\n")
+}
@@ -0,0 +1,99 @@
+package table
+
+import (
+	"slices"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/davidroman0O/vtable/core"
+)
+
+// AutoSizeColumns fits each column's width to the widest of its header and
+// its cells, within the column's MinWidth and MaxWidth, so short columns don't
+// waste space. Widths are measured in display cells, so wide characters and
+// emoji count double, and a sortable header keeps room for its sort arrow.
+//
+// For performance only the rows of the first loaded chunk are sampled, never
+// the whole data source: a longer value further down is truncated as usual.
+// When no rows are loaded yet, or a load is in flight, the columns are sized
+// once it completes. It emits ColumnsAutoSizedMsg.
+func (t *Table) AutoSizeColumns() tea.Cmd {
+	return core.ColumnsAutoSizeCmd()
+}
+
+// ResampleAutoSize sizes the columns again like AutoSizeColumns, from the rows
+// loaded now, e.g. after a refresh brought in very different data. Call it
+// after the refresh: while its chunks are loading, the sample is taken once
+// they have arrived.
+func (t *Table) ResampleAutoSize() tea.Cmd {
+	return core.ColumnsAutoSizeCmd()
+}
+
+// handleAutoSizeColumns sizes the configured columns from the header titles
+// and the sampled rows, or defers sizing until rows are loaded
+func (t *Table) handleAutoSizeColumns() tea.Cmd {
+	sample, ok := t.autoSizeSample()
+	if !ok {
+		t.pendingAutoSize = true
+		return nil
+	}
+	t.pendingAutoSize = false
+
+	// The displayed columns may alias the configured ones; copy before editing
+	columns := slices.Clone(t.config.Columns)
+	widths := make([]int, len(columns))
+	for i, col := range columns {
+		width := t.headerAutoWidth(col)
+		for _, row := range sample {
+			if i < len(row.Cells) {
+				width = max(width, lipgloss.Width(row.Cells[i]))
+			}
+		}
+		columns[i].Width = clampColumnWidth(col, width)
+		widths[i] = columns[i].Width
+	}
+	t.config.Columns = columns
+	t.applyOverflowStrategy()
+
+	return core.ColumnsAutoSizedCmd(widths)
+}
+
+// autoSizeSample returns the data rows of the first loaded chunk, with their
+// cells in column order. It fails while chunks are loading or none is loaded.
+func (t *Table) autoSizeSample() ([]core.TableRow, bool) {
+	if t.hasLoadingChunks || len(t.chunks) == 0 {
+		return nil, false
+	}
+
+	first := -1
+	for start := range t.chunks {
+		if first < 0 || start < first {
+			first = start
+		}
+	}
+
+	var rows []core.TableRow
+	for _, item := range t.chunks[first].Items {
+		if row, ok := item.Item.(core.TableRow); ok && row.Kind == core.TableRowData {
+			rows = append(rows, row)
+		}
+	}
+	return rows, true
+}
+
+// headerAutoWidth returns the width a column's header needs, including the
+// room of the sort arrow the header shows once its field is sorted
+func (t *Table) headerAutoWidth(col core.TableColumn) int {
+	width := lipgloss.Width(col.Title)
+	if col.Field == "" {
+		return width
+	}
+
+	// A multi-column sort numbers the arrows of the styled indicators
+	indicator := "↑"
+	if sorted := t.sortIndicator(col.Field); t.config.ShowSortIndicators && sorted != "" {
+		indicator = sorted
+	}
+	return width + 1 + lipgloss.Width(indicator)
+}
@@ -0,0 +1,158 @@
+package table
+
+import (
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/mattn/go-runewidth"
+
+	"github.com/davidroman0O/vtable/core"
+)
+
+// cellEditor holds the state of an inline cell edit
+type cellEditor struct {
+	rowID    string
+	column   int
+	field    string
+	original string
+	value    []rune
+	cursor   int // Rune position of the caret in value
+}
+
+// handleCellEditStart opens the editor on the active cell of the data row
+// under the cursor, prefilled with the cell's raw value
+func (t *Table) handleCellEditStart() tea.Cmd {
+	column := t.currentColumn
+	if column < 0 || column >= len(t.columns) || t.hiddenColumns[column] {
+		return nil
+	}
+
+	item, ok := t.getItemAtIndex(t.viewport.CursorIndex)
+	if !ok || item.Disabled {
+		return nil
+	}
+	row, ok := item.Item.(core.TableRow)
+	if !ok || row.Kind != core.TableRowData {
+		return nil
+	}
+
+	var value string
+	if column < len(row.Cells) {
+		value = row.Cells[column]
+	}
+
+	t.cellEditor = &cellEditor{
+		rowID:    row.ID,
+		column:   column,
+		field:    t.columns[column].Field,
+		original: value,
+		value:    []rune(value),
+		cursor:   len([]rune(value)),
+	}
+	return nil
+}
+
+// handleCellEditKey edits the open cell editor. It captures every key so
+// navigation and Tab do not leak to the table while typing.
+func (t *Table) handleCellEditKey(msg tea.KeyMsg) tea.Cmd {
+	editor := t.cellEditor
+
+	switch msg.Type {
+	case tea.KeyEsc:
+		t.cellEditor = nil
+	case tea.KeyEnter:
+		return t.commitCellEdit()
+	case tea.KeyLeft:
+		if editor.cursor > 0 {
+			editor.cursor--
+		}
+	case tea.KeyRight:
+		if editor.cursor < len(editor.value) {
+			editor.cursor++
+		}
+	case tea.KeyHome, tea.KeyCtrlA:
+		editor.cursor = 0
+	case tea.KeyEnd, tea.KeyCtrlE:
+		editor.cursor = len(editor.value)
+	case tea.KeyBackspace:
+		if editor.cursor > 0 {
+			editor.value = append(editor.value[:editor.cursor-1], editor.value[editor.cursor:]...)
+			editor.cursor--
+		}
+	case tea.KeyDelete:
+		if editor.cursor < len(editor.value) {
+			editor.value = append(editor.value[:editor.cursor], editor.value[editor.cursor+1:]...)
+		}
+	case tea.KeySpace:
+		editor.insert([]rune{' '})
+	case tea.KeyRunes:
+		editor.insert(msg.Runes)
+	}
+
+	return nil
+}
+
+// insert inserts runes at the caret
+func (e *cellEditor) insert(runes []rune) {
+	value := make([]rune, 0, len(e.value)+len(runes))
+	value = append(value, e.value[:e.cursor]...)
+	value = append(value, runes...)
+	value = append(value, e.value[e.cursor:]...)
+	e.value = value
+	e.cursor += len(runes)
+}
+
+// commitCellEdit closes the editor and, when the value changed, reports the
+// edit with core.CellEditCommitMsg. An EditableDataSource also stores the value,
+// after which the visible chunks are reloaded to show it.
+func (t *Table) commitCellEdit() tea.Cmd {
+	editor := t.cellEditor
+	t.cellEditor = nil
+
+	newValue := string(editor.value)
+	if newValue == editor.original {
+		return nil
+	}
+
+	commitCmd := core.CellEditCommitCmd(editor.rowID, editor.field, editor.original, newValue)
+	if editable, ok := t.idSource().(core.EditableDataSource); ok {
+		return tea.Batch(
+			commitCmd,
+			tea.Sequence(editable.SetCellValue(editor.rowID, editor.field, newValue), core.DataChunksRefreshCmd()),
+		)
+	}
+	return commitCmd
+}
+
+// isEditingCell reports whether the editor is open on a cell
+func (t *Table) isEditingCell(rowID string, column int) bool {
+	return t.cellEditor != nil && t.cellEditor.rowID == rowID && t.cellEditor.column == column
+}
+
+// renderCellEditor renders the editor in place of its cell, fitted to the
+// column width and aligned like the column, keeping the caret in view
+func (t *Table) renderCellEditor(col core.TableColumn) string {
+	editor := t.cellEditor
+	before := editor.value[:editor.cursor]
+	for len(before) > 0 && runewidth.StringWidth(string(before))+1 > col.Width {
+		before = before[1:]
+	}
+	text := runewidth.Truncate(string(before)+"▏"+string(editor.value[editor.cursor:]), col.Width, "")
+
+	constrained := t.applyCellConstraints(text, core.CellConstraint{
+		Width:     col.Width,
+		Height:    1,
+		Alignment: core.ColumnAlignment(col),
+	}, -1)
+
+	return lipgloss.NewStyle().Underline(true).Render(constrained)
+}
+
+// EditCell starts editing the active cell of the row under the cursor
+func (t *Table) EditCell() tea.Cmd {
+	return core.CellEditStartCmd()
+}
+
+// IsEditingCell returns whether an inline cell edit is open
+func (t *Table) IsEditingCell() bool {
+	return t.cellEditor != nil
+}
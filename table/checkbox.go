@@ -0,0 +1,130 @@
+package table
+
+import (
+	"maps"
+	"slices"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/davidroman0O/vtable/core"
+)
+
+// Checkbox marks rendered in the indicator column with ShowCheckboxColumn
+const (
+	checkboxUnchecked     = "[ ]"
+	checkboxChecked       = "[x]"
+	checkboxIndeterminate = "[-]"
+)
+
+// renderCheckboxIndicator renders the indicator column as the cursor mark
+// followed by the row's checkbox, styled with the theme's CheckboxStyle on top
+// of the indicator style. Subtotal and total rows get no checkbox.
+func (t *Table) renderCheckboxIndicator(item core.Data[any], row core.TableRow, absoluteIndex int, isCursor bool, style lipgloss.Style) string {
+	mark := " "
+	if isCursor {
+		mark = "►"
+	}
+
+	box := "   "
+	switch row.Kind {
+	case core.TableRowData:
+		box = checkboxUnchecked
+		if item.Selected {
+			box = checkboxChecked
+		}
+	case core.TableRowGroupHeader:
+		box = checkboxUnchecked
+		if selected, total := t.groupSelection(absoluteIndex, row.Level); selected > 0 && selected == total {
+			box = checkboxChecked
+		} else if selected > 0 {
+			box = checkboxIndeterminate
+		}
+	}
+
+	return style.Render(mark) + t.config.Theme.CheckboxStyle.Inherit(style).Render(box)
+}
+
+// groupSelection counts the loaded data rows of the group whose header is at
+// the given index, and how many of them are selected.
+func (t *Table) groupSelection(headerIndex, level int) (selected, total int) {
+	for _, item := range t.groupMembers(headerIndex, level) {
+		total++
+		if item.Selected {
+			selected++
+		}
+	}
+	return selected, total
+}
+
+// groupMembers returns the loaded data rows of the group whose header is at
+// the given index, keyed by their absolute index. The group ends at the next
+// header, subtotal or total row of the same or an outer level, or at the
+// first row not loaded yet.
+func (t *Table) groupMembers(headerIndex, level int) map[int]core.Data[any] {
+	members := make(map[int]core.Data[any])
+	for index := headerIndex + 1; index < t.totalItems; index++ {
+		item, ok := t.getItemAtIndex(index)
+		if !ok {
+			break
+		}
+		row, ok := item.Item.(core.TableRow)
+		if !ok {
+			continue
+		}
+		if row.Kind == core.TableRowGrandTotal || (row.Kind != core.TableRowData && row.Level <= level) {
+			break
+		}
+		if row.Kind == core.TableRowData {
+			members[index] = item
+		}
+	}
+	return members
+}
+
+// isCheckboxColumn reports whether the x coordinate of a click falls on the
+// checkbox of a row
+func (t *Table) isCheckboxColumn(x int) bool {
+	if t.config.ShowBorders {
+		x--
+	}
+	return t.config.ShowCheckboxColumn && x >= 1 && x < 1+len(checkboxUnchecked)
+}
+
+// toggleCheckbox toggles the selection of the row at index. The checkbox of a
+// group header selects its loaded data rows, or deselects them when all are
+// selected, in multiple selection mode.
+func (t *Table) toggleCheckbox(index int) tea.Cmd {
+	if t.config.SelectionMode == core.SelectionNone || t.dataSource == nil {
+		return nil
+	}
+	item, ok := t.getItemAtIndex(index)
+	if !ok {
+		return nil
+	}
+
+	row, _ := item.Item.(core.TableRow)
+	switch row.Kind {
+	case core.TableRowData:
+		return t.toggleItemSelection(item.ID)
+	case core.TableRowGroupHeader:
+		if t.config.SelectionMode != core.SelectionMultiple {
+			return nil
+		}
+	default:
+		return nil
+	}
+
+	selected, total := t.groupSelection(index, row.Level)
+	target := selected < total
+
+	members := t.groupMembers(index, row.Level)
+	children := slices.Sorted(maps.Keys(members))
+	var cmds []tea.Cmd
+	for _, child := range children {
+		if members[child].Selected != target {
+			cmds = append(cmds, t.dataSource.SetSelected(child, target), t.recordSelection(members[child].ID, target))
+		}
+	}
+	return tea.Sequence(cmds...)
+}
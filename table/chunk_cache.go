@@ -0,0 +1,123 @@
+package table
+
+import (
+	"slices"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/davidroman0O/vtable/core"
+	"github.com/davidroman0O/vtable/data"
+)
+
+// GetLoadedChunkStats returns the number of chunks in memory, how many were
+// unloaded so far, and how many visible items were found in the loaded chunks
+// or missing from them
+func (t *Table) GetLoadedChunkStats() core.ChunkStats {
+	stats := t.chunkStats
+	stats.Loaded = len(t.chunks)
+	return stats
+}
+
+// countChunkHits counts the visible items served from a loaded chunk and
+// those shown as placeholders, once for each position of the viewport
+func (t *Table) countChunkHits() {
+	start := t.viewport.ViewportStartIndex
+	if len(t.visibleItems) == 0 || start == t.hitsViewportStart {
+		return
+	}
+	t.hitsViewportStart = start
+
+	for _, item := range t.visibleItems {
+		if isPlaceholderID(item.ID) {
+			t.chunkStats.Misses++
+		} else {
+			t.chunkStats.Hits++
+		}
+	}
+}
+
+// trackTravelDirection records whether the viewport last moved up or down,
+// for PrefetchChunks
+func (t *Table) trackTravelDirection() {
+	start := t.viewport.ViewportStartIndex
+	switch {
+	case start > t.lastViewportStart:
+		t.travelDirection = 1
+	case start < t.lastViewportStart:
+		t.travelDirection = -1
+	}
+	t.lastViewportStart = start
+}
+
+// prefetchChunkStarts returns the chunks PrefetchChunks loads past the
+// bounding area, in the direction the viewport last moved
+func (t *Table) prefetchChunkStarts() []int {
+	count := t.config.ViewportConfig.PrefetchChunks
+	chunkSize := t.config.ViewportConfig.ChunkSize
+	if count <= 0 || chunkSize <= 0 || t.totalItems == 0 {
+		return nil
+	}
+
+	area := t.calculateBoundingArea()
+	var starts []int
+	for i := 0; i < count; i++ {
+		start := area.ChunkEnd + i*chunkSize
+		if t.travelDirection < 0 {
+			start = area.ChunkStart - (i+1)*chunkSize
+		}
+		if start < 0 || start >= t.totalItems {
+			break
+		}
+		starts = append(starts, start)
+	}
+	return starts
+}
+
+// evictChunks unloads the least recently used chunks past MaxLoadedChunks.
+// The chunks covering the cursor or the viewport are never unloaded.
+func (t *Table) evictChunks() tea.Cmd {
+	maxChunks := t.config.ViewportConfig.MaxLoadedChunks
+	if maxChunks <= 0 {
+		return nil
+	}
+
+	viewportEnd := t.viewport.ViewportStartIndex + t.config.ViewportConfig.Height - 1
+	protected := func(chunkStart int) bool {
+		chunk := t.chunks[chunkStart]
+		coversCursor := t.viewport.CursorIndex >= chunk.StartIndex && t.viewport.CursorIndex <= chunk.EndIndex
+		coversViewport := chunk.StartIndex <= viewportEnd && chunk.EndIndex >= t.viewport.ViewportStartIndex
+		return coversCursor || coversViewport
+	}
+
+	var cmds []tea.Cmd
+	for _, chunkStart := range data.ChunksToEvict(t.chunks, t.chunkAccessTime, maxChunks, t.viewport.CursorIndex, protected) {
+		cmds = append(cmds, t.unloadChunk(chunkStart))
+	}
+	return tea.Batch(cmds...)
+}
+
+// unloadChunk drops a loaded chunk and reports it
+func (t *Table) unloadChunk(chunkStart int) tea.Cmd {
+	delete(t.chunks, chunkStart)
+	delete(t.chunkAccessTime, chunkStart)
+	t.chunkStats.Evicted++
+	t.logChunkEvent(core.ChunkEvent{
+		Type:       core.ChunkEventUnloaded,
+		StartIndex: chunkStart,
+		Size:       t.config.ViewportConfig.ChunkSize,
+	})
+	return core.ChunkUnloadedCmd(chunkStart)
+}
+
+// isPrefetchedChunk reports whether a chunk is one PrefetchChunks keeps
+// loaded ahead of the viewport
+func (t *Table) isPrefetchedChunk(chunkStart int) bool {
+	return slices.Contains(t.prefetchChunkStarts(), chunkStart)
+}
+
+// touchChunk marks a chunk as just used, so a chunk loaded ahead of the
+// viewport is not the first one evicted
+func (t *Table) touchChunk(chunkStart int) {
+	t.chunkAccessTime[chunkStart] = time.Now()
+}
@@ -0,0 +1,183 @@
+package table
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/davidroman0O/vtable/core"
+)
+
+// tsvCellReplacer keeps cells on one TSV field
+var tsvCellReplacer = strings.NewReplacer("\t", " ", "\r\n", " ", "\n", " ")
+
+// CopySelection copies the selected rows to the clipboard in the given format
+// and reports the outcome with core.CopyCompletedMsg
+func (t *Table) CopySelection(format core.CopyFormat) tea.Cmd {
+	return core.CopySelectionCmd(format)
+}
+
+// osc52CopyMsg carries rows the clipboard writer failed to copy, to be sent
+// to the terminal with OSC 52 instead
+type osc52CopyMsg struct {
+	text  string
+	count int
+}
+
+// osc52SentMsg ends the frames OSC 52 copy seq is rendered with
+type osc52SentMsg struct {
+	seq int
+}
+
+// osc52Duration is how long the sequence of an OSC 52 copy is rendered with
+// the table, long enough for the renderer to draw a frame
+const osc52Duration = 500 * time.Millisecond
+
+// SetClipboardWriter replaces the clipboard rows are copied to. Nil restores
+// the default core.SystemClipboard.
+func (t *Table) SetClipboardWriter(writer core.ClipboardWriter) {
+	t.clipboard = writer
+}
+
+// SetOSC52Fallback sets whether copies the clipboard writer fails to make are
+// sent to the terminal's clipboard with OSC 52 instead, which works over SSH.
+// The sequence goes out with the next frames the table renders, through the
+// program's output. Terminals without OSC 52 support ignore it, so such copies
+// are reported with core.CopyCompletedMsg.OSC52 set.
+func (t *Table) SetOSC52Fallback(enabled bool) {
+	t.osc52Fallback = enabled
+}
+
+// handleCopySelection returns a command that loads every row of the data
+// source, including rows outside the loaded chunks, and copies the selected
+// ones with the visible columns and cell formatters applied
+func (t *Table) handleCopySelection(format core.CopyFormat) tea.Cmd {
+	export := t.newCSVExport(true)
+	clipboard := t.clipboard
+	if clipboard == nil {
+		clipboard = core.SystemClipboard{}
+	}
+	fallback := t.osc52Fallback
+
+	// Skip the scan when the data source knows nothing is selected
+	if counter, ok := t.idSource().(core.SelectionCounter); ok && counter.GetSelectionCount() == 0 {
+		return func() tea.Msg {
+			return core.CopyCompletedMsg{}
+		}
+	}
+
+	return func() tea.Msg {
+		var records [][]string
+		err := export.eachRow(func(row core.TableRow, index int, selected bool) error {
+			if selected {
+				records = append(records, export.record(row, index))
+			}
+			return nil
+		})
+		if err != nil || len(records) == 0 {
+			return core.CopyCompletedMsg{Err: err}
+		}
+
+		text, err := export.formatRecords(records, format)
+		if err != nil {
+			return core.CopyCompletedMsg{Err: err}
+		}
+		if err := clipboard.WriteClipboard(text); err != nil {
+			if fallback {
+				return osc52CopyMsg{text: text, count: len(records)}
+			}
+			return core.CopyCompletedMsg{Err: err}
+		}
+		return core.CopyCompletedMsg{Count: len(records)}
+	}
+}
+
+// handleOSC52Copy renders the OSC 52 sequence of a copy with the table's
+// frames for a moment, so the renderer writes it to the terminal in order with
+// the rest of the output, and reports the copy
+func (t *Table) handleOSC52Copy(msg osc52CopyMsg) tea.Cmd {
+	t.clipboardSeq++
+	t.clipboardSequence = core.OSC52Sequence(msg.text)
+	return tea.Batch(
+		func() tea.Msg {
+			return core.CopyCompletedMsg{Count: msg.count, OSC52: true}
+		},
+		core.DelayCmd(osc52Duration, osc52SentMsg{seq: t.clipboardSeq}),
+	)
+}
+
+// formatRecords writes the records with a header in the given format
+func (e *csvExport) formatRecords(records [][]string, format core.CopyFormat) (string, error) {
+	header := make([]string, 0, len(e.columns))
+	keys := make([]string, 0, len(e.columns))
+	for _, colIdx := range e.columns {
+		col := e.allColumns[colIdx]
+		header = append(header, col.Title)
+		key := col.Field
+		if key == "" {
+			key = col.Title
+		}
+		keys = append(keys, key)
+	}
+
+	switch format {
+	case core.CopyCSV:
+		var buf bytes.Buffer
+		writer := csv.NewWriter(&buf)
+		if err := writer.Write(header); err != nil {
+			return "", err
+		}
+		err := writer.WriteAll(records)
+		return buf.String(), err
+
+	case core.CopyJSON:
+		return formatJSONRecords(keys, records)
+
+	default:
+		lines := make([]string, 0, len(records)+1)
+		for _, record := range append([][]string{header}, records...) {
+			cells := make([]string, len(record))
+			for i, cell := range record {
+				cells[i] = tsvCellReplacer.Replace(cell)
+			}
+			lines = append(lines, strings.Join(cells, "\t"))
+		}
+		return strings.Join(lines, "\n"), nil
+	}
+}
+
+// formatJSONRecords writes the records as an array of objects whose keys
+// follow the column order
+func formatJSONRecords(keys []string, records [][]string) (string, error) {
+	var buf bytes.Buffer
+	buf.WriteString("[")
+	for i, record := range records {
+		if i > 0 {
+			buf.WriteString(",")
+		}
+		buf.WriteString("{")
+		for j, key := range keys {
+			if j > 0 {
+				buf.WriteString(",")
+			}
+			name, err := json.Marshal(key)
+			if err != nil {
+				return "", err
+			}
+			value, err := json.Marshal(record[j])
+			if err != nil {
+				return "", err
+			}
+			buf.Write(name)
+			buf.WriteString(":")
+			buf.Write(value)
+		}
+		buf.WriteString("}")
+	}
+	buf.WriteString("]")
+	return buf.String(), nil
+}
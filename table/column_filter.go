@@ -0,0 +1,132 @@
+package table
+
+import (
+	"strings"
+	"unicode/utf8"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/mattn/go-runewidth"
+
+	"github.com/davidroman0O/vtable/core"
+)
+
+// columnFilterEditor holds the state of an inline column filter input
+type columnFilterEditor struct {
+	column int
+	input  string
+	err    error
+}
+
+// handleColumnFilterEdit opens the inline filter input for a column, prefilled
+// with the text of its current filter
+func (t *Table) handleColumnFilterEdit(column int) tea.Cmd {
+	if column < 0 || column >= len(t.columns) {
+		return nil
+	}
+
+	t.filterEditor = &columnFilterEditor{
+		column: column,
+		input:  t.filterInputs[t.columns[column].Field],
+	}
+	return nil
+}
+
+// handleColumnFilterKey edits the open filter input. It captures every key so
+// navigation and Tab do not leak to the table while typing.
+func (t *Table) handleColumnFilterKey(msg tea.KeyMsg) tea.Cmd {
+	editor := t.filterEditor
+
+	switch msg.Type {
+	case tea.KeyEsc:
+		t.filterEditor = nil
+	case tea.KeyEnter:
+		return t.applyColumnFilter()
+	case tea.KeyBackspace:
+		if runes := []rune(editor.input); len(runes) > 0 {
+			editor.input = string(runes[:len(runes)-1])
+		}
+		editor.err = nil
+	case tea.KeySpace:
+		editor.input += " "
+		editor.err = nil
+	case tea.KeyRunes:
+		editor.input += string(msg.Runes)
+		editor.err = nil
+	}
+
+	return nil
+}
+
+// applyColumnFilter parses the filter input and applies it. Number and date
+// columns are parsed as a core.RangeFilter; invalid input keeps the editor open
+// with the error so it can be shown inline. Empty input clears the filter.
+func (t *Table) applyColumnFilter() tea.Cmd {
+	editor := t.filterEditor
+	col := t.columns[editor.column]
+	input := strings.TrimSpace(editor.input)
+
+	// Committing the input applies debounced changes along with it
+	t.adoptPendingFilters()
+
+	if input == "" {
+		delete(t.filters, col.Field)
+		delete(t.filterInputs, col.Field)
+		t.filterEditor = nil
+		return t.handleFilterChange()
+	}
+
+	var value any = input
+	switch col.Type {
+	case core.ColumnInt, core.ColumnFloat, core.ColumnDate:
+		rangeFilter, err := core.ParseRangeFilter(input, col.Type)
+		if err != nil {
+			editor.err = err
+			return nil
+		}
+		value = rangeFilter
+	}
+
+	t.filters[col.Field] = value
+	t.filterInputs[col.Field] = input
+	t.filterEditor = nil
+	return t.handleFilterChange()
+}
+
+// renderColumnFilterEditor renders the filter input in place of the column's
+// header cell, keeping the end of long input visible
+func (t *Table) renderColumnFilterEditor(col core.TableColumn) string {
+	text := t.filterEditor.input + "▏"
+	for runewidth.StringWidth(text) > col.Width && len(text) > 0 {
+		_, size := utf8.DecodeRuneInString(text)
+		text = text[size:]
+	}
+
+	constrained := t.applyCellConstraints(text, core.CellConstraint{
+		Width:     col.Width,
+		Height:    1,
+		Alignment: core.AlignLeft,
+	}, -1)
+
+	if t.filterEditor.err != nil {
+		return t.config.Theme.ErrorStyle.Render(constrained)
+	}
+	return t.config.Theme.HeaderStyle.Copy().Underline(true).Render(constrained)
+}
+
+// EditColumnFilter opens the inline filter input of a column
+func (t *Table) EditColumnFilter(column int) tea.Cmd {
+	return core.ColumnFilterEditCmd(column)
+}
+
+// IsEditingFilter returns whether a column filter input is open
+func (t *Table) IsEditingFilter() bool {
+	return t.filterEditor != nil
+}
+
+// GetFilterError returns the validation error of the open filter input, if any
+func (t *Table) GetFilterError() error {
+	if t.filterEditor == nil {
+		return nil
+	}
+	return t.filterEditor.err
+}
@@ -0,0 +1,170 @@
+package table
+
+import (
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/davidroman0O/vtable/core"
+)
+
+// MoveColumnLeft moves the active column one position to the left
+func (t *Table) MoveColumnLeft() tea.Cmd {
+	return core.MoveColumnLeftCmd()
+}
+
+// MoveColumnRight moves the active column one position to the right
+func (t *Table) MoveColumnRight() tea.Cmd {
+	return core.MoveColumnRightCmd()
+}
+
+// handleColumnMove swaps the active column with its neighbor delta positions
+// away, and keeps it active. Everything keyed by column index moves with it:
+// cells, cell and header formatters, scroll offsets and open editors. It emits
+// ColumnMovedMsg when the column actually moves.
+func (t *Table) handleColumnMove(delta int) tea.Cmd {
+	from := t.currentColumn
+	to := from + delta
+	if delta == 0 || from < 0 || from >= len(t.config.Columns) || to < 0 || to >= len(t.config.Columns) {
+		return nil
+	}
+
+	// The displayed columns may alias the configured ones; copy before editing
+	columns := make([]core.TableColumn, len(t.config.Columns))
+	copy(columns, t.config.Columns)
+	columns[from], columns[to] = columns[to], columns[from]
+	t.config.Columns = columns
+
+	if t.columnCells == nil {
+		t.columnCells = make([]int, len(columns))
+		for i := range t.columnCells {
+			t.columnCells[i] = i
+		}
+	}
+	t.columnCells[from], t.columnCells[to] = t.columnCells[to], t.columnCells[from]
+
+	swapColumnEntries(t.cellFormatters, from, to)
+	swapColumnEntries(t.headerCellFormatters, from, to)
+	swapColumnEntries(t.horizontalScrollOffsets, from, to)
+	if t.cellEditor != nil {
+		t.cellEditor.column = swappedColumn(t.cellEditor.column, from, to)
+	}
+	if t.filterEditor != nil {
+		t.filterEditor.column = swappedColumn(t.filterEditor.column, from, to)
+	}
+
+	// Rows already loaded are rearranged in place of a reload
+	for start, chunk := range t.chunks {
+		items := make([]core.Data[any], len(chunk.Items))
+		for i, item := range chunk.Items {
+			if row, ok := item.Item.(core.TableRow); ok && row.Kind != core.TableRowGroupHeader {
+				row.Cells = append([]string(nil), row.Cells...)
+				if from < len(row.Cells) && to < len(row.Cells) {
+					row.Cells[from], row.Cells[to] = row.Cells[to], row.Cells[from]
+				}
+				item.Item = row
+			}
+			items[i] = item
+		}
+		chunk.Items = items
+		t.chunks[start] = chunk
+	}
+
+	t.currentColumn = to
+	t.applyOverflowStrategy()
+	t.updateVisibleItems()
+
+	return core.ColumnMovedCmd(from, to)
+}
+
+// swapColumnEntries swaps the entries of two columns in a map keyed by column
+// index, including when only one of them has an entry
+func swapColumnEntries[V any](entries map[int]V, a, b int) {
+	valueA, okA := entries[a]
+	valueB, okB := entries[b]
+	delete(entries, a)
+	delete(entries, b)
+	if okA {
+		entries[b] = valueA
+	}
+	if okB {
+		entries[a] = valueB
+	}
+}
+
+// swappedColumn returns where a column index ends up after columns a and b swap
+func swappedColumn(column, a, b int) int {
+	switch column {
+	case a:
+		return b
+	case b:
+		return a
+	}
+	return column
+}
+
+// cellIndex returns the index into TableRow.Cells of a column's values
+func (t *Table) cellIndex(column int) int {
+	if column >= 0 && column < len(t.columnCells) {
+		return t.columnCells[column]
+	}
+	return column
+}
+
+// arrangeItems returns rows from a data source with their cells in column
+// order once columns have been moved. Group header rows keep their label in
+// the first cell.
+func arrangeItems(items []core.Data[any], columnCells []int) []core.Data[any] {
+	if columnCells == nil {
+		return items
+	}
+
+	arranged := make([]core.Data[any], len(items))
+	for i, item := range items {
+		if row, ok := item.Item.(core.TableRow); ok && row.Kind != core.TableRowGroupHeader {
+			cells := make([]string, len(columnCells), max(len(columnCells), len(row.Cells)))
+			for column, source := range columnCells {
+				if source < len(row.Cells) {
+					cells[column] = row.Cells[source]
+				}
+			}
+			// Cells past the columns are kept after them
+			if len(row.Cells) > len(columnCells) {
+				cells = append(cells, row.Cells[len(columnCells):]...)
+			}
+			row.Cells = cells
+			item.Item = row
+		}
+		arranged[i] = item
+	}
+	return arranged
+}
+
+// cellFormatterFor returns the cell formatter of a column: the one set for its
+// field, or else the one set for its index, or else the default of its Type.
+func (t *Table) cellFormatterFor(column int) (core.SimpleCellFormatter, bool) {
+	if column >= 0 && column < len(t.columns) && t.columns[column].Field != "" {
+		if formatter, ok := t.fieldCellFormatters[t.columns[column].Field]; ok {
+			return formatter, true
+		}
+	}
+	if formatter, ok := t.cellFormatters[column]; ok {
+		return formatter, true
+	}
+	if column >= 0 && column < len(t.columns) {
+		if formatter := core.ColumnTypeFormatter(t.columns[column]); formatter != nil {
+			return formatter, true
+		}
+	}
+	return nil, false
+}
+
+// headerFormatterFor returns the header formatter of a column: the one set for
+// its field, or else the one set for its index.
+func (t *Table) headerFormatterFor(column int) (core.SimpleHeaderFormatter, bool) {
+	if column >= 0 && column < len(t.columns) && t.columns[column].Field != "" {
+		if formatter, ok := t.fieldHeaderFormatters[t.columns[column].Field]; ok {
+			return formatter, true
+		}
+	}
+	formatter, ok := t.headerCellFormatters[column]
+	return formatter, ok
+}
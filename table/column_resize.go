@@ -0,0 +1,97 @@
+package table
+
+import (
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/davidroman0O/vtable/core"
+)
+
+// handleResizeKey handles a key press in resize mode. Left and right shrink or
+// grow the active column, Tab keys pick another column, and escape or enter
+// leave the mode. Other keys are reported as unhandled so navigation keeps
+// working while resizing.
+func (t *Table) handleResizeKey(msg tea.KeyMsg) (tea.Cmd, bool) {
+	switch msg.Type {
+	case tea.KeyEsc, tea.KeyEnter:
+		t.resizeMode = false
+		return nil, true
+	case tea.KeyLeft:
+		return t.resizeColumn(t.currentColumn, -1), true
+	case tea.KeyRight:
+		return t.resizeColumn(t.currentColumn, 1), true
+	case tea.KeyTab:
+		return t.handleNextColumn(), true
+	case tea.KeyShiftTab:
+		return t.handlePrevColumn(), true
+	}
+	return nil, false
+}
+
+// resizeColumn changes a configured column's width by delta, clamped to the
+// column's MinWidth and MaxWidth
+func (t *Table) resizeColumn(index, delta int) tea.Cmd {
+	if index < 0 || index >= len(t.config.Columns) {
+		return nil
+	}
+	return t.setColumnWidth(index, t.config.Columns[index].Width+delta)
+}
+
+// setColumnWidth sets a configured column's width, clamped to the column's
+// MinWidth and MaxWidth, and refits the displayed columns. It emits
+// ColumnResizedMsg when the width actually changes.
+func (t *Table) setColumnWidth(index, width int) tea.Cmd {
+	if index < 0 || index >= len(t.config.Columns) {
+		return nil
+	}
+
+	col := t.config.Columns[index]
+	width = clampColumnWidth(col, width)
+	if width == col.Width {
+		return nil
+	}
+
+	// The displayed columns may alias the configured ones; copy before editing
+	// so a previously returned config is not mutated behind the caller's back
+	columns := make([]core.TableColumn, len(t.config.Columns))
+	copy(columns, t.config.Columns)
+	columns[index].Width = width
+	t.config.Columns = columns
+	t.applyOverflowStrategy()
+
+	return core.ColumnResizedCmd(index, width)
+}
+
+// clampColumnWidth bounds width to the column's MinWidth and MaxWidth
+func clampColumnWidth(col core.TableColumn, width int) int {
+	minWidth := col.MinWidth
+	if minWidth < 1 {
+		minWidth = 1
+	}
+	if width < minWidth {
+		width = minWidth
+	}
+	if col.MaxWidth > 0 && width > col.MaxWidth {
+		width = col.MaxWidth
+	}
+	return width
+}
+
+// SetResizeMode enters or leaves the interactive column resize mode
+func (t *Table) SetResizeMode(enabled bool) tea.Cmd {
+	return core.ColumnResizeModeCmd(enabled)
+}
+
+// ResizeColumn grows or shrinks a column by delta
+func (t *Table) ResizeColumn(index, delta int) tea.Cmd {
+	return core.ColumnResizeCmd(index, delta)
+}
+
+// SetColumnWidth sets a column's width, clamped to its MinWidth and MaxWidth
+func (t *Table) SetColumnWidth(index, width int) tea.Cmd {
+	return core.ColumnWidthSetCmd(index, width)
+}
+
+// IsResizeMode returns whether the interactive column resize mode is active
+func (t *Table) IsResizeMode() bool {
+	return t.resizeMode
+}
@@ -0,0 +1,91 @@
+package table
+
+import (
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/davidroman0O/vtable/core"
+)
+
+// handleColumnVisibility shows or hides the configured column showing field
+// and refits the displayed columns. Hiding the active column moves it to the
+// next visible column.
+func (t *Table) handleColumnVisibility(field string, visible bool) tea.Cmd {
+	index := -1
+	for i, col := range t.config.Columns {
+		if col.Field == field {
+			index = i
+			break
+		}
+	}
+	if index < 0 || t.config.Columns[index].Hidden == !visible {
+		return nil
+	}
+
+	// Keep at least one column on screen
+	if !visible {
+		shown := 0
+		for _, col := range t.config.Columns {
+			if !col.Hidden {
+				shown++
+			}
+		}
+		if shown <= 1 {
+			return nil
+		}
+	}
+
+	columns := make([]core.TableColumn, len(t.config.Columns))
+	copy(columns, t.config.Columns)
+	columns[index].Hidden = !visible
+	t.config.Columns = columns
+	t.applyOverflowStrategy()
+
+	if t.hiddenColumns[t.currentColumn] {
+		t.currentColumn = t.stepVisibleColumn(t.currentColumn, 1)
+	}
+	t.updateVisibleItems()
+	return nil
+}
+
+// hideColumnsByConfig marks the displayed columns declared Hidden
+func (t *Table) hideColumnsByConfig() {
+	for i, col := range t.columns {
+		if col.Hidden {
+			t.hiddenColumns[i] = true
+		}
+	}
+}
+
+// droppedColumnCount returns how many columns the overflow strategy hid to fit
+// the available width
+func (t *Table) droppedColumnCount() int {
+	dropped := 0
+	for i := range t.hiddenColumns {
+		if !t.columns[i].Hidden {
+			dropped++
+		}
+	}
+	return dropped
+}
+
+// stepVisibleColumn returns the first visible column after column in the
+// direction of step, wrapping around the ends
+func (t *Table) stepVisibleColumn(column, step int) int {
+	count := len(t.columns)
+	if count == 0 {
+		return 0
+	}
+	next := column
+	for range count {
+		next = (next + step + count) % count
+		if !t.hiddenColumns[next] {
+			return next
+		}
+	}
+	return column
+}
+
+// SetColumnVisibility shows or hides the column showing field
+func (t *Table) SetColumnVisibility(field string, visible bool) tea.Cmd {
+	return core.ColumnVisibilityCmd(field, visible)
+}
@@ -0,0 +1,104 @@
+package table
+
+import (
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/davidroman0O/vtable/core"
+)
+
+// Controller drives a Table synchronously without a Bubble Tea program, for
+// automation, tests and screenshot generation. Every action sends its message to
+// the table and then runs the resulting commands in place, feeding their
+// messages back into the table, so chunk loads complete before the action
+// returns. Rendering goes through the table's own View.
+type Controller struct {
+	table *Table
+	queue []tea.Cmd
+
+	// MaxSteps bounds the number of commands processed by WaitForIdle, guarding
+	// against data sources or messages that keep producing new commands.
+	MaxSteps int
+}
+
+// NewController creates a controller for the table and runs its Init commands
+// until the initial data is loaded.
+func NewController(table *Table) *Controller {
+	c := &Controller{
+		table:    table,
+		MaxSteps: 1000,
+	}
+	c.Do(table.Init())
+	return c
+}
+
+// Table returns the controlled table.
+func (c *Controller) Table() *Table {
+	return c.table
+}
+
+// Send delivers a message to the table and waits for the resulting commands.
+func (c *Controller) Send(msg tea.Msg) {
+	_, cmd := c.table.Update(msg)
+	c.enqueue(cmd)
+	c.WaitForIdle()
+}
+
+// Do runs a command against the table and waits for the resulting commands.
+func (c *Controller) Do(cmd tea.Cmd) {
+	c.enqueue(cmd)
+	c.WaitForIdle()
+}
+
+// MoveUp moves the cursor up one row.
+func (c *Controller) MoveUp() {
+	c.Do(core.CursorUpCmd())
+}
+
+// MoveDown moves the cursor down one row.
+func (c *Controller) MoveDown() {
+	c.Do(core.CursorDownCmd())
+}
+
+// JumpTo moves the cursor to the given index.
+func (c *Controller) JumpTo(index int) {
+	c.Do(core.JumpToCmd(index))
+}
+
+// ToggleSelect toggles the selection of the row under the cursor.
+func (c *Controller) ToggleSelect() {
+	c.Do(core.SelectCurrentCmd())
+}
+
+// Render returns the table's current view.
+func (c *Controller) Render() string {
+	return c.table.View()
+}
+
+// WaitForIdle processes queued commands and the messages they produce until no
+// work is left. Commands run synchronously through core.RunCmd, so sequences
+// deliver their messages in order and timer commands, such as loading
+// animations and debounces, are skipped. It returns false if MaxSteps was
+// reached first.
+func (c *Controller) WaitForIdle() bool {
+	for steps := 0; len(c.queue) > 0; steps++ {
+		if c.MaxSteps > 0 && steps >= c.MaxSteps {
+			return false
+		}
+
+		cmd := c.queue[0]
+		c.queue = c.queue[1:]
+
+		for _, msg := range core.RunCmd(cmd) {
+			_, next := c.table.Update(msg)
+			c.enqueue(next)
+		}
+	}
+	return true
+}
+
+// enqueue adds a command to the queue, ignoring nil commands.
+func (c *Controller) enqueue(cmd tea.Cmd) {
+	if cmd != nil {
+		c.queue = append(c.queue, cmd)
+	}
+}
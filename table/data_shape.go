@@ -0,0 +1,37 @@
+package table
+
+import (
+	"fmt"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/davidroman0O/vtable/core"
+)
+
+// checkDataShape reports the data rows of a loaded chunk whose cell count
+// differs from the column count: the first one as a load error with
+// StrictCellCount, and each one as a DataShapeWarningMsg with WarnDataShape
+func (t *Table) checkDataShape(startIndex int, items []core.Data[any]) tea.Cmd {
+	if !t.config.StrictCellCount && !t.config.WarnDataShape {
+		return nil
+	}
+
+	columns := len(t.config.Columns)
+	var cmds []tea.Cmd
+	reported := false
+	for i, item := range items {
+		row, ok := item.Item.(core.TableRow)
+		if !ok || row.Kind != core.TableRowData || len(row.Cells) == columns {
+			continue
+		}
+		if t.config.WarnDataShape {
+			cmds = append(cmds, core.DataShapeWarningCmd(startIndex+i, row.ID, len(row.Cells), columns))
+		}
+		if t.config.StrictCellCount && !reported {
+			reported = true
+			err := fmt.Errorf("row %q at index %d has %d cells for %d columns", row.ID, startIndex+i, len(row.Cells), columns)
+			cmds = append(cmds, core.DataLoadErrorCmd(err))
+		}
+	}
+	return tea.Batch(cmds...)
+}
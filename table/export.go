@@ -0,0 +1,160 @@
+package table
+
+import (
+	"encoding/csv"
+	"io"
+	"os"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/davidroman0O/vtable/core"
+	"github.com/davidroman0O/vtable/data"
+)
+
+// csvExport is a snapshot of the table state needed to export its rows, taken
+// on the Update goroutine so the export itself can run in a command
+type csvExport struct {
+	dataSource     core.DataSource[any]
+	request        core.DataRequest
+	chunkSize      int
+	columns        []int
+	allColumns     []core.TableColumn
+	formatters     map[int]core.SimpleCellFormatter
+	columnCells    []int
+	renderContext  core.RenderContext
+	includeHeaders bool
+}
+
+// ExportCSV writes every data row of the data source, not just the loaded
+// chunks, to w as RFC 4180 CSV with a header record. Rows are requested in
+// ChunkSize increments with the table's current sort and filters, only the
+// visible columns are written, and cell formatters are applied with their ANSI
+// styling stripped. Group headers and total rows are skipped. It returns the
+// number of data rows written.
+func (t *Table) ExportCSV(w io.Writer) (int, error) {
+	return t.newCSVExport(true).write(w)
+}
+
+// handleExportCSV returns a command exporting the rows to the file at msg.Path
+// and reporting the outcome with core.ExportCompletedMsg
+func (t *Table) handleExportCSV(msg core.ExportCSVMsg) tea.Cmd {
+	export := t.newCSVExport(msg.IncludeHeaders)
+
+	return func() tea.Msg {
+		file, err := os.Create(msg.Path)
+		if err != nil {
+			return core.ExportCompletedMsg{Path: msg.Path, Err: err}
+		}
+
+		count, err := export.write(file)
+		if closeErr := file.Close(); err == nil {
+			err = closeErr
+		}
+		return core.ExportCompletedMsg{Path: msg.Path, RowCount: count, Err: err}
+	}
+}
+
+// newCSVExport snapshots the state needed for an export
+func (t *Table) newCSVExport(includeHeaders bool) *csvExport {
+	chunkSize := t.config.ViewportConfig.ChunkSize
+	if chunkSize <= 0 {
+		chunkSize = 100
+	}
+
+	var columns []int
+	for i := range t.columns {
+		if !t.hiddenColumns[i] {
+			columns = append(columns, i)
+		}
+	}
+
+	formatters := make(map[int]core.SimpleCellFormatter)
+	for i := range t.columns {
+		if formatter, ok := t.cellFormatterFor(i); ok {
+			formatters[i] = formatter
+		}
+	}
+
+	request := data.CreateDataRequest(0, 0, copyStrings(t.sortFields), copyStrings(t.sortDirs), copyFilters(t.filters))
+	request.FieldTypes = t.fieldTypes()
+	request.SortComparators = t.sortComparators()
+	request.FuzzyFields = copyStrings(t.fuzzyFields)
+	request.FilterFoldDiacritics = t.foldDiacritics
+
+	return &csvExport{
+		dataSource:     t.dataSource,
+		request:        request,
+		chunkSize:      chunkSize,
+		columns:        columns,
+		allColumns:     append([]core.TableColumn{}, t.columns...),
+		formatters:     formatters,
+		columnCells:    append([]int(nil), t.columnCells...),
+		renderContext:  t.renderContext,
+		includeHeaders: includeHeaders,
+	}
+}
+
+// write loads the rows chunk by chunk and writes them to w
+func (e *csvExport) write(w io.Writer) (int, error) {
+	writer := csv.NewWriter(w)
+	writer.UseCRLF = true
+
+	if e.includeHeaders {
+		header := make([]string, 0, len(e.columns))
+		for _, colIdx := range e.columns {
+			header = append(header, e.allColumns[colIdx].Title)
+		}
+		if err := writer.Write(header); err != nil {
+			return 0, err
+		}
+	}
+
+	count := 0
+	err := e.eachRow(func(row core.TableRow, index int, _ bool) error {
+		if err := writer.Write(e.record(row, index)); err != nil {
+			return err
+		}
+		count++
+		return nil
+	})
+	writer.Flush()
+	if err != nil {
+		return count, err
+	}
+	return count, writer.Error()
+}
+
+// eachRow loads the rows chunk by chunk and calls fn for each data row with its
+// absolute index and selection state, stopping at the first error. A result of
+// the data source that is not a loaded chunk is an error, so rows are never
+// skipped silently.
+func (e *csvExport) eachRow(fn func(row core.TableRow, index int, selected bool) error) error {
+	return data.ScanChunks(e.dataSource, e.request, e.chunkSize, func(chunk core.DataChunkLoadedMsg) (bool, error) {
+		for i, item := range arrangeItems(chunk.Items, e.columnCells) {
+			row, ok := item.Item.(core.TableRow)
+			if !ok || row.Kind != core.TableRowData {
+				continue
+			}
+			if err := fn(row, chunk.StartIndex+i, item.Selected); err != nil {
+				return false, err
+			}
+		}
+		return true, nil
+	})
+}
+
+// record returns the formatted, unstyled cells of a row for the exported columns
+func (e *csvExport) record(row core.TableRow, index int) []string {
+	record := make([]string, 0, len(e.columns))
+	for _, colIdx := range e.columns {
+		var value string
+		if colIdx < len(row.Cells) {
+			value = row.Cells[colIdx]
+		}
+		if formatter, exists := e.formatters[colIdx]; exists {
+			value = formatter(value, index, e.allColumns[colIdx], e.renderContext, false, false, false)
+		}
+		record = append(record, stripANSI(value))
+	}
+	return record
+}
@@ -0,0 +1,102 @@
+package table
+
+import (
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/davidroman0O/vtable/core"
+)
+
+// filterDebounceMsg applies the pending filters once the quiet period that
+// followed filter change seq has elapsed
+type filterDebounceMsg struct {
+	seq int
+}
+
+// SetFilterDebounce sets the quiet period after which filter changes are
+// applied. Filter changes arriving within the period coalesce into a single
+// refresh. Zero, the default, applies every change immediately.
+func (t *Table) SetFilterDebounce(d time.Duration) {
+	t.filterDebounce = d
+}
+
+// FlushFilters applies pending filter changes now instead of waiting out the
+// debounce period
+func (t *Table) FlushFilters() tea.Cmd {
+	return core.FiltersFlushCmd()
+}
+
+// HasPendingFilters returns whether filter changes are waiting for the debounce
+// period to elapse
+func (t *Table) HasPendingFilters() bool {
+	return t.pendingFilters != nil
+}
+
+// changeFilter sets or, when clear is true, removes the filter of a field. With
+// a debounce period, the change goes to the pending filters and a tick is
+// scheduled to apply them; every change supersedes the ticks scheduled before.
+func (t *Table) changeFilter(field string, value any, clear bool) tea.Cmd {
+	// Changes left pending by a debounce since turned off go first
+	if t.filterDebounce <= 0 {
+		t.adoptPendingFilters()
+	}
+
+	filters := t.filters
+	if t.filterDebounce > 0 {
+		if t.pendingFilters == nil {
+			t.pendingFilters = copyFilters(t.filters)
+		}
+		filters = t.pendingFilters
+	}
+
+	if clear {
+		delete(filters, field)
+	} else {
+		filters[field] = value
+	}
+
+	if t.filterDebounce <= 0 {
+		return t.handleFilterChange()
+	}
+	t.filterSeq++
+	return core.DelayCmd(t.filterDebounce, filterDebounceMsg{seq: t.filterSeq})
+}
+
+// handleFilterDebounce applies the pending filters unless a newer change has
+// rescheduled them or they were already applied
+func (t *Table) handleFilterDebounce(msg filterDebounceMsg) tea.Cmd {
+	if msg.seq != t.filterSeq {
+		return nil
+	}
+	return t.flushPendingFilters()
+}
+
+// flushPendingFilters applies the pending filters and refreshes the data
+func (t *Table) flushPendingFilters() tea.Cmd {
+	if !t.adoptPendingFilters() {
+		return nil
+	}
+	return t.handleFilterChange()
+}
+
+// adoptPendingFilters replaces the filters with the pending ones in one step
+// and invalidates any scheduled tick. It reports whether there were any.
+func (t *Table) adoptPendingFilters() bool {
+	if t.pendingFilters == nil {
+		return false
+	}
+	t.filters = t.pendingFilters
+	t.pendingFilters = nil
+	t.filterSeq++
+	return true
+}
+
+// discardPendingFilters drops pending filter changes superseded by filters
+// replaced wholesale
+func (t *Table) discardPendingFilters() {
+	if t.pendingFilters != nil {
+		t.pendingFilters = nil
+		t.filterSeq++
+	}
+}
@@ -0,0 +1,110 @@
+package table
+
+import (
+	"slices"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/davidroman0O/vtable/core"
+)
+
+// hasFooter reports whether a footer row is rendered below the rows
+func (t *Table) hasFooter() bool {
+	return t.config.FooterRow != nil || t.showsAggregateFooter()
+}
+
+// showsAggregateFooter reports whether the footer shows the column aggregates:
+// some column has an Aggregate, no FooterRow replaces them, and the table is
+// not grouped, since grouped data sources add their own grand total row.
+func (t *Table) showsAggregateFooter() bool {
+	if t.config.FooterRow != nil || t.isGrouped() {
+		return false
+	}
+	return slices.ContainsFunc(t.columns, func(col core.TableColumn) bool { return col.Aggregate != nil })
+}
+
+// computeAggregates scans the whole filtered dataset, one chunk at a time, and
+// computes the aggregate of each column that has one. The result arrives as an
+// AggregatesComputedMsg and is kept until the next computation.
+func (t *Table) computeAggregates() tea.Cmd {
+	if !t.showsAggregateFooter() || t.dataSource == nil {
+		return nil
+	}
+
+	export := t.newCSVExport(false)
+	columns := slices.Clone(t.columns)
+	cells := make([]int, len(columns))
+	for i := range columns {
+		cells[i] = t.cellIndex(i)
+	}
+
+	return func() tea.Msg {
+		// Scanned rows have their cells in column order
+		values := make([][]string, len(columns))
+		err := export.eachRow(func(row core.TableRow, index int, selected bool) error {
+			for i, col := range columns {
+				if col.Aggregate != nil && i < len(row.Cells) {
+					values[i] = append(values[i], row.Cells[i])
+				}
+			}
+			return nil
+		})
+		if err != nil {
+			return core.AggregatesComputedMsg{Error: err}
+		}
+
+		width := len(columns)
+		for _, cell := range cells {
+			width = max(width, cell+1)
+		}
+		result := make([]string, width)
+		for i, col := range columns {
+			if col.Aggregate != nil {
+				result[cells[i]] = col.Aggregate(values[i])
+			}
+		}
+		return core.AggregatesComputedMsg{Cells: result}
+	}
+}
+
+// renderFooter renders the FooterRow, or the column aggregates, below the
+// rows, laid out like a data row with the visible columns and styled with the
+// theme's FooterStyle. It returns an empty string when there is no footer.
+func (t *Table) renderFooter() string {
+	var row core.TableRow
+	switch {
+	case t.config.FooterRow != nil:
+		row = t.config.FooterRow(t.renderContext)
+	case t.showsAggregateFooter():
+		row = core.TableRow{Cells: t.aggregates}
+	default:
+		return ""
+	}
+	style := t.config.Theme.FooterStyle
+
+	// The indicator column stays blank: the footer has no cursor or selection
+	parts := []string{style.Render(strings.Repeat(" ", 4))}
+	for i, col := range t.columns {
+		if t.hiddenColumns[i] {
+			continue
+		}
+
+		var cellValue string
+		if cell := t.cellIndex(i); cell < len(row.Cells) {
+			cellValue = row.Cells[cell]
+		}
+		constrained := t.applyCellConstraints(cellValue, core.CellConstraint{
+			Width:     col.Width,
+			Height:    1,
+			Alignment: core.ColumnAlignment(col),
+		}, -1)
+		parts = append(parts, style.Render(constrained))
+	}
+
+	result := t.joinCells(parts, 1, t.getBorderChar(), t.pinnedBorderChar())
+	if t.config.ShowBorders {
+		result = t.getBorderChar() + result + t.getBorderChar()
+	}
+	return result
+}
@@ -0,0 +1,47 @@
+package table
+
+import (
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/davidroman0O/vtable/core"
+)
+
+// formatCell calls the cell formatter of a column. With RecoverFormatterPanics
+// a panic in it renders the cell as a warning sign and is queued for a
+// FormatterPanicMsg, once per column.
+func (t *Table) formatCell(formatter core.SimpleCellFormatter, column int, rowID, cellValue string, rowIndex int, col core.TableColumn, isCursor, isSelected, isActiveCell bool) (formatted string) {
+	if !t.config.RecoverFormatterPanics {
+		return formatter(cellValue, rowIndex, col, t.renderContext, isCursor, isSelected, isActiveCell)
+	}
+
+	defer func() {
+		recovered := recover()
+		if recovered == nil {
+			return
+		}
+		formatted = t.config.Theme.FormatterErrorStyle.Render("⚠")
+		if !t.panickedColumns[column] {
+			if t.panickedColumns == nil {
+				t.panickedColumns = make(map[int]bool)
+			}
+			t.panickedColumns[column] = true
+			t.pendingPanics = append(t.pendingPanics, core.FormatterPanicMsg{Column: column, RowID: rowID, Recovered: recovered})
+		}
+	}()
+	return formatter(cellValue, rowIndex, col, t.renderContext, isCursor, isSelected, isActiveCell)
+}
+
+// reportFormatterPanics returns the FormatterPanicMsg of the panics recovered
+// while rendering. View cannot return commands, so they are sent along with
+// the next message the table handles.
+func (t *Table) reportFormatterPanics() tea.Cmd {
+	if len(t.pendingPanics) == 0 {
+		return nil
+	}
+	var cmds []tea.Cmd
+	for _, panicked := range t.pendingPanics {
+		cmds = append(cmds, core.FormatterPanicCmd(panicked.Column, panicked.RowID, panicked.Recovered))
+	}
+	t.pendingPanics = nil
+	return tea.Batch(cmds...)
+}
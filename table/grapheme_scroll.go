@@ -0,0 +1,110 @@
+package table
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/rivo/uniseg"
+)
+
+// graphemeCluster is one user-perceived character of a cell: a base rune with
+// its combining marks, a full-width CJK character, or a whole emoji sequence
+type graphemeCluster struct {
+	text  string
+	width int // display cells
+}
+
+// splitGraphemes splits text into its grapheme clusters
+func splitGraphemes(text string) []graphemeCluster {
+	var clusters []graphemeCluster
+	state := -1
+	for text != "" {
+		var cluster string
+		var boundaries int
+		cluster, text, boundaries, state = uniseg.StepString(text, state)
+		clusters = append(clusters, graphemeCluster{text: cluster, width: boundaries >> uniseg.ShiftWidth})
+	}
+	return clusters
+}
+
+// smartScrollBoundaries returns the cluster indices smart scrolling stops at:
+// the start of the text, the start of every word, and camelCase humps inside
+// words. Words are found by Unicode word segmentation, so each CJK ideograph
+// and each emoji sequence starts its own word, and runs of spaces and
+// punctuation are skipped over.
+func smartScrollBoundaries(text string) []int {
+	boundaries := []int{0}
+	index := 0
+	state := -1
+	for text != "" {
+		var word string
+		word, text, state = uniseg.FirstWordInString(text, state)
+
+		clusters := splitGraphemes(word)
+		if isSmartScrollWord(word) && index > 0 {
+			boundaries = append(boundaries, index)
+		}
+		for i := 1; i < len(clusters); i++ {
+			previous, _ := utf8.DecodeRuneInString(clusters[i-1].text)
+			current, _ := utf8.DecodeRuneInString(clusters[i].text)
+			if unicode.IsLower(previous) && unicode.IsUpper(current) {
+				boundaries = append(boundaries, index+i)
+			}
+		}
+		index += len(clusters)
+	}
+	return boundaries
+}
+
+// isSmartScrollWord reports whether a word segment is worth stopping at, i.e.
+// it is not made of spaces or punctuation only
+func isSmartScrollWord(word string) bool {
+	return strings.IndexFunc(word, func(r rune) bool {
+		return !unicode.IsSpace(r) && !unicode.IsPunct(r)
+	}) >= 0
+}
+
+// scrollToSmartBoundary returns text from its smart scroll boundary with the
+// given index on, or "" past the last boundary.
+func scrollToSmartBoundary(text string, boundary int) string {
+	boundaries := smartScrollBoundaries(text)
+	if boundary >= len(boundaries) {
+		return ""
+	}
+
+	var builder strings.Builder
+	for _, cluster := range splitGraphemes(text)[boundaries[boundary]:] {
+		builder.WriteString(cluster.text)
+	}
+	return builder.String()
+}
+
+// truncateGraphemes cuts plain text to at most maxWidth display cells, followed
+// by suffix when anything was cut. It never splits a cluster: a wide character
+// that would straddle the edge is left out, so the result may be one cell
+// narrower than maxWidth.
+func truncateGraphemes(text string, maxWidth int, suffix string) string {
+	if maxWidth <= 0 {
+		return ""
+	}
+	if uniseg.StringWidth(text) <= maxWidth {
+		return text
+	}
+
+	suffixWidth := uniseg.StringWidth(suffix)
+	if maxWidth <= suffixWidth {
+		return strings.Repeat(".", maxWidth)
+	}
+
+	var builder strings.Builder
+	width := 0
+	for _, cluster := range splitGraphemes(text) {
+		if width+cluster.width > maxWidth-suffixWidth {
+			break
+		}
+		builder.WriteString(cluster.text)
+		width += cluster.width
+	}
+	return builder.String() + suffix
+}
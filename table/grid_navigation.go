@@ -0,0 +1,115 @@
+package table
+
+import (
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/davidroman0O/vtable/core"
+)
+
+// GetActiveCell returns the position of the active cell, the cursor row and
+// the active column, and its text. The text is empty while the row is
+// loading.
+func (t *Table) GetActiveCell() (row, col int, value string) {
+	return t.viewport.CursorIndex, t.currentColumn, t.activeCellValue()
+}
+
+// MoveActiveCell moves the active cell by dRow rows and dCol columns, like
+// arrow keys on a grid. It stops at the edges of the data and of the columns,
+// skips the columns hidden through TableColumn.Hidden, and loads the chunks the
+// new row needs. Moving onto a column dropped to fit the width scrolls it into
+// view, dropping another column in its place. It emits ActiveCellChangedMsg
+// when the cell moved.
+func (t *Table) MoveActiveCell(dRow, dCol int) tea.Cmd {
+	return core.ActiveCellMoveCmd(dRow, dCol)
+}
+
+// handleActiveCellMove moves the cursor row and the active column together
+func (t *Table) handleActiveCellMove(dRow, dCol int) tea.Cmd {
+	if t.totalItems == 0 || len(t.columns) == 0 {
+		return nil
+	}
+	previousRow, previousCol := t.viewport.CursorIndex, t.currentColumn
+
+	for ; dCol != 0; dCol -= sign(dCol) {
+		next, ok := t.adjacentGridColumn(t.currentColumn, sign(dCol))
+		if !ok {
+			break
+		}
+		t.currentColumn = next
+	}
+	if t.hiddenColumns[t.currentColumn] {
+		t.revealColumn(t.currentColumn)
+	}
+
+	var cmds []tea.Cmd
+	target := max(0, min(t.viewport.CursorIndex+dRow, t.totalItems-1))
+	switch {
+	case target == t.viewport.CursorIndex:
+	case target-t.viewport.CursorIndex > t.config.ViewportConfig.Height,
+		t.viewport.CursorIndex-target > t.config.ViewportConfig.Height:
+		cmds = append(cmds, t.handleJumpTo(target))
+	default:
+		// Short moves scroll the way the arrow keys do
+		for t.viewport.CursorIndex != target {
+			before := t.viewport.CursorIndex
+			if target > before {
+				cmds = append(cmds, t.handleCursorDown())
+			} else {
+				cmds = append(cmds, t.handleCursorUp())
+			}
+			if t.viewport.CursorIndex == before {
+				break
+			}
+		}
+	}
+
+	if t.viewport.CursorIndex == previousRow && t.currentColumn == previousCol {
+		return tea.Batch(cmds...)
+	}
+	cmds = append(cmds, core.ActiveCellChangedCmd(t.viewport.CursorIndex, t.currentColumn, t.activeCellValue()))
+	return tea.Batch(cmds...)
+}
+
+// adjacentGridColumn returns the nearest column after column in the direction
+// of step that is not hidden through TableColumn.Hidden, without wrapping
+func (t *Table) adjacentGridColumn(column, step int) (int, bool) {
+	for next := column + step; next >= 0 && next < len(t.columns); next += step {
+		if !t.columns[next].Hidden {
+			return next, true
+		}
+	}
+	return column, false
+}
+
+// revealColumn keeps a column dropped to fit the width displayed, refitting
+// the other columns around it
+func (t *Table) revealColumn(column int) {
+	t.revealedColumn = column
+	t.applyOverflowStrategy()
+	t.updateVisibleItems()
+}
+
+// activeCellValue returns the text of the active cell, or "" when its row is
+// not loaded
+func (t *Table) activeCellValue() string {
+	item, ok := t.getItemAtIndex(t.viewport.CursorIndex)
+	if !ok {
+		return ""
+	}
+	row, ok := item.Item.(core.TableRow)
+	if !ok || t.currentColumn >= len(row.Cells) {
+		return ""
+	}
+	return row.Cells[t.currentColumn]
+}
+
+// sign returns -1, 0 or 1 according to the sign of n
+func sign(n int) int {
+	switch {
+	case n < 0:
+		return -1
+	case n > 0:
+		return 1
+	}
+	return 0
+}
@@ -0,0 +1,201 @@
+package table
+
+import (
+	"sync"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/davidroman0O/vtable/core"
+	"github.com/davidroman0O/vtable/data"
+)
+
+// fieldGroupingSource groups the rows of a data source in the table itself,
+// for TableConfig.GroupByFields. It loads every row matching the table's sort
+// and filters, groups them with data.GroupRows and serves the grouped rows by
+// index. Calls addressing rows by ID pass through to the wrapped source.
+type fieldGroupingSource struct {
+	core.DataSource[any]
+
+	mu        sync.Mutex
+	columns   []core.TableColumn
+	groupBy   []string
+	collapsed map[string]bool
+	request   core.DataRequest
+	chunkSize int
+
+	// rows holds the grouped rows until the table resets the source; nil
+	// means they are loaded again on the next call
+	rows []core.Data[any]
+}
+
+// reset replaces the grouping and the request rows are loaded with, and drops
+// the grouped rows so they are loaded again
+func (s *fieldGroupingSource) reset(columns []core.TableColumn, groupBy []string, collapsed map[string]bool, request core.DataRequest, chunkSize int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if chunkSize <= 0 {
+		chunkSize = 100
+	}
+	s.chunkSize = chunkSize
+	s.columns = columns
+	s.groupBy = groupBy
+	s.collapsed = collapsed
+	s.request = request
+	s.rows = nil
+}
+
+// invalidate drops the grouped rows so selection and edits are picked up
+func (s *fieldGroupingSource) invalidate() {
+	s.mu.Lock()
+	s.rows = nil
+	s.mu.Unlock()
+}
+
+// groupedRows returns the grouped rows, loading them from the wrapped source
+// chunk by chunk when they are not held
+func (s *fieldGroupingSource) groupedRows() ([]core.Data[any], error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.rows != nil {
+		return s.rows, nil
+	}
+
+	var rows []core.TableRow
+	items := make(map[string]core.Data[any])
+	err := data.ScanChunks(s.DataSource, s.request, s.chunkSize, func(chunk core.DataChunkLoadedMsg) (bool, error) {
+		for _, item := range chunk.Items {
+			if row, ok := item.Item.(core.TableRow); ok {
+				rows = append(rows, row)
+				items[row.ID] = item
+			}
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	grouped := data.GroupRows(rows, s.columns, s.groupBy, s.collapsed)
+	s.rows = make([]core.Data[any], len(grouped))
+	for i, row := range grouped {
+		item, ok := items[row.ID]
+		if !ok || row.Kind != core.TableRowData {
+			item = core.Data[any]{ID: row.ID, Metadata: core.NewTypedMetadata()}
+		}
+		item.Item = row
+		s.rows[i] = item
+	}
+	return s.rows, nil
+}
+
+// GetTotal returns the number of grouped rows, headers and subtotals included
+func (s *fieldGroupingSource) GetTotal() tea.Cmd {
+	return func() tea.Msg {
+		rows, err := s.groupedRows()
+		if err != nil {
+			return core.DataLoadErrorMsg{Error: err}
+		}
+		return core.DataTotalMsg{Total: len(rows)}
+	}
+}
+
+// RefreshTotal loads the rows again and returns their grouped count
+func (s *fieldGroupingSource) RefreshTotal() tea.Cmd {
+	s.invalidate()
+	return s.GetTotal()
+}
+
+// LoadChunk returns the grouped rows of the requested range
+func (s *fieldGroupingSource) LoadChunk(request core.DataRequest) tea.Cmd {
+	return func() tea.Msg {
+		rows, err := s.groupedRows()
+		if err != nil {
+			return core.DataChunkErrorMsg{StartIndex: request.Start, Error: err, Request: request}
+		}
+
+		start := min(max(request.Start, 0), len(rows))
+		end := min(start+request.Count, len(rows))
+		return core.DataChunkLoadedMsg{
+			StartIndex: request.Start,
+			Items:      append([]core.Data[any](nil), rows[start:end]...),
+			Request:    request,
+		}
+	}
+}
+
+// SetSelected selects the data row at a grouped index; headers and subtotals
+// are ignored
+func (s *fieldGroupingSource) SetSelected(index int, selected bool) tea.Cmd {
+	return s.selectIDs(index, index, selected)
+}
+
+// SelectRange selects the data rows between two grouped indices
+func (s *fieldGroupingSource) SelectRange(startIndex, endIndex int) tea.Cmd {
+	return s.selectIDs(startIndex, endIndex, true)
+}
+
+// selectIDs selects or deselects the data rows between two grouped indices by
+// their ID in the wrapped source
+func (s *fieldGroupingSource) selectIDs(startIndex, endIndex int, selected bool) tea.Cmd {
+	return func() tea.Msg {
+		rows, err := s.groupedRows()
+		if err != nil {
+			return core.SelectionResponseMsg{Success: false, Error: err}
+		}
+
+		var msg tea.Msg = core.SelectionResponseMsg{Success: true}
+		for i := max(startIndex, 0); i <= endIndex && i < len(rows); i++ {
+			if row, ok := rows[i].Item.(core.TableRow); ok && row.Kind == core.TableRowData {
+				if cmd := s.DataSource.SetSelectedByID(row.ID, selected); cmd != nil {
+					msg = cmd()
+				}
+			}
+		}
+		s.invalidate()
+		return msg
+	}
+}
+
+// isGrouped reports whether rows are grouped, by the table or its data source
+func (t *Table) isGrouped() bool {
+	return len(t.config.GroupByFields) > 0 || len(t.config.GroupBy) > 0
+}
+
+// idSource returns the data source for calls addressing rows by ID, which
+// pass through table-side grouping unchanged
+func (t *Table) idSource() core.DataSource[any] {
+	if t.groupSource != nil {
+		return t.groupSource.DataSource
+	}
+	return t.dataSource
+}
+
+// sourceColumns returns the columns in the order of the cells of the rows the
+// data source returns, undoing column moves
+func (t *Table) sourceColumns() []core.TableColumn {
+	columns := make([]core.TableColumn, len(t.columns))
+	for i, col := range t.columns {
+		if cell := t.cellIndex(i); cell >= 0 && cell < len(columns) {
+			columns[cell] = col
+		}
+	}
+	return columns
+}
+
+// resetGroupSource hands the current grouping, sort and filters to the
+// table-side grouping, if any, so the rows are grouped again on next load
+func (t *Table) resetGroupSource() {
+	if t.groupSource == nil {
+		return
+	}
+
+	collapsed := make(map[string]bool, len(t.collapsedGroups))
+	for key, value := range t.collapsedGroups {
+		collapsed[key] = value
+	}
+	request := t.CurrentRequest()
+	request.Start, request.Count = 0, 0
+
+	t.groupSource.reset(t.sourceColumns(), append([]string(nil), t.config.GroupByFields...), collapsed, request, t.config.ViewportConfig.ChunkSize)
+}
@@ -0,0 +1,86 @@
+package table
+
+import (
+	"github.com/davidroman0O/vtable/core"
+	"github.com/davidroman0O/vtable/viewport"
+)
+
+// hintedRowWindow returns the positions in the visible items, from and up to
+// to, of the rows fitting the viewport height according to the data source's
+// height hints. It fails when the source gives no hints. Pinned rows and
+// loading placeholders count as one line, plus the detail line of the card
+// layout.
+func (t *Table) hintedRowWindow(width int) (int, int, bool) {
+	source, ok := t.idSource().(core.HeightHintDataSource)
+	if !ok {
+		return 0, 0, false
+	}
+
+	var heights, positions []int
+	cursor := -1
+	cardDetail := t.showsCardDetail()
+	for i, item := range t.visibleItems {
+		if t.viewport.ViewportStartIndex+i >= t.totalItems {
+			break
+		}
+		if t.isPinnedRow(item.ID) {
+			continue
+		}
+		if i == t.viewport.CursorViewportIndex {
+			cursor = len(heights)
+		}
+
+		height := 1
+		if !isPlaceholderID(item.ID) {
+			height = max(source.RowHeightHint(item.ID, width), 1)
+		}
+		if cardDetail {
+			height++
+		}
+		heights = append(heights, height)
+		positions = append(positions, i)
+	}
+	if len(heights) == 0 {
+		return 0, 0, false
+	}
+
+	start, count := fitHeights(heights, cursor, t.config.ViewportConfig.Height)
+	return positions[start], positions[start+count-1] + 1, true
+}
+
+// hintedBoundingArea returns the bounding area budgeted in lines from the data
+// source's height hints: the rows filling the viewport height and
+// BoundingAreaAfter lines after its start, and BoundingAreaBefore lines
+// before it. Rows not loaded yet count as one line, so the area only shrinks
+// as hinted rows load. It fails when rows span one line or the source gives
+// no hints.
+func (t *Table) hintedBoundingArea() (core.BoundingArea, bool) {
+	if !t.scrollsByLines() || t.totalItems == 0 {
+		return core.BoundingArea{}, false
+	}
+	if _, ok := t.idSource().(core.HeightHintDataSource); !ok {
+		return core.BoundingArea{}, false
+	}
+
+	cfg := t.config.ViewportConfig
+	counter := t.newLineCounter()
+	start := min(max(t.viewport.ViewportStartIndex, 0), t.totalItems-1)
+
+	last, lines := start, counter.lines(start, start)
+	for last+1 < t.totalItems && lines < cfg.Height+cfg.BoundingAreaAfter {
+		last++
+		lines += counter.lines(last, last)
+	}
+	first, lines := start, 0
+	for first > 0 && lines < cfg.BoundingAreaBefore {
+		first--
+		lines += counter.lines(first, first)
+	}
+
+	// The rows found become a one-row viewport with its bounding area in rows
+	rowConfig := cfg
+	rowConfig.Height = 1
+	rowConfig.BoundingAreaBefore = start - first
+	rowConfig.BoundingAreaAfter = last - start
+	return viewport.CalculateBoundingArea(t.viewport, rowConfig, t.totalItems), true
+}
@@ -0,0 +1,59 @@
+package table
+
+import (
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/davidroman0O/vtable/core"
+	"github.com/davidroman0O/vtable/data"
+)
+
+// notifyChanges calls the OnCursorChange and OnSelectionChange hooks for the
+// changes made while handling msg
+func (t *Table) notifyChanges(msg tea.Msg, previousCursor int) {
+	if t.viewport.CursorIndex != previousCursor && t.config.OnCursorChange != nil {
+		var row core.TableRow
+		if item, ok := t.getItemAtIndex(t.viewport.CursorIndex); ok {
+			row, _ = item.Item.(core.TableRow)
+		}
+		t.config.OnCursorChange(previousCursor, t.viewport.CursorIndex, row)
+	}
+
+	if count, changed := t.selectionChange(msg); changed && t.config.OnSelectionChange != nil {
+		t.config.OnSelectionChange(count)
+	}
+}
+
+// selectionChange reports the selection count when it changed. A SelectionCounter
+// is asked right after the DataSource answers a selection operation; otherwise
+// the count is taken from the chunks once those reloaded for the operation
+// arrive, so each change is reported once.
+func (t *Table) selectionChange(msg tea.Msg) (int, bool) {
+	switch msg.(type) {
+	case core.SelectionResponseMsg:
+		if _, ok := t.idSource().(core.SelectionCounter); !ok && len(t.chunks) > 0 {
+			t.selectionReloads += len(t.chunks)
+			return 0, false
+		}
+	case core.DataChunkLoadedMsg:
+		if t.selectionReloads == 0 {
+			return 0, false
+		}
+		if t.selectionReloads--; t.selectionReloads > 0 {
+			return 0, false
+		}
+	default:
+		return 0, false
+	}
+
+	var count int
+	if counter, ok := t.idSource().(core.SelectionCounter); ok {
+		count = counter.GetSelectionCount()
+	} else {
+		count = data.GetSelectionCount(t.chunks)
+	}
+	if count == t.reportedSelection {
+		return count, false
+	}
+	t.reportedSelection = count
+	return count, true
+}
@@ -0,0 +1,236 @@
+package table
+
+import (
+	"fmt"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/davidroman0O/vtable/core"
+	"github.com/davidroman0O/vtable/render"
+)
+
+// incrementalSearch is the state of a search-as-you-type session
+type incrementalSearch struct {
+	query     string
+	prompting bool // The prompt is open and captures keys
+	origin    int  // Cursor index when the search started
+	searchID  int  // Full-source scan whose results are awaited
+	cancel    func()
+	matches   []int
+	current   int // Index into matches, -1 when the cursor is not on a match
+}
+
+// StartSearch opens the incremental search prompt. While it is open, typed
+// characters extend the query and the cursor jumps to the first row containing
+// it (case-insensitive) at or after where the search started. Enter closes the
+// prompt and keeps the matches for SearchNext and SearchPrev, escape clears
+// the search.
+func (t *Table) StartSearch() tea.Cmd {
+	t.stopIncrementalScan()
+	t.incSearch = &incrementalSearch{
+		prompting: true,
+		origin:    t.viewport.CursorIndex,
+		current:   -1,
+	}
+	return core.SearchStateCmd("", 0, 0)
+}
+
+// SearchNext moves the cursor to the next row matching the incremental search,
+// wrapping around at the end
+func (t *Table) SearchNext() tea.Cmd {
+	return t.stepSearchMatch(1)
+}
+
+// SearchPrev moves the cursor to the previous row matching the incremental
+// search, wrapping around at the start
+func (t *Table) SearchPrev() tea.Cmd {
+	return t.stepSearchMatch(-1)
+}
+
+// IsSearching returns whether the incremental search prompt is open
+func (t *Table) IsSearching() bool {
+	return t.incSearch != nil && t.incSearch.prompting
+}
+
+// SearchQuery returns the incremental search query, empty when no search is
+// active
+func (t *Table) SearchQuery() string {
+	if t.incSearch == nil {
+		return ""
+	}
+	return t.incSearch.query
+}
+
+// ClearSearch ends the incremental search and removes its highlights
+func (t *Table) ClearSearch() tea.Cmd {
+	if t.incSearch == nil {
+		return nil
+	}
+	t.stopIncrementalScan()
+	t.incSearch = nil
+	return core.SearchStateCmd("", 0, 0)
+}
+
+// handleSearchKey handles a key press while the search prompt is open
+func (t *Table) handleSearchKey(msg tea.KeyMsg) tea.Cmd {
+	search := t.incSearch
+
+	switch msg.Type {
+	case tea.KeyEsc:
+		return t.ClearSearch()
+	case tea.KeyEnter:
+		search.prompting = false
+		return nil
+	case tea.KeyDown, tea.KeyCtrlN:
+		return t.SearchNext()
+	case tea.KeyUp, tea.KeyCtrlP:
+		return t.SearchPrev()
+	case tea.KeyBackspace:
+		runes := []rune(search.query)
+		if len(runes) == 0 {
+			return nil
+		}
+		return t.setSearchQuery(string(runes[:len(runes)-1]))
+	case tea.KeySpace:
+		return t.setSearchQuery(search.query + " ")
+	case tea.KeyRunes:
+		return t.setSearchQuery(search.query + string(msg.Runes))
+	}
+	return nil
+}
+
+// setSearchQuery replaces the query and rescans the data source for it. An
+// empty query clears the matches without moving the cursor.
+func (t *Table) setSearchQuery(query string) tea.Cmd {
+	search := t.incSearch
+	t.stopIncrementalScan()
+	search.query = query
+	search.matches = nil
+	search.current = -1
+
+	if query == "" {
+		return core.SearchStateCmd("", 0, 0)
+	}
+
+	cmd, cancel := t.SearchAllAsync(query, nil)
+	search.searchID = t.activeSearch.id
+	search.cancel = cancel
+	return cmd
+}
+
+// stopIncrementalScan cancels the scan of the incremental search, if any
+func (t *Table) stopIncrementalScan() {
+	if t.incSearch == nil || t.incSearch.cancel == nil {
+		return
+	}
+	t.incSearch.cancel()
+	t.incSearch.cancel = nil
+	if t.activeSearch != nil && t.activeSearch.id == t.incSearch.searchID {
+		t.activeSearch = nil
+	}
+}
+
+// handleIncrementalMatches stores the rows matching the incremental search and
+// jumps to the first one at or after the search origin. Without matches the
+// cursor stays where it is.
+func (t *Table) handleIncrementalMatches(indices []int) tea.Cmd {
+	search := t.incSearch
+	search.cancel = nil
+	search.matches = indices
+	search.current = -1
+
+	if len(indices) == 0 {
+		return t.searchStateCmd()
+	}
+
+	search.current = 0
+	for i, index := range indices {
+		if index >= search.origin {
+			search.current = i
+			break
+		}
+	}
+
+	return tea.Batch(t.handleJumpTo(indices[search.current]), t.searchStateCmd())
+}
+
+// stepSearchMatch moves the cursor by delta matches, wrapping around
+func (t *Table) stepSearchMatch(delta int) tea.Cmd {
+	if t.incSearch == nil || len(t.incSearch.matches) == 0 {
+		return nil
+	}
+	search := t.incSearch
+
+	count := len(search.matches)
+	if search.current < 0 {
+		search.current = 0
+	} else {
+		search.current = ((search.current+delta)%count + count) % count
+	}
+
+	return tea.Batch(t.handleJumpTo(search.matches[search.current]), t.searchStateCmd())
+}
+
+// searchStateCmd reports the current incremental search state
+func (t *Table) searchStateCmd() tea.Cmd {
+	search := t.incSearch
+	return core.SearchStateCmd(search.query, len(search.matches), search.current+1)
+}
+
+// highlightSearchMatches renders content with base, styling occurrences of the
+// incremental search query with the theme's SearchMatchStyle. Content already
+// carrying ANSI styling from a formatter is rendered unchanged.
+func (t *Table) highlightSearchMatches(content string, base lipgloss.Style) string {
+	if t.incSearch == nil || t.incSearch.query == "" || strings.Contains(content, "\x1b[") {
+		return base.Render(content)
+	}
+
+	// Folding may change byte lengths, which would misplace the matches
+	lower := core.FoldFilterText(content, t.foldDiacritics)
+	needle := core.FoldFilterText(t.incSearch.query, t.foldDiacritics)
+	if len(lower) != len(content) || !strings.Contains(lower, needle) {
+		return base.Render(content)
+	}
+
+	matchStyle := t.config.Theme.SearchMatchStyle
+	var builder strings.Builder
+	for {
+		idx := strings.Index(lower, needle)
+		if idx < 0 {
+			break
+		}
+		if idx > 0 {
+			builder.WriteString(base.Render(content[:idx]))
+		}
+		builder.WriteString(matchStyle.Render(content[idx : idx+len(needle)]))
+		content = content[idx+len(needle):]
+		lower = lower[idx+len(needle):]
+	}
+	if content != "" {
+		builder.WriteString(base.Render(content))
+	}
+	return builder.String()
+}
+
+// renderSearchPrompt renders the open search prompt with its match position,
+// fitted to the table frame width
+func (t *Table) renderSearchPrompt() string {
+	search := t.incSearch
+	prompt := "/" + search.query
+	if search.query != "" && search.cancel == nil {
+		if len(search.matches) == 0 {
+			prompt += "  [no match]"
+		} else {
+			prompt += fmt.Sprintf("  [%d/%d]", search.current+1, len(search.matches))
+		}
+	}
+
+	width := t.frameWidth()
+	prompt = render.TruncateText(prompt, width)
+	if pad := width - lipgloss.Width(prompt); pad > 0 {
+		prompt += strings.Repeat(" ", pad)
+	}
+	return t.config.Theme.StatusStyle.Render(prompt)
+}
@@ -0,0 +1,71 @@
+package table
+
+import (
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/davidroman0O/vtable/core"
+	"github.com/davidroman0O/vtable/data"
+)
+
+// IsIndexLoading reports whether the row at index belongs to a chunk that has
+// been requested from the data source and has not arrived yet
+func (t *Table) IsIndexLoading(index int) bool {
+	return data.IsIndexLoading(index, t.config.ViewportConfig.ChunkSize, t.loadingChunks)
+}
+
+// startLoadingAnimation schedules the first LoadingTickMsg when placeholders
+// are animated and no tick is pending
+func (t *Table) startLoadingAnimation() tea.Cmd {
+	interval := t.config.ViewportConfig.LoadingAnimationInterval
+	if interval <= 0 || t.loadingTicking {
+		return nil
+	}
+	t.loadingTicking = true
+	return core.LoadingTickCmd(interval)
+}
+
+// handleLoadingTick advances the loading animation and keeps ticking while
+// chunks are loading
+func (t *Table) handleLoadingTick() tea.Cmd {
+	t.loadingTicking = false
+	if !t.hasLoadingChunks {
+		return nil
+	}
+	t.renderContext.LoadingFrame++
+	return t.startLoadingAnimation()
+}
+
+// renderLoadingPlaceholder renders the ViewportConfig.LoadingPlaceholder
+// content across the visible columns of a row
+func (t *Table) renderLoadingPlaceholder(content string, isCursor bool) string {
+	span := -1
+	for i, col := range t.columns {
+		if !t.hiddenColumns[i] {
+			span += col.Width + 1
+		}
+	}
+	if span < 0 {
+		span = 0
+	}
+
+	line := t.applyCellConstraints(content, core.CellConstraint{
+		Width:     span,
+		Height:    1,
+		Alignment: core.AlignLeft,
+	}, -1)
+	indicator := "    "
+
+	style := t.config.Theme.CellStyle
+	if isCursor {
+		style = t.cursorStyle()
+		if t.config.FullRowHighlighting {
+			style = t.fullRowCursorStyle()
+		}
+	}
+
+	result := style.Render(indicator) + t.getBorderChar() + style.Render(line)
+	if t.config.ShowBorders {
+		result = t.getBorderChar() + result + t.getBorderChar()
+	}
+	return result
+}
@@ -0,0 +1,98 @@
+package table
+
+import (
+	"errors"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/davidroman0O/vtable/core"
+)
+
+// errRowFound stops a row scan once the searched row is found
+var errRowFound = errors.New("row found")
+
+// markLocatedMsg reports where the row of a mark was found by a data source
+// scan, or an index of -1 when it is not in the data
+type markLocatedMsg struct {
+	name  rune
+	id    string
+	index int
+}
+
+// SetMark marks the row under the cursor with a single-character name,
+// replacing any row marked with that name before
+func (t *Table) SetMark(name rune) tea.Cmd {
+	return core.SetMarkCmd(name)
+}
+
+// JumpToMark moves the cursor to the row marked with name. Marks follow rows
+// by ID, so they survive sorting, filtering and refreshes; core.MarkUnavailableMsg
+// is emitted when the mark is not set or its row is not in the data.
+func (t *Table) JumpToMark(name rune) tea.Cmd {
+	return core.JumpToMarkCmd(name)
+}
+
+// GetMarks returns the row ID of each mark, keyed by mark name
+func (t *Table) GetMarks() map[rune]string {
+	marks := make(map[rune]string, len(t.marks))
+	for name, id := range t.marks {
+		marks[name] = id
+	}
+	return marks
+}
+
+// handleSetMark records the ID of the row under the cursor
+func (t *Table) handleSetMark(name rune) {
+	item, ok := t.getItemAtIndex(t.viewport.CursorIndex)
+	if !ok || item.ID == "" {
+		return
+	}
+	if t.marks == nil {
+		t.marks = make(map[rune]string)
+	}
+	t.marks[name] = item.ID
+}
+
+// handleJumpToMark jumps to a marked row in the loaded chunks, or otherwise
+// scans the data source for it with the current sort and filters
+func (t *Table) handleJumpToMark(name rune) tea.Cmd {
+	id, ok := t.marks[name]
+	if !ok {
+		return markUnavailableCmd(name)
+	}
+	if index := t.findItemIndex(id); index >= 0 {
+		return t.handleJumpTo(index)
+	}
+
+	export := t.newCSVExport(false)
+	return func() tea.Msg {
+		located := markLocatedMsg{name: name, id: id, index: -1}
+		_ = export.eachRow(func(row core.TableRow, index int, selected bool) error {
+			if row.ID != id {
+				return nil
+			}
+			located.index = index
+			return errRowFound
+		})
+		return located
+	}
+}
+
+// handleMarkLocated jumps to the row found by a mark scan, unless the mark was
+// moved meanwhile
+func (t *Table) handleMarkLocated(msg markLocatedMsg) tea.Cmd {
+	if t.marks[msg.name] != msg.id {
+		return nil
+	}
+	if msg.index < 0 || msg.index >= t.totalItems {
+		return markUnavailableCmd(msg.name)
+	}
+	return t.handleJumpTo(msg.index)
+}
+
+// markUnavailableCmd reports a mark that cannot be jumped to
+func markUnavailableCmd(name rune) tea.Cmd {
+	return func() tea.Msg {
+		return core.MarkUnavailableMsg{Name: name}
+	}
+}
@@ -0,0 +1,159 @@
+package table
+
+import (
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/davidroman0O/vtable/core"
+	"github.com/davidroman0O/vtable/data"
+)
+
+// matchSearch is the state of a predicate scan. It is only touched by the
+// scan step commands, which run one at a time.
+type matchSearch struct {
+	id       int
+	pred     func(row core.TableRow) bool
+	request  core.DataRequest
+	total    int
+	backward bool
+
+	next    int // Next index to scan
+	scanned int
+}
+
+// JumpToMatch moves the cursor to the first row, in the table's current sort
+// and filter order, for which pred returns true. A data source implementing
+// core.SearchableDataProvider is asked directly; otherwise the table loads the
+// data one chunk per step, emitting core.MatchSearchProgressMsg after each
+// chunk without a match, until a row matches or the rows run out. Either way
+// the scan ends with core.MatchSearchCompleteMsg. Group header and total rows
+// are skipped.
+func (t *Table) JumpToMatch(pred func(row core.TableRow) bool) tea.Cmd {
+	return t.startMatchSearch(pred, 0, false)
+}
+
+// JumpToNextMatch is like JumpToMatch, scanning forward from the row after the
+// cursor. It stops at the last row.
+func (t *Table) JumpToNextMatch(pred func(row core.TableRow) bool) tea.Cmd {
+	return t.startMatchSearch(pred, t.viewport.CursorIndex+1, false)
+}
+
+// JumpToPrevMatch is like JumpToMatch, scanning backward from the row before
+// the cursor. It stops at the first row.
+func (t *Table) JumpToPrevMatch(pred func(row core.TableRow) bool) tea.Cmd {
+	return t.startMatchSearch(pred, t.viewport.CursorIndex-1, true)
+}
+
+// startMatchSearch starts a predicate scan from index, replacing any scan in
+// progress
+func (t *Table) startMatchSearch(pred func(row core.TableRow) bool, from int, backward bool) tea.Cmd {
+	if pred == nil || t.dataSource == nil {
+		return nil
+	}
+
+	search := &matchSearch{
+		id:       int(searchIDs.Add(1)),
+		pred:     pred,
+		request:  data.CreateDataRequest(0, 0, copyStrings(t.sortFields), copyStrings(t.sortDirs), copyFilters(t.filters)),
+		total:    t.totalItems,
+		backward: backward,
+		next:     from,
+	}
+	search.request.FieldTypes = t.fieldTypes()
+	search.request.SortComparators = t.sortComparators()
+	search.request.FuzzyFields = copyStrings(t.fuzzyFields)
+	search.request.FilterFoldDiacritics = t.foldDiacritics
+	t.activeMatch = search
+
+	if provider, ok := t.dataSource.(core.SearchableDataProvider); ok && from == 0 && !backward {
+		request := search.request
+		return func() tea.Msg {
+			index, found := provider.FindItemIndexFunc(request, pred)
+			if !found {
+				index = -1
+			}
+			return core.MatchSearchCompleteMsg{SearchID: search.id, Index: index, Found: found}
+		}
+	}
+	return t.matchSearchStep(search)
+}
+
+// matchSearchStep returns a command scanning the next chunk of a predicate scan
+func (t *Table) matchSearchStep(search *matchSearch) tea.Cmd {
+	dataSource := t.dataSource
+	chunkSize := t.config.ViewportConfig.ChunkSize
+	if chunkSize <= 0 {
+		chunkSize = 100
+	}
+
+	return func() tea.Msg {
+		complete := func(index int, err error) tea.Msg {
+			return core.MatchSearchCompleteMsg{SearchID: search.id, Index: index, Found: index >= 0, Error: err}
+		}
+		if search.next < 0 || search.next >= search.total {
+			return complete(-1, nil)
+		}
+
+		// Backward scans load the chunk ending at the next index
+		start := search.next
+		if search.backward {
+			start = max(0, search.next-chunkSize+1)
+		}
+		request := search.request
+		request.Start = start
+		request.Count = data.CalculateActualChunkSize(start, chunkSize, search.total)
+		if search.backward {
+			request.Count = search.next - start + 1
+		}
+
+		chunk, err := data.LoadChunkNow(dataSource, request)
+		if err != nil {
+			return complete(-1, err)
+		}
+		for n := range chunk.Items {
+			i := n
+			if search.backward {
+				i = len(chunk.Items) - 1 - n
+			}
+			row, ok := chunk.Items[i].Item.(core.TableRow)
+			if ok && row.Kind == core.TableRowData && search.pred(row) {
+				return complete(chunk.StartIndex+i, nil)
+			}
+		}
+
+		search.scanned += request.Count
+		if search.backward {
+			search.next = start - 1
+		} else {
+			search.next = start + request.Count
+		}
+		if search.next < 0 || search.next >= search.total {
+			return complete(-1, nil)
+		}
+
+		return core.MatchSearchProgressMsg{
+			SearchID: search.id,
+			Scanned:  search.scanned,
+			Total:    search.total,
+		}
+	}
+}
+
+// handleMatchSearchProgress schedules the next step of the active predicate scan
+func (t *Table) handleMatchSearchProgress(msg core.MatchSearchProgressMsg) tea.Cmd {
+	if t.activeMatch == nil || t.activeMatch.id != msg.SearchID {
+		return nil
+	}
+	return t.matchSearchStep(t.activeMatch)
+}
+
+// handleMatchSearchComplete jumps to the row found by the active predicate scan
+func (t *Table) handleMatchSearchComplete(msg core.MatchSearchCompleteMsg) tea.Cmd {
+	if t.activeMatch == nil || t.activeMatch.id != msg.SearchID {
+		return nil
+	}
+	t.activeMatch = nil
+	if !msg.Found || msg.Index >= t.totalItems {
+		return nil
+	}
+	return t.handleJumpTo(msg.Index)
+}
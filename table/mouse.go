@@ -0,0 +1,102 @@
+package table
+
+import (
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/davidroman0O/vtable/core"
+	"github.com/davidroman0O/vtable/viewport"
+)
+
+// doubleClickInterval is the longest delay between two clicks on the same row
+// that still counts as a double click
+const doubleClickInterval = 500 * time.Millisecond
+
+// mouseClick remembers the last click to detect double clicks
+type mouseClick struct {
+	index int
+	at    time.Time
+}
+
+// recordRowLayout remembers which row each rendered line belongs to, given the
+// number of lines above the rows and the absolute index of each row, -1 for
+// rows that only remain on screen during a transition
+func (t *Table) recordRowLayout(top int, rows []string, indices []int) {
+	t.bodyTop = top
+	t.lineRows = t.lineRows[:0]
+	for i, row := range rows {
+		for range strings.Count(row, "\n") + 1 {
+			t.lineRows = append(t.lineRows, indices[i])
+		}
+	}
+}
+
+// rowAtLine returns the absolute index of the row rendered on line y of the
+// last view, or false for border, header, status and removed rows
+func (t *Table) rowAtLine(y int) (int, bool) {
+	line := y - t.bodyTop
+	if line < 0 || line >= len(t.lineRows) || t.lineRows[line] < 0 {
+		return 0, false
+	}
+	return t.lineRows[line], true
+}
+
+// handleMouse moves the cursor to a clicked row, reports double clicks with
+// ItemActivatedMsg and turns the wheel into cursor movement
+func (t *Table) handleMouse(msg tea.MouseMsg) tea.Cmd {
+	if !t.config.MouseEnabled || !t.focused || msg.Action != tea.MouseActionPress {
+		return nil
+	}
+
+	switch msg.Button {
+	case tea.MouseButtonWheelUp:
+		return t.handleCursorUp()
+	case tea.MouseButtonWheelDown:
+		return t.handleCursorDown()
+	case tea.MouseButtonLeft:
+	default:
+		return nil
+	}
+
+	index, ok := t.rowAtLine(msg.Y)
+	if !ok {
+		return nil
+	}
+
+	// A click on a checkbox toggles it without counting toward a double click
+	if t.isCheckboxColumn(msg.X) {
+		t.moveCursorInView(index)
+		return t.toggleCheckbox(index)
+	}
+
+	now := time.Now()
+	double := t.lastClick.index == index && now.Sub(t.lastClick.at) <= doubleClickInterval
+	t.lastClick = mouseClick{index: index, at: now}
+
+	t.moveCursorInView(index)
+	if !double {
+		return nil
+	}
+
+	// A third click starts a new double click
+	t.lastClick = mouseClick{index: -1}
+	var id string
+	if item, ok := t.getItemAtIndex(index); ok {
+		id = item.ID
+	}
+	return core.ItemActivatedCmd(index, id)
+}
+
+// moveCursorInView moves the cursor to a row of the current viewport without
+// scrolling it
+func (t *Table) moveCursorInView(index int) {
+	if index < t.viewport.ViewportStartIndex || index >= t.totalItems || index == t.viewport.CursorIndex {
+		return
+	}
+	t.viewport.CursorIndex = index
+	t.viewport.CursorViewportIndex = index - t.viewport.ViewportStartIndex
+	t.viewport = viewport.UpdateViewportBounds(t.viewport, t.config.ViewportConfig, t.totalItems)
+	t.handleScrollResetOnNavigation()
+}
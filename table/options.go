@@ -0,0 +1,97 @@
+package table
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/davidroman0O/vtable/config"
+	"github.com/davidroman0O/vtable/core"
+)
+
+// Option configures a table built with New. An option returns an error when
+// its arguments are invalid.
+type Option func(cfg *core.TableConfig) error
+
+// New creates a table reading from dataSource, starting from
+// config.DefaultTableConfig and applying opts in order. Unlike NewTable, which
+// silently fixes an invalid configuration, it returns an error when an option
+// is rejected or the resulting configuration does not validate, for example
+// when no columns are given.
+func New(dataSource core.DataSource[any], opts ...Option) (*Table, error) {
+	cfg := config.DefaultTableConfig()
+	for _, opt := range opts {
+		if err := opt(&cfg); err != nil {
+			return nil, err
+		}
+	}
+	if errs := config.ValidateTableConfig(&cfg); len(errs) > 0 {
+		return nil, errors.Join(errs...)
+	}
+	return NewTable(cfg, dataSource), nil
+}
+
+// WithColumns sets the table columns.
+func WithColumns(columns ...core.TableColumn) Option {
+	return func(cfg *core.TableConfig) error {
+		if len(columns) == 0 {
+			return errors.New("table must have at least one column")
+		}
+		cfg.Columns = append([]core.TableColumn(nil), columns...)
+		return nil
+	}
+}
+
+// WithViewportHeight sets the number of visible rows.
+func WithViewportHeight(height int) Option {
+	return func(cfg *core.TableConfig) error {
+		if height <= 0 {
+			return fmt.Errorf("viewport height must be positive, got %d", height)
+		}
+		cfg.ViewportConfig.Height = height
+		return nil
+	}
+}
+
+// WithThresholds sets the scroll thresholds, as offsets from the top and the
+// bottom of the viewport. -1 disables a threshold.
+func WithThresholds(top, bottom int) Option {
+	return func(cfg *core.TableConfig) error {
+		if top < -1 || bottom < -1 {
+			return fmt.Errorf("thresholds must be -1 (disabled) or non-negative, got %d and %d", top, bottom)
+		}
+		cfg.ViewportConfig.TopThreshold = top
+		cfg.ViewportConfig.BottomThreshold = bottom
+		return nil
+	}
+}
+
+// WithChunkSize sets the number of rows loaded per data source request.
+func WithChunkSize(size int) Option {
+	return func(cfg *core.TableConfig) error {
+		if size <= 0 {
+			return fmt.Errorf("chunk size must be positive, got %d", size)
+		}
+		cfg.ViewportConfig.ChunkSize = size
+		return nil
+	}
+}
+
+// WithSelectionMode sets how rows are selected.
+func WithSelectionMode(mode core.SelectionMode) Option {
+	return func(cfg *core.TableConfig) error {
+		switch mode {
+		case core.SelectionSingle, core.SelectionMultiple, core.SelectionNone:
+			cfg.SelectionMode = mode
+			return nil
+		}
+		return fmt.Errorf("unknown selection mode %d", mode)
+	}
+}
+
+// WithTheme sets the table theme.
+func WithTheme(theme core.Theme) Option {
+	return func(cfg *core.TableConfig) error {
+		cfg.Theme = theme
+		return nil
+	}
+}
@@ -0,0 +1,215 @@
+package table
+
+import (
+	"sort"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/davidroman0O/vtable/core"
+)
+
+// minShrinkWidth is the narrowest a column is shrunk to by OverflowShrink
+const minShrinkWidth = 3
+
+// applyOverflowStrategy rebuilds the displayed columns from the configured ones
+// so they fit the available width. The configured widths are kept intact, so a
+// wider terminal restores shrunk or hidden columns.
+func (t *Table) applyOverflowStrategy() {
+	t.hiddenColumns = make(map[int]bool)
+
+	cardMode := t.isCardLayout()
+	if (t.config.OverflowStrategy == core.OverflowScroll && !cardMode) || t.availableWidth <= 0 {
+		t.columns = t.config.Columns
+		t.hideColumnsByConfig()
+		return
+	}
+
+	t.columns = make([]core.TableColumn, len(t.config.Columns))
+	copy(t.columns, t.config.Columns)
+	t.hideColumnsByConfig()
+
+	excess := t.frameWidth() - t.availableWidth
+	if excess <= 0 {
+		return
+	}
+
+	if cardMode {
+		t.hideLowPriorityColumns()
+		return
+	}
+
+	switch t.config.OverflowStrategy {
+	case core.OverflowShrink:
+		t.shrinkColumns(excess)
+	case core.OverflowHidePriority:
+		t.hideLowPriorityColumns()
+	}
+}
+
+// shrinkColumns narrows columns in proportion to how much each can give up,
+// never below minShrinkWidth. Pinned columns keep their width.
+func (t *Table) shrinkColumns(excess int) {
+	shrinkable := 0
+	for _, col := range t.columns {
+		if col.Width > minShrinkWidth && !col.Pinned {
+			shrinkable += col.Width - minShrinkWidth
+		}
+	}
+	if shrinkable == 0 {
+		return
+	}
+	if excess > shrinkable {
+		excess = shrinkable
+	}
+
+	removed := 0
+	for i, col := range t.columns {
+		if col.Width <= minShrinkWidth || col.Pinned {
+			continue
+		}
+		cut := excess * (col.Width - minShrinkWidth) / shrinkable
+		t.columns[i].Width -= cut
+		removed += cut
+	}
+
+	// Take the rounding remainder from the widest columns
+	for removed < excess {
+		widest := -1
+		for i, col := range t.columns {
+			if col.Width > minShrinkWidth && !col.Pinned && (widest < 0 || col.Width > t.columns[widest].Width) {
+				widest = i
+			}
+		}
+		if widest < 0 {
+			break
+		}
+		t.columns[widest].Width--
+		removed++
+	}
+}
+
+// hideLowPriorityColumns hides columns from the lowest Priority up, rightmost
+// first among equals, until the table fits. At least one column stays visible,
+// and pinned columns and the column grid navigation scrolled into view are
+// never hidden.
+func (t *Table) hideLowPriorityColumns() {
+	order := make([]int, len(t.columns))
+	for i := range order {
+		order[i] = i
+	}
+	sort.SliceStable(order, func(a, b int) bool {
+		pa, pb := t.columns[order[a]].Priority, t.columns[order[b]].Priority
+		if pa != pb {
+			return pa < pb
+		}
+		return order[a] > order[b]
+	})
+
+	for _, idx := range order {
+		if t.frameWidth() <= t.availableWidth || len(t.hiddenColumns) >= len(t.columns)-1 {
+			break
+		}
+		if t.columns[idx].Pinned || idx == t.revealedColumn {
+			continue
+		}
+		t.hiddenColumns[idx] = true
+	}
+}
+
+// isCardLayout reports whether the responsive card layout applies at the
+// current available width
+func (t *Table) isCardLayout() bool {
+	if !t.config.ResponsiveCard || t.availableWidth <= 0 {
+		return false
+	}
+	if t.config.ResponsiveCardWidth > 0 {
+		return t.availableWidth < t.config.ResponsiveCardWidth
+	}
+
+	// Without a threshold, switch as soon as the declared columns overflow
+	width := 4
+	for _, col := range t.config.Columns {
+		width += col.Width + 1
+	}
+	if t.config.ShowBorders {
+		width += 2
+	}
+	return width > t.availableWidth
+}
+
+// renderCardDetail renders the second line of a card row with the values of
+// the collapsed columns, spanning the visible columns
+func (t *Table) renderCardDetail(item core.Data[any], isCursor bool) string {
+	span := -1
+	for i, col := range t.columns {
+		if !t.hiddenColumns[i] {
+			span += col.Width + 1
+		}
+	}
+	if span < 0 {
+		span = 0
+	}
+
+	var details []string
+	if row, ok := item.Item.(core.TableRow); ok && row.Kind == core.TableRowData {
+		for i, col := range t.columns {
+			if !t.hiddenColumns[i] || col.Hidden {
+				continue
+			}
+			var value string
+			if i < len(row.Cells) {
+				value = row.Cells[i]
+			}
+			details = append(details, col.Title+": "+value)
+		}
+	}
+
+	detail := t.applyCellConstraints(strings.Join(details, "  "), core.CellConstraint{
+		Width:     span,
+		Height:    1,
+		Alignment: core.AlignLeft,
+	}, -1)
+	indicator := strings.Repeat(" ", 4)
+
+	style := t.config.Theme.CellStyle
+	if t.config.FullRowHighlighting && isCursor {
+		style = t.fullRowCursorStyle()
+	} else if item.Selected {
+		style = t.config.Theme.SelectedStyle
+	}
+
+	result := style.Render(indicator) + t.getBorderChar() + style.Render(detail)
+	if t.config.ShowBorders {
+		result = t.getBorderChar() + result + t.getBorderChar()
+	}
+	return result
+}
+
+// lastVisibleColumn returns the index of the rightmost column that is not hidden
+func (t *Table) lastVisibleColumn() int {
+	for i := len(t.columns) - 1; i >= 0; i-- {
+		if !t.hiddenColumns[i] {
+			return i
+		}
+	}
+	return -1
+}
+
+// SetOverflowStrategy changes how columns are fitted to the available width
+func (t *Table) SetOverflowStrategy(strategy core.OverflowStrategy) tea.Cmd {
+	return core.OverflowStrategySetCmd(strategy)
+}
+
+// GetHiddenColumns returns the indices of columns hidden to fit the available
+// width, leaving out columns hidden through TableColumn.Hidden
+func (t *Table) GetHiddenColumns() []int {
+	hidden := make([]int, 0, len(t.hiddenColumns))
+	for idx := range t.hiddenColumns {
+		if !t.columns[idx].Hidden {
+			hidden = append(hidden, idx)
+		}
+	}
+	sort.Ints(hidden)
+	return hidden
+}
@@ -0,0 +1,27 @@
+package table
+
+import (
+	"fmt"
+
+	"github.com/davidroman0O/vtable/core"
+	"github.com/davidroman0O/vtable/viewport"
+)
+
+// GetPageInfo returns the 1-based page of the cursor, the number of pages and
+// the page size, which is the viewport height. The page count follows the
+// total, so it shrinks and grows with filtering.
+func (t *Table) GetPageInfo() (page, totalPages, pageSize int) {
+	page, totalPages = viewport.PageInfo(t.viewport, t.config.ViewportConfig, t.totalItems)
+	return page, totalPages, t.config.ViewportConfig.Height
+}
+
+// renderPageIndicator renders the "Page 3/17" line fitted to the table width
+func (t *Table) renderPageIndicator() string {
+	page, totalPages, _ := t.GetPageInfo()
+	text := t.applyCellConstraints(fmt.Sprintf("Page %d/%d", page, totalPages), core.CellConstraint{
+		Width:     t.frameWidth(),
+		Height:    1,
+		Alignment: core.AlignRight,
+	}, -1)
+	return t.config.Theme.StatusStyle.Render(text)
+}
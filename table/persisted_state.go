@@ -0,0 +1,144 @@
+package table
+
+import (
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/davidroman0O/vtable/core"
+)
+
+// restoredCursorMsg reports where a data source scan found the cursor row of a
+// restored state, or an index of -1 when it is no longer in the data
+type restoredCursorMsg struct {
+	id    string
+	index int
+}
+
+// SaveState captures the view state of the table: the cursor row, the active
+// column and horizontal scroll offsets, column widths, sorting, filters and
+// selection. Selected IDs come from the data source when it implements
+// GetSelectedIDs, otherwise from the loaded rows.
+func (t *Table) SaveState() core.PersistedState {
+	state := core.PersistedState{
+		CursorIndex:    t.viewport.CursorIndex,
+		ActiveColumn:   t.currentColumn,
+		SortFields:     copyStrings(t.sortFields),
+		SortDirections: copyStrings(t.sortDirs),
+		Filters:        core.PersistFilters(t.filters),
+		SelectedIDs:    t.GetSelectedIDs(),
+	}
+	if source, ok := t.idSource().(interface{ GetSelectedIDs() []string }); ok {
+		state.SelectedIDs = source.GetSelectedIDs()
+	}
+
+	if item, ok := t.getItemAtIndex(t.viewport.CursorIndex); ok {
+		state.CursorID = item.ID
+	}
+
+	for col, offset := range t.horizontalScrollOffsets {
+		if offset > 0 {
+			if state.ScrollOffsets == nil {
+				state.ScrollOffsets = make(map[int]int)
+			}
+			state.ScrollOffsets[col] = offset
+		}
+	}
+
+	for _, col := range t.config.Columns {
+		if col.Field == "" {
+			continue
+		}
+		if state.ColumnWidths == nil {
+			state.ColumnWidths = make(map[string]int)
+		}
+		state.ColumnWidths[col.Field] = col.Width
+	}
+
+	return state
+}
+
+// RestoreState applies a state saved with SaveState and reloads the data. The
+// cursor returns to the saved row, found by ID with the restored sort and
+// filters; when the row is gone it stays at the saved index, clamped to the
+// rows.
+func (t *Table) RestoreState(state core.PersistedState) tea.Cmd {
+	return core.StateRestoreCmd(state)
+}
+
+// handleStateRestore applies a saved state. The selection is replaced through
+// the data source before the data reloads.
+func (t *Table) handleStateRestore(state core.PersistedState) tea.Cmd {
+	var cmds []tea.Cmd
+	for i, col := range t.config.Columns {
+		if width, ok := state.ColumnWidths[col.Field]; ok && col.Field != "" {
+			cmds = append(cmds, t.setColumnWidth(i, width))
+		}
+	}
+
+	if state.ActiveColumn >= 0 && state.ActiveColumn < len(t.columns) {
+		t.currentColumn = state.ActiveColumn
+	}
+	t.horizontalScrollOffsets = make(map[int]int, len(state.ScrollOffsets))
+	for col, offset := range state.ScrollOffsets {
+		if offset > 0 {
+			t.horizontalScrollOffsets[col] = offset
+		}
+	}
+
+	t.pendingCursorID = state.CursorID
+	reload := t.handleDataRequestSet(core.DataRequest{
+		Start:          state.CursorIndex,
+		SortFields:     state.SortFields,
+		SortDirections: state.SortDirections,
+		Filters:        core.RestoreFilters(state.Filters),
+		FuzzyFields:    t.fuzzyFields,
+
+		FilterFoldDiacritics: t.foldDiacritics,
+	})
+
+	if t.dataSource != nil && t.config.SelectionMode != core.SelectionNone {
+		t.selectionHistory = nil
+		sequence := []tea.Cmd{t.dataSource.ClearSelection()}
+		for _, id := range state.SelectedIDs {
+			sequence = append(sequence, t.dataSource.SetSelectedByID(id, true))
+		}
+		reload = tea.Sequence(append(sequence, reload)...)
+	}
+
+	return tea.Batch(append(cmds, reload)...)
+}
+
+// locateRestoredCursor returns a command scanning the data source for the
+// cursor row of a restored state, once its total is known
+func (t *Table) locateRestoredCursor() tea.Cmd {
+	id := t.pendingCursorID
+	if id == "" {
+		return nil
+	}
+
+	export := t.newCSVExport(false)
+	return func() tea.Msg {
+		located := restoredCursorMsg{id: id, index: -1}
+		_ = export.eachRow(func(row core.TableRow, index int, selected bool) error {
+			if row.ID != id {
+				return nil
+			}
+			located.index = index
+			return errRowFound
+		})
+		return located
+	}
+}
+
+// handleRestoredCursor moves the cursor to the row found by the scan, unless
+// another state was restored meanwhile. A row no longer in the data leaves the
+// cursor at the saved index.
+func (t *Table) handleRestoredCursor(msg restoredCursorMsg) tea.Cmd {
+	if msg.id != t.pendingCursorID {
+		return nil
+	}
+	t.pendingCursorID = ""
+	if msg.index < 0 || msg.index >= t.totalItems {
+		return t.settleRowTransition()
+	}
+	return tea.Batch(t.handleJumpTo(msg.index), t.settleRowTransition())
+}
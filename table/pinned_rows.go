@@ -0,0 +1,135 @@
+package table
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/davidroman0O/vtable/core"
+)
+
+// pinnedRowsMsg carries the pinned rows found by a scan of the data source
+type pinnedRowsMsg struct {
+	items map[string]core.Data[any]
+}
+
+// PinRow pins the row with the given ID above the scrolling rows, where it
+// stays whatever the scroll position, followed by a separator and styled with
+// the theme's PinnedRowStyle. Pinned rows are left out of the scrolling rows
+// and the cursor skips them. A pinned row survives sorting and filtering, and
+// is shown again as long as it is still in the data.
+func (t *Table) PinRow(id string) tea.Cmd {
+	return core.RowPinCmd(id, true)
+}
+
+// UnpinRow returns a pinned row to its place among the scrolling rows
+func (t *Table) UnpinRow(id string) tea.Cmd {
+	return core.RowPinCmd(id, false)
+}
+
+// PinnedRowIDs returns the IDs of the pinned rows, in pinning order
+func (t *Table) PinnedRowIDs() []string {
+	return slices.Clone(t.pinnedRows)
+}
+
+// isPinnedRow reports whether the row with the given ID is pinned
+func (t *Table) isPinnedRow(id string) bool {
+	return slices.Contains(t.pinnedRows, id)
+}
+
+// handleRowPin pins or unpins a row. A newly pinned row not loaded yet is
+// looked up in the data source, and a cursor left on it moves to the next
+// row.
+func (t *Table) handleRowPin(id string, pinned bool) tea.Cmd {
+	if !pinned {
+		t.pinnedRows = slices.DeleteFunc(t.pinnedRows, func(pinnedID string) bool { return pinnedID == id })
+		delete(t.pinnedItems, id)
+		return nil
+	}
+	if id == "" || t.isPinnedRow(id) {
+		return nil
+	}
+
+	t.pinnedRows = append(t.pinnedRows, id)
+	index := t.findItemIndex(id)
+	if index < 0 {
+		t.pinnedScanKey = ""
+		return t.locatePinnedRows()
+	}
+	item, _ := t.getItemAtIndex(index)
+	t.pinnedItems[id] = item
+
+	if index != t.viewport.CursorIndex {
+		return nil
+	}
+	target, ok := t.nextNavigableIndex(1)
+	if !ok {
+		target, ok = t.nextNavigableIndex(-1)
+	}
+	if !ok {
+		return nil
+	}
+	return t.handleJumpTo(target)
+}
+
+// locatePinnedRows returns a command scanning the data source, with the
+// table's sort and filters, for the pinned rows. Rows the scan does not find
+// are hidden until a later scan finds them again. The data is only scanned
+// again once the filters or the total change, loaded chunks keeping the
+// pinned rows up to date in between.
+func (t *Table) locatePinnedRows() tea.Cmd {
+	if len(t.pinnedRows) == 0 {
+		return nil
+	}
+	key := fmt.Sprint(core.PersistFilters(t.filters), t.fuzzyFields, t.foldDiacritics, t.totalItems)
+	if key == t.pinnedScanKey {
+		return nil
+	}
+	t.pinnedScanKey = key
+
+	ids := slices.Clone(t.pinnedRows)
+	export := t.newCSVExport(false)
+	return func() tea.Msg {
+		found := make(map[string]core.Data[any])
+		_ = export.eachRow(func(row core.TableRow, index int, selected bool) error {
+			if !slices.Contains(ids, row.ID) {
+				return nil
+			}
+			found[row.ID] = core.Data[any]{ID: row.ID, Item: row, Selected: selected}
+			if len(found) == len(ids) {
+				return errRowFound
+			}
+			return nil
+		})
+		return pinnedRowsMsg{items: found}
+	}
+}
+
+// trackPinnedItems keeps the pinned rows up to date with a loaded chunk
+func (t *Table) trackPinnedItems(items []core.Data[any]) {
+	if len(t.pinnedRows) == 0 {
+		return
+	}
+	for _, item := range items {
+		if t.isPinnedRow(item.ID) {
+			t.pinnedItems[item.ID] = item
+		}
+	}
+}
+
+// renderPinnedRows renders the pinned rows still in the data followed by a
+// separator, or "" when there are none
+func (t *Table) renderPinnedRows() string {
+	var rows []string
+	for _, id := range t.pinnedRows {
+		if item, ok := t.pinnedItems[id]; ok {
+			rows = append(rows, t.renderRow(item, -1, false))
+		}
+	}
+	if len(rows) == 0 {
+		return ""
+	}
+	return strings.Join(rows, "\n") + "\n" + t.constructHeaderSeparator()
+}
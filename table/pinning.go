@@ -0,0 +1,101 @@
+package table
+
+import (
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/davidroman0O/vtable/core"
+)
+
+// Heavy vertical box characters marking the edge of the pinned columns
+const (
+	pinnedVertical = "┃"
+	pinnedTopT     = "┰"
+	pinnedCross    = "╂"
+	pinnedBottomT  = "┸"
+)
+
+// handleColumnPin pins or unpins a configured column and refits the displayed
+// columns. A newly pinned column drops its horizontal scroll offset.
+func (t *Table) handleColumnPin(index int, pinned bool) tea.Cmd {
+	if index < 0 || index >= len(t.config.Columns) || t.config.Columns[index].Pinned == pinned {
+		return nil
+	}
+
+	columns := make([]core.TableColumn, len(t.config.Columns))
+	copy(columns, t.config.Columns)
+	columns[index].Pinned = pinned
+	t.config.Columns = columns
+	t.applyOverflowStrategy()
+
+	if pinned {
+		delete(t.horizontalScrollOffsets, index)
+	}
+	return nil
+}
+
+// isColumnPinned reports whether a displayed column is pinned
+func (t *Table) isColumnPinned(index int) bool {
+	return index >= 0 && index < len(t.columns) && t.columns[index].Pinned
+}
+
+// isPinnedEdge reports whether the separator after a displayed column closes
+// the pinned block, that is the column is pinned and the next visible one is not
+func (t *Table) isPinnedEdge(index int) bool {
+	if !t.isColumnPinned(index) {
+		return false
+	}
+	for next := index + 1; next < len(t.columns); next++ {
+		if !t.hiddenColumns[next] {
+			return !t.columns[next].Pinned
+		}
+	}
+	return false
+}
+
+// pinnedBorderChar returns the separator drawn after the pinned block
+func (t *Table) pinnedBorderChar() string {
+	if t.config.ShowBorders {
+		return pinnedVertical
+	}
+	return t.config.Theme.BorderChars.Vertical
+}
+
+// joinCells joins the rendered cells of a line, where cells[lead:] follow the
+// visible columns in order. The separator after the pinned block is replaced
+// by pinnedSeparator.
+func (t *Table) joinCells(cells []string, lead int, separator, pinnedSeparator string) string {
+	var builder strings.Builder
+	column := -1
+	for i, cell := range cells {
+		if i > 0 {
+			if column >= 0 && t.isPinnedEdge(column) {
+				builder.WriteString(pinnedSeparator)
+			} else {
+				builder.WriteString(separator)
+			}
+		}
+		builder.WriteString(cell)
+
+		if i >= lead {
+			column = t.nextVisibleColumn(column)
+		}
+	}
+	return builder.String()
+}
+
+// nextVisibleColumn returns the first visible displayed column after index
+func (t *Table) nextVisibleColumn(index int) int {
+	for next := index + 1; next < len(t.columns); next++ {
+		if !t.hiddenColumns[next] {
+			return next
+		}
+	}
+	return len(t.columns)
+}
+
+// PinColumn pins or unpins a column
+func (t *Table) PinColumn(index int, pinned bool) tea.Cmd {
+	return core.ColumnPinCmd(index, pinned)
+}
@@ -0,0 +1,103 @@
+package table
+
+import (
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/davidroman0O/vtable/core"
+)
+
+// rangeSelection is a range selection in progress: the items from anchor to
+// cursor are selected. Both are indices in the sorted and filtered data, so
+// the range survives scrolling and chunk loads.
+type rangeSelection struct {
+	anchor int
+	cursor int
+}
+
+// bounds returns the first and last index of the range
+func (r rangeSelection) bounds() (int, int) {
+	return min(r.anchor, r.cursor), max(r.anchor, r.cursor)
+}
+
+// SetSelectionAnchor starts a range selection at the row under the cursor.
+// Moving the cursor then selects every row between the anchor and the cursor,
+// deselecting the rows the range shrinks away from, until the range is
+// committed with CommitRangeSelection or dropped with ClearRangeSelection.
+// It requires SelectionMultiple and emits RangeSelectionUpdatedMsg whenever
+// the range changes.
+func (t *Table) SetSelectionAnchor() tea.Cmd {
+	return core.SelectionAnchorSetCmd()
+}
+
+// CommitRangeSelection ends the range selection, keeping its rows selected
+func (t *Table) CommitRangeSelection() tea.Cmd {
+	return core.SelectionAnchorCommitCmd()
+}
+
+// ClearRangeSelection ends the range selection and deselects its rows
+func (t *Table) ClearRangeSelection() tea.Cmd {
+	return core.SelectionAnchorClearCmd()
+}
+
+// handleSelectionAnchorSet anchors a range selection at the cursor and
+// selects the cursor row
+func (t *Table) handleSelectionAnchorSet() tea.Cmd {
+	if t.config.SelectionMode != core.SelectionMultiple || t.dataSource == nil || t.totalItems == 0 {
+		return nil
+	}
+
+	cursor := t.viewport.CursorIndex
+	t.rangeSelection = &rangeSelection{anchor: cursor, cursor: cursor}
+	return tea.Batch(
+		t.dataSource.SelectRange(cursor, cursor),
+		core.RangeSelectionUpdatedCmd(cursor, cursor, 1),
+	)
+}
+
+// handleSelectionAnchorEnd ends the range selection, deselecting its rows
+// unless it is committed
+func (t *Table) handleSelectionAnchorEnd(commit bool) tea.Cmd {
+	selection := t.rangeSelection
+	t.rangeSelection = nil
+	if selection == nil || commit || t.dataSource == nil {
+		return nil
+	}
+
+	first, last := selection.bounds()
+	var cmds []tea.Cmd
+	for i := first; i <= last && i < t.totalItems; i++ {
+		cmds = append(cmds, t.dataSource.SetSelected(i, false))
+	}
+	return tea.Batch(cmds...)
+}
+
+// extendRangeSelection stretches the range selection to the cursor after it
+// moved: rows the range no longer covers are deselected and the new range is
+// selected
+func (t *Table) extendRangeSelection() tea.Cmd {
+	selection := t.rangeSelection
+	if selection == nil || t.dataSource == nil || t.viewport.CursorIndex == selection.cursor {
+		return nil
+	}
+	// The data shrank from under the anchor
+	if selection.anchor >= t.totalItems {
+		t.rangeSelection = nil
+		return nil
+	}
+
+	oldFirst, oldLast := selection.bounds()
+	selection.cursor = t.viewport.CursorIndex
+	first, last := selection.bounds()
+
+	var cmds []tea.Cmd
+	for i := oldFirst; i <= oldLast && i < t.totalItems; i++ {
+		if i < first || i > last {
+			cmds = append(cmds, t.dataSource.SetSelected(i, false))
+		}
+	}
+	cmds = append(cmds,
+		t.dataSource.SelectRange(first, last),
+		core.RangeSelectionUpdatedCmd(selection.anchor, selection.cursor, last-first+1),
+	)
+	return tea.Batch(cmds...)
+}
@@ -0,0 +1,76 @@
+package table
+
+import (
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/davidroman0O/vtable/core"
+	"github.com/davidroman0O/vtable/viewport"
+)
+
+// refreshCursor is the cursor row before a refresh, which the cursor returns
+// to once the refreshed total is known
+type refreshCursor struct {
+	id    string
+	index int
+	// inPlace marks a refresh that kept the loaded chunks and the scroll
+	// position, see RefreshTotalPreservingPosition
+	inPlace bool
+}
+
+// rememberRefreshCursor records the cursor row before a DataRefreshMsg reloads
+// the data
+func (t *Table) rememberRefreshCursor() {
+	t.refreshCursor = nil
+	if t.totalItems == 0 {
+		return
+	}
+
+	cursor := &refreshCursor{index: t.viewport.CursorIndex}
+	if item, ok := t.getItemAtIndex(t.viewport.CursorIndex); ok && !isPlaceholderID(item.ID) {
+		cursor.id = item.ID
+	}
+	t.refreshCursor = cursor
+}
+
+// restoreRefreshCursor puts the cursor back at its index before the refresh,
+// clamped to the new total, and asks an IndexableDataSource where its row is
+// now
+func (t *Table) restoreRefreshCursor() tea.Cmd {
+	cursor := t.refreshCursor
+	if cursor == nil {
+		return nil
+	}
+
+	if t.totalItems > 0 {
+		index := min(cursor.index, t.totalItems-1)
+		t.viewport = viewport.CalculateJumpTo(index, t.config.ViewportConfig, t.totalItems)
+	}
+
+	source, ok := t.dataSource.(core.IndexableDataSource)
+	if !ok || cursor.id == "" {
+		t.refreshCursor = nil
+		return nil
+	}
+	return source.IndexOfID(cursor.id)
+}
+
+// handleIndexOfID moves the cursor onto its row found again after a refresh.
+// A row no longer in the data leaves the cursor at its clamped old index.
+func (t *Table) handleIndexOfID(msg core.IndexOfIDMsg) tea.Cmd {
+	cursor := t.refreshCursor
+	if cursor == nil || cursor.id != msg.ID {
+		return nil
+	}
+	t.refreshCursor = nil
+	if !msg.Found || msg.Index < 0 || msg.Index >= t.totalItems {
+		return nil
+	}
+	if cursor.inPlace {
+		if msg.Index == t.viewport.CursorIndex {
+			return nil
+		}
+		// The row moved, so the rows of the kept chunks moved as well
+		t.chunks = make(map[int]core.Chunk[any])
+	}
+	return t.handleJumpTo(msg.Index)
+}
@@ -0,0 +1,80 @@
+package table
+
+import (
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/davidroman0O/vtable/core"
+)
+
+// renderCacheKey identifies a rendered row by everything View varies between
+// rows. Anything else a row depends on clears the cache when it changes.
+type renderCacheKey struct {
+	id               string
+	index            int
+	width            int
+	cursor           bool
+	selected         bool
+	activeColumn     int // -1 off the cursor row
+	themeVersion     int
+	formatterVersion int
+}
+
+// InvalidateRenderCache drops every cached row, so the next View renders them
+// all again. The table clears the cache itself on every change it handles;
+// call this after changing something it cannot see, such as a formatter
+// whose output depends on outside state.
+func (t *Table) InvalidateRenderCache() {
+	t.renderCache = nil
+}
+
+// noteRenderChange clears the render cache before a message is handled,
+// unless the message only moves the cursor: the cursor and selection state
+// are part of the key, so the rows keep matching their cached strings.
+func (t *Table) noteRenderChange(msg tea.Msg) {
+	switch msg.(type) {
+	case core.CursorUpMsg, core.CursorDownMsg, core.PageUpMsg, core.PageDownMsg,
+		core.JumpToStartMsg, core.JumpToEndMsg, core.JumpToMsg:
+		return
+	case core.TableThemeSetMsg:
+		t.themeVersion++
+	case core.CellFormatterSetMsg, core.CellFormatterSetByFieldMsg, core.RowFormatterSetMsg,
+		core.LoadingFormatterSetMsg:
+		t.formatterVersion++
+	}
+	t.InvalidateRenderCache()
+}
+
+// renderCachedRow renders a visible row, reusing its cached string when the
+// render cache is enabled. Loading placeholders and rows being edited are
+// always rendered afresh.
+func (t *Table) renderCachedRow(item core.Data[any], absoluteIndex int, isCursor bool, width int) string {
+	if !t.config.RenderCacheEnabled || isPlaceholderID(item.ID) || (t.cellEditor != nil && isCursor) {
+		return t.renderRow(item, absoluteIndex, isCursor)
+	}
+
+	key := renderCacheKey{
+		id:               item.ID,
+		index:            absoluteIndex,
+		width:            width,
+		cursor:           isCursor,
+		selected:         item.Selected,
+		activeColumn:     -1,
+		themeVersion:     t.themeVersion,
+		formatterVersion: t.formatterVersion,
+	}
+	if isCursor {
+		key.activeColumn = t.currentColumn
+	}
+	if rendered, ok := t.renderCache[key]; ok {
+		return rendered
+	}
+
+	// Rows scrolled out of view are dropped once the cache outgrows a few
+	// viewports
+	if t.renderCache == nil || len(t.renderCache) > 4*max(t.config.ViewportConfig.Height, 1) {
+		t.renderCache = make(map[renderCacheKey]string)
+	}
+	rendered := t.renderRow(item, absoluteIndex, isCursor)
+	t.renderCache[key] = rendered
+	return rendered
+}
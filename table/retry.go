@@ -0,0 +1,69 @@
+package table
+
+import (
+	"slices"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/davidroman0O/vtable/core"
+)
+
+// errorRowGlyph marks data rows whose item has an error in the indicator column
+const errorRowGlyph = "✗"
+
+// handleRetryItem asks a RetryableDataSource to reload the item with the
+// given ID. It does nothing when the data source cannot retry items.
+func (t *Table) handleRetryItem(id string) tea.Cmd {
+	retryable, ok := t.idSource().(core.RetryableDataSource)
+	if !ok || id == "" {
+		return nil
+	}
+	return retryable.RetryItem(id)
+}
+
+// handleRetryAllErrored retries every loaded item that has an error, in index
+// order.
+func (t *Table) handleRetryAllErrored() tea.Cmd {
+	retryable, ok := t.idSource().(core.RetryableDataSource)
+	if !ok {
+		return nil
+	}
+
+	var errored []int
+	ids := make(map[int]string)
+	for _, chunk := range t.chunks {
+		for i, item := range chunk.Items {
+			if item.Error != nil {
+				errored = append(errored, chunk.StartIndex+i)
+				ids[chunk.StartIndex+i] = item.ID
+			}
+		}
+	}
+	slices.Sort(errored)
+
+	cmds := make([]tea.Cmd, 0, len(errored))
+	for _, index := range errored {
+		cmds = append(cmds, retryable.RetryItem(ids[index]))
+	}
+	return tea.Batch(cmds...)
+}
+
+// handleItemRetryResult replaces a successfully reloaded item in the loaded
+// chunks. Failed retries leave the item as it was.
+func (t *Table) handleItemRetryResult(msg core.ItemRetryResultMsg) {
+	if msg.Err != nil {
+		t.lastError = msg.Err
+		return
+	}
+
+	for start, chunk := range t.chunks {
+		for i, item := range chunk.Items {
+			if item.ID == msg.ID {
+				chunk.Items[i] = msg.Item
+				t.chunks[start] = chunk
+				t.updateVisibleItems()
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,84 @@
+package table
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/davidroman0O/vtable/core"
+)
+
+// GetHorizontalScrollRange returns the characters of the active cell shown by
+// its column: a 0-based start, an exclusive end and the length of the cell
+// text. The active cell is the cursor row's cell of the active column, or, in
+// the all rows scope, the longest cell of that column among the visible rows,
+// since they all share the same offset.
+func (t *Table) GetHorizontalScrollRange() (start, end, total int) {
+	if t.currentColumn < 0 || t.currentColumn >= len(t.columns) {
+		return 0, 0, 0
+	}
+
+	text := t.activeScrollText()
+	total = len([]rune(text))
+	if offset := t.horizontalScrollOffsets[t.currentColumn]; offset > 0 && !t.isColumnPinned(t.currentColumn) {
+		start = total - len([]rune(t.applySimpleANSIAwareScroll(text, offset)))
+	}
+	end = min(start+t.columns[t.currentColumn].Width, total)
+	return start, end, total
+}
+
+// activeScrollText returns the plain, space-collapsed text of the active cell
+func (t *Table) activeScrollText() string {
+	cell := t.cellIndex(t.currentColumn)
+	var text string
+	for i, item := range t.visibleItems {
+		isCursor := i == t.viewport.CursorViewportIndex
+		if !isCursor && !t.scrollAllRows {
+			continue
+		}
+		row, ok := item.Item.(core.TableRow)
+		if !ok || cell >= len(row.Cells) {
+			continue
+		}
+		if candidate := strings.Join(strings.Fields(stripANSI(row.Cells[cell])), " "); isCursor && !t.scrollAllRows {
+			return candidate
+		} else if len([]rune(candidate)) > len([]rune(text)) {
+			text = candidate
+		}
+	}
+	return text
+}
+
+// renderHorizontalScrollIndicator renders the "◀ chars 12–38 of 120 ▶" line
+// below the table, starting under the active column. The arrows show when
+// more of the cell lies in their direction.
+func (t *Table) renderHorizontalScrollIndicator() string {
+	var text string
+	if start, end, total := t.GetHorizontalScrollRange(); total > 0 {
+		left, right := "  ", "  "
+		if start > 0 {
+			left = "◀ "
+		}
+		if end < total {
+			right = " ▶"
+		}
+		text = fmt.Sprintf("%schars %d–%d of %d%s", left, min(start+1, end), end, total, right)
+	}
+
+	// Line the text up with the active column, as far as the frame allows
+	x := 4 + 1
+	if t.config.ShowBorders {
+		x++
+	}
+	for i := 0; i < t.currentColumn && i < len(t.columns); i++ {
+		if !t.hiddenColumns[i] {
+			x += t.columns[i].Width + 1
+		}
+	}
+	width := t.frameWidth()
+	x = max(0, min(x, width-lipgloss.Width(text)))
+
+	line := ansiTruncateWithRunewidth(strings.Repeat(" ", x)+text, width, "")
+	line += strings.Repeat(" ", max(0, width-lipgloss.Width(line)))
+	return t.config.Theme.StatusStyle.Render(line)
+}
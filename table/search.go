@@ -0,0 +1,197 @@
+package table
+
+import (
+	"context"
+	"strings"
+	"sync/atomic"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/davidroman0O/vtable/core"
+	"github.com/davidroman0O/vtable/data"
+)
+
+// searchIDs hands out identifiers for full-source searches
+var searchIDs atomic.Int64
+
+// fullSearch is the state of a full-source search. It is only touched by the
+// scan step commands, which run one at a time.
+type fullSearch struct {
+	id      int
+	ctx     context.Context
+	query   string
+	needle  string // Query folded with core.FoldFilterText
+	columns []int  // Indices of the cells to match, nil for all
+	request core.DataRequest
+	total   int
+
+	next    int
+	indices []int
+}
+
+// SearchAllAsync searches the whole data source, not just the loaded chunks,
+// for rows containing query (case-insensitive, see core.FoldFilterText) in the
+// given fields, or in any column when fields is empty. The scan loads one
+// chunk per step with the table's current sort and filters, emitting
+// core.SearchProgressMsg after each chunk and core.SearchCompleteMsg at the
+// end, whose indices also become the table's search results. Calling cancel stops the scan before the next chunk.
+func (t *Table) SearchAllAsync(query string, fields []string) (tea.Cmd, func()) {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	var columns []int
+	for _, field := range fields {
+		for i, col := range t.columns {
+			if col.Field == field {
+				columns = append(columns, t.cellIndex(i))
+			}
+		}
+	}
+
+	search := &fullSearch{
+		id:      int(searchIDs.Add(1)),
+		ctx:     ctx,
+		query:   query,
+		needle:  core.FoldFilterText(query, t.foldDiacritics),
+		columns: columns,
+		request: data.CreateDataRequest(0, 0, copyStrings(t.sortFields), copyStrings(t.sortDirs), copyFilters(t.filters)),
+		total:   t.totalItems,
+	}
+	search.request.FieldTypes = t.fieldTypes()
+	search.request.SortComparators = t.sortComparators()
+	search.request.FuzzyFields = copyStrings(t.fuzzyFields)
+	search.request.FilterFoldDiacritics = t.foldDiacritics
+	t.activeSearch = search
+
+	return t.searchStep(search), cancel
+}
+
+// searchStep returns a command scanning the next chunk of a full-source search
+func (t *Table) searchStep(search *fullSearch) tea.Cmd {
+	dataSource := t.dataSource
+	chunkSize := t.config.ViewportConfig.ChunkSize
+	if chunkSize <= 0 {
+		chunkSize = 100
+	}
+
+	return func() tea.Msg {
+		complete := func(err error) tea.Msg {
+			return core.SearchCompleteMsg{
+				SearchID:  search.id,
+				Query:     search.query,
+				Indices:   search.indices,
+				Cancelled: search.ctx.Err() != nil,
+				Error:     err,
+			}
+		}
+
+		if search.ctx.Err() != nil || dataSource == nil || search.next >= search.total {
+			return complete(nil)
+		}
+
+		request := search.request
+		request.Start = search.next
+		request.Count = data.CalculateActualChunkSize(search.next, chunkSize, search.total)
+
+		chunk, err := data.LoadChunkNow(dataSource, request)
+		if err != nil {
+			return complete(err)
+		}
+		for i, item := range chunk.Items {
+			if search.matches(item) {
+				search.indices = append(search.indices, chunk.StartIndex+i)
+			}
+		}
+		search.next += request.Count
+
+		// A short chunk is the end of a filtering source's rows
+		if search.next >= search.total || len(chunk.Items) < request.Count || search.ctx.Err() != nil {
+			return complete(nil)
+		}
+
+		return core.SearchProgressMsg{
+			SearchID: search.id,
+			Scanned:  search.next,
+			Total:    search.total,
+			Matches:  len(search.indices),
+		}
+	}
+}
+
+// matches reports whether an item contains the query in the searched columns
+func (s *fullSearch) matches(item core.Data[any]) bool {
+	row, ok := item.Item.(core.TableRow)
+	if !ok || row.Kind != core.TableRowData {
+		return false
+	}
+
+	if s.columns == nil {
+		for _, cell := range row.Cells {
+			if s.contains(cell) {
+				return true
+			}
+		}
+		return false
+	}
+
+	for _, colIdx := range s.columns {
+		if colIdx < len(row.Cells) && s.contains(row.Cells[colIdx]) {
+			return true
+		}
+	}
+	return false
+}
+
+// contains reports whether a cell contains the query, folded like it
+func (s *fullSearch) contains(cell string) bool {
+	return strings.Contains(core.FoldFilterText(cell, s.request.FilterFoldDiacritics), s.needle)
+}
+
+// handleSearchProgress schedules the next step of the active full-source search
+func (t *Table) handleSearchProgress(msg core.SearchProgressMsg) tea.Cmd {
+	if t.activeSearch == nil || t.activeSearch.id != msg.SearchID {
+		return nil
+	}
+	return t.searchStep(t.activeSearch)
+}
+
+// handleSearchComplete stores the results of the active full-source search.
+// The partial results of a cancelled search are discarded, leaving the
+// results of the last search that ran to its end.
+func (t *Table) handleSearchComplete(msg core.SearchCompleteMsg) tea.Cmd {
+	if t.activeSearch == nil || t.activeSearch.id != msg.SearchID {
+		return nil
+	}
+	t.activeSearch = nil
+	if msg.Cancelled {
+		return nil
+	}
+	t.searchResults = msg.Indices
+
+	if t.incSearch != nil && t.incSearch.searchID == msg.SearchID {
+		return t.handleIncrementalMatches(msg.Indices)
+	}
+	return nil
+}
+
+// GetSearchResults returns the indices found by the last search that was not
+// cancelled
+func (t *Table) GetSearchResults() []int {
+	return append([]int{}, t.searchResults...)
+}
+
+// copyStrings returns a copy of a string slice
+func copyStrings(values []string) []string {
+	if values == nil {
+		return nil
+	}
+	return append([]string{}, values...)
+}
+
+// copyFilters returns a shallow copy of a filter map
+func copyFilters(filters map[string]any) map[string]any {
+	result := make(map[string]any, len(filters))
+	for key, value := range filters {
+		result[key] = value
+	}
+	return result
+}
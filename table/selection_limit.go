@@ -0,0 +1,35 @@
+package table
+
+import (
+	"slices"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/davidroman0O/vtable/core"
+)
+
+// recordSelection tracks a row selected or deselected through the table and,
+// once more than MaxSelections rows are selected, returns a command
+// deselecting the least recently selected ones in the data source. Only
+// selections made through the table count; clearing the selection resets them.
+func (t *Table) recordSelection(id string, selected bool) tea.Cmd {
+	if t.config.MaxSelections <= 0 {
+		return nil
+	}
+
+	t.selectionHistory = slices.DeleteFunc(t.selectionHistory, func(recorded string) bool {
+		return recorded == id
+	})
+	if !selected {
+		return nil
+	}
+	t.selectionHistory = append(t.selectionHistory, id)
+
+	var cmds []tea.Cmd
+	for len(t.selectionHistory) > t.config.MaxSelections {
+		evicted := t.selectionHistory[0]
+		t.selectionHistory = t.selectionHistory[1:]
+		cmds = append(cmds, t.dataSource.SetSelectedByID(evicted, false), core.SelectionEvictedCmd(evicted))
+	}
+	return tea.Batch(cmds...)
+}
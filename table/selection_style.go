@@ -0,0 +1,17 @@
+package table
+
+import (
+	"strings"
+
+	"github.com/davidroman0O/vtable/core"
+)
+
+// styleSelectedCell applies the selection style to a constrained cell, merged
+// into the formatter's styling unless SelectionOverridesFormatter is set
+func (t *Table) styleSelectedCell(content string) string {
+	style := t.config.Theme.SelectedStyle
+	if t.config.SelectionOverridesFormatter || !strings.Contains(content, "\x1b[") {
+		return style.Render(stripANSI(content))
+	}
+	return core.MergeStyle(content, style)
+}
@@ -0,0 +1,64 @@
+package table
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/mattn/go-runewidth"
+
+	"github.com/davidroman0O/vtable/core"
+)
+
+// sortIndicator returns the header decoration of a sorted field: an arrow for
+// its direction followed, when several fields are sorted, by its priority.
+// Unsorted fields return an empty string.
+func (t *Table) sortIndicator(field string) string {
+	if field == "" {
+		return ""
+	}
+	for i, sortField := range t.sortFields {
+		if sortField != field {
+			continue
+		}
+		indicator := "↑"
+		if i < len(t.sortDirs) && t.sortDirs[i] == "desc" {
+			indicator = "↓"
+		}
+		if len(t.sortFields) > 1 {
+			indicator += strconv.Itoa(i + 1)
+		}
+		return indicator
+	}
+	return ""
+}
+
+// renderHeaderText fits a header cell's text to its constraint and styles it.
+// With ShowSortIndicators, the sort indicator of a sorted field takes the end
+// of the cell and the text is fitted, and truncated if needed, to the rest.
+func (t *Table) renderHeaderText(text string, constraint core.CellConstraint, field string) string {
+	headerStyle := t.config.Theme.HeaderStyle
+
+	indicator := ""
+	if t.config.ShowSortIndicators {
+		indicator = t.sortIndicator(field)
+	}
+	if indicator == "" {
+		// Use -1 to skip horizontal scrolling for headers
+		return headerStyle.Render(t.applyCellConstraints(text, constraint, -1))
+	}
+
+	indicatorStyle := t.config.Theme.SortIndicatorStyle.Inherit(headerStyle)
+	indicatorWidth := runewidth.StringWidth(indicator)
+
+	// Too narrow for any text: the indicator alone, cut to the width
+	if constraint.Width <= indicatorWidth+1 {
+		indicator = runewidth.Truncate(indicator, max(0, constraint.Width), "")
+		padding := strings.Repeat(" ", max(0, constraint.Width-lipgloss.Width(indicator)))
+		return indicatorStyle.Render(indicator) + headerStyle.Render(padding)
+	}
+
+	constraint.Width -= indicatorWidth + 1
+	content := t.applyCellConstraints(text, constraint, -1)
+	return headerStyle.Render(content+" ") + indicatorStyle.Render(indicator)
+}
@@ -2,12 +2,16 @@ package table
 
 import (
 	"fmt"
+	"slices"
+	"sort"
+	"strconv"
 	"strings"
 	"time"
 
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
 	"github.com/mattn/go-runewidth"
+	"github.com/rivo/uniseg"
 
 	"github.com/davidroman0O/vtable/config"
 	"github.com/davidroman0O/vtable/core"
@@ -42,17 +46,21 @@ type Table struct {
 	config core.TableConfig
 
 	// Table-specific configuration
-	columns              []core.TableColumn
-	cellFormatters       map[int]core.SimpleCellFormatter // Column index -> simplified formatter
-	rowFormatter         core.RowFormatter
-	headerFormatter      core.HeaderFormatter
-	headerCellFormatters map[int]core.SimpleHeaderFormatter // Column index -> header formatter
-	loadingFormatter     core.LoadingRowFormatter
-	renderContext        core.RenderContext
+	columns               []core.TableColumn
+	cellFormatters        map[int]core.SimpleCellFormatter // Column index -> simplified formatter
+	rowFormatter          core.RowFormatter
+	headerFormatter       core.HeaderFormatter
+	headerCellFormatters  map[int]core.SimpleHeaderFormatter    // Column index -> header formatter
+	fieldCellFormatters   map[string]core.SimpleCellFormatter   // Column field -> formatter, before cellFormatters
+	fieldHeaderFormatters map[string]core.SimpleHeaderFormatter // Column field -> header formatter, before headerCellFormatters
+	loadingFormatter      core.LoadingRowFormatter
+	renderContext         core.RenderContext
 
 	// Selection state
 	selectedItems map[string]bool
 	selectedOrder []string
+	// IDs selected through the table, least recent first, for MaxSelections
+	selectionHistory []string
 
 	// Focus state
 	focused bool
@@ -60,26 +68,139 @@ type Table struct {
 	lastError error
 
 	// Filtering and sorting
-	filters     map[string]any
-	sortFields  []string
-	sortDirs    []string
-	searchQuery string
-	searchField string
+	filters        map[string]any
+	fuzzyFields    []string // Fields whose filters match fuzzily
+	foldDiacritics bool     // String filters and searches ignore accents
+	sortFields     []string
+	sortDirs       []string
+	searchQuery    string
+	searchField    string
+
+	// Filter changes waiting for the debounce period, and the sequence number
+	// of the latest change so stale ticks are ignored
+	filterDebounce time.Duration
+	pendingFilters map[string]any
+	filterSeq      int
 
 	// Search results
 	searchResults []int
+	activeSearch  *fullSearch        // Full-source search in progress, if any
+	activeMatch   *matchSearch       // Predicate scan in progress, if any
+	incSearch     *incrementalSearch // Search-as-you-type session, if any
+
+	// Rendered layout for mapping mouse clicks to rows
+	bodyTop   int   // Lines above the first row
+	lineRows  []int // Absolute row index of each rendered row line
+	lastClick mouseClick
 
 	// visibleItems is the slice of Data items currently visible in the viewport
 	visibleItems []core.Data[any]
 
 	// Chunk access tracking for LRU management
 	chunkAccessTime map[int]time.Time
+	chunkStats      core.ChunkStats
+	// ViewportStartIndex when chunk hits and misses were last counted, -1
+	// before the first count
+	hitsViewportStart int
+	// Direction the viewport last moved (-1 up, 1 down, 0 not yet) and its
+	// start then, for PrefetchChunks
+	travelDirection   int
+	lastViewportStart int
 
 	// Loading state tracking
 	loadingChunks    map[int]bool
 	hasLoadingChunks bool
+	loadingTicking   bool // A LoadingTickMsg is scheduled
 	canScroll        bool
 
+	// Load start times for chunk lifecycle logging
+	chunkLoadStarted map[int]time.Time
+
+	// Coalesces total updates and refreshes under MinRefreshInterval
+	refreshThrottle data.RefreshThrottle
+
+	// Cursor position to restore after a request applied with SetRequest reloads (-1 = none)
+	pendingRequestStart int
+	// ID of the row the cursor moves to once a restored state reloads ("" = none)
+	pendingCursorID string
+	// Cursor row a DataRefreshMsg moves back onto once the new total is known
+	refreshCursor *refreshCursor
+
+	// Column aggregates shown in the footer, in data source order
+	aggregates []string
+
+	// Row change transition started by a refresh with AnimateChanges
+	rowTransition *rowTransition
+
+	// Auto-sizing of the columns waits for loaded rows
+	pendingAutoSize bool
+
+	// IDs of the rows pinned above the scrolling rows, in pinning order,
+	// their last known data, and the filters and total they were located with
+	pinnedRows    []string
+	pinnedItems   map[string]core.Data[any]
+	pinnedScanKey string
+
+	// Range selection in progress, nil when there is none
+	rangeSelection *rangeSelection
+
+	// Digits typed before a JumpToPercent key
+	countPrefix string
+
+	// Columns whose formatter panicked, and the panics not reported yet
+	panickedColumns map[int]bool
+	pendingPanics   []core.FormatterPanicMsg
+
+	// Rendered rows reused between View calls, and the versions of the theme
+	// and formatters they were rendered with
+	renderCache      map[renderCacheKey]string
+	themeVersion     int
+	formatterVersion int
+
+	// Managed status line rendered below the table (empty = hidden)
+	statusLine string
+
+	// Clipboard selected rows are copied to (nil = core.SystemClipboard),
+	// whether copies fall back to OSC 52 when it fails, and the OSC 52
+	// sequence of fallback copy clipboardSeq, rendered until cleared
+	clipboard         core.ClipboardWriter
+	osc52Fallback     bool
+	clipboardSequence string
+	clipboardSeq      int
+
+	// Inline cell editor, nil when no cell is being edited
+	cellEditor *cellEditor
+
+	// Color profile themes are degraded to
+	colorProfile core.ColorProfile
+
+	// Marked row IDs by mark name
+	marks map[rune]string
+
+	// Selection count last reported to OnSelectionChange, and the chunk reloads
+	// to wait for before counting again
+	reportedSelection int
+	selectionReloads  int
+
+	// Overflow layout state: available width (0 = unknown) and dropped columns
+	availableWidth int
+	hiddenColumns  map[int]bool
+
+	// Index into TableRow.Cells of each column once columns are moved (nil = same index)
+	columnCells []int
+
+	// Inline column filter input and the raw text of applied column filters
+	filterEditor *columnFilterEditor
+	filterInputs map[string]string
+
+	// Interactive column resize mode
+	resizeMode bool
+
+	// Grouping state pushed to a GroupingDataSource
+	collapsedGroups map[string]bool
+	// groupSource wraps dataSource while the table groups rows itself
+	groupSource *fieldGroupingSource
+
 	// Component-based rendering system
 	componentRenderer *TableComponentRenderer // Optional component-based renderer
 
@@ -88,6 +209,7 @@ type Table struct {
 	horizontalScrollMode    string      // "character", "word", "smart"
 	scrollAllRows           bool        // true = scroll all rows together, false = only current row
 	currentColumn           int         // Currently focused column for scrolling
+	revealedColumn          int         // Column grid navigation keeps displayed when columns are dropped to fit, -1 for none
 	previousCursorIndex     int         // Track previous cursor position for scroll reset
 }
 
@@ -143,7 +265,7 @@ func (tl *TableLayout) RenderCell(content string, columnIndex int, style lipglos
 	width := tl.columnWidths[columnIndex]
 
 	// Apply width and alignment constraints
-	constrainedContent := tl.applyColumnConstraints(content, width, col.Alignment)
+	constrainedContent := tl.applyColumnConstraints(content, width, core.ColumnAlignment(col))
 
 	// Apply the style to the constrained content
 	return style.Render(constrainedContent)
@@ -204,27 +326,47 @@ func NewTable(tableConfig core.TableConfig, dataSource core.DataSource[any]) *Ta
 		tableConfig.ActiveCellBackgroundColor = "226" // Default bright yellow background
 	}
 
+	// Degrade the theme once to the colors the terminal can display
+	colorProfile := tableConfig.ForceColorProfile
+	if colorProfile == core.ColorProfileAuto {
+		colorProfile = core.DetectColorProfile()
+	}
+	tableConfig.Theme = tableConfig.Theme.Degrade(colorProfile)
+	tableConfig.ActiveCellBackgroundColor = core.DegradeColorString(tableConfig.ActiveCellBackgroundColor, colorProfile)
+
 	table := &Table{
-		dataSource:           dataSource,
-		chunks:               make(map[int]core.Chunk[any]),
-		config:               tableConfig,
-		columns:              tableConfig.Columns,
-		cellFormatters:       make(map[int]core.SimpleCellFormatter),
-		headerCellFormatters: make(map[int]core.SimpleHeaderFormatter),
-		selectedItems:        make(map[string]bool),
-		selectedOrder:        make([]string, 0),
-		filters:              make(map[string]any),
-		chunkAccessTime:      make(map[int]time.Time),
-		visibleItems:         make([]core.Data[any], 0),
-		loadingChunks:        make(map[int]bool),
-		hasLoadingChunks:     false,
-		canScroll:            true,
-		componentRenderer:    NewTableComponentRenderer(DefaultComponentTableRenderConfig()), // Always enabled
+		dataSource:            dataSource,
+		chunks:                make(map[int]core.Chunk[any]),
+		config:                tableConfig,
+		colorProfile:          colorProfile,
+		columns:               tableConfig.Columns,
+		cellFormatters:        make(map[int]core.SimpleCellFormatter),
+		headerCellFormatters:  make(map[int]core.SimpleHeaderFormatter),
+		fieldCellFormatters:   make(map[string]core.SimpleCellFormatter),
+		fieldHeaderFormatters: make(map[string]core.SimpleHeaderFormatter),
+		selectedItems:         make(map[string]bool),
+		selectedOrder:         make([]string, 0),
+		filters:               make(map[string]any),
+		chunkAccessTime:       make(map[int]time.Time),
+		hitsViewportStart:     -1,
+		visibleItems:          make([]core.Data[any], 0),
+		loadingChunks:         make(map[int]bool),
+		chunkLoadStarted:      make(map[int]time.Time),
+		collapsedGroups:       make(map[string]bool),
+		filterInputs:          make(map[string]string),
+		hiddenColumns:         make(map[int]bool),
+		pendingRequestStart:   -1,
+		pendingAutoSize:       tableConfig.AutoSizeColumns,
+		pinnedItems:           make(map[string]core.Data[any]),
+		hasLoadingChunks:      false,
+		canScroll:             true,
+		componentRenderer:     NewTableComponentRenderer(DefaultComponentTableRenderConfig()), // Always enabled
 		// Initialize horizontal scrolling state
 		horizontalScrollOffsets: make(map[int]int),
 		horizontalScrollMode:    "character",                             // Default to character-by-character
 		scrollAllRows:           false,                                   // Default to scroll all rows together
 		currentColumn:           0,                                       // Start with first column
+		revealedColumn:          -1,                                      // No column revealed by grid navigation
 		previousCursorIndex:     tableConfig.ViewportConfig.InitialIndex, // Track for scroll reset
 		viewport: core.ViewportState{
 			ViewportStartIndex:  0,
@@ -240,6 +382,9 @@ func NewTable(tableConfig core.TableConfig, dataSource core.DataSource[any]) *Ta
 	// Set up render context
 	table.setupRenderContext()
 
+	// Hand the initial grouping to the data source
+	table.applyGrouping()
+
 	return table
 }
 
@@ -250,6 +395,30 @@ func (t *Table) Init() tea.Cmd {
 
 // Update handles all messages and updates the table state
 func (t *Table) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	previousCursor := t.viewport.CursorIndex
+	t.noteRenderChange(msg)
+	model, cmd := t.update(msg)
+	// Rows spanning several lines may have changed height with the message
+	if t.fitViewportToLines() {
+		t.updateVisibleItems()
+		cmd = tea.Batch(cmd, t.smartChunkManagement())
+	}
+	if followCmd := t.selectOnCursorMove(previousCursor); followCmd != nil {
+		cmd = tea.Batch(cmd, followCmd)
+	}
+	if rangeCmd := t.extendRangeSelection(); rangeCmd != nil {
+		cmd = tea.Batch(cmd, rangeCmd)
+	}
+	if panicCmd := t.reportFormatterPanics(); panicCmd != nil {
+		cmd = tea.Batch(cmd, panicCmd)
+	}
+	t.notifyChanges(msg, previousCursor)
+	return model, cmd
+}
+
+// update dispatches a message to its handler. Update wraps it to apply the
+// cursor-follows-selection and range selection behaviors once per message.
+func (t *Table) update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	var cmds []tea.Cmd
 
 	switch msg := msg.(type) {
@@ -309,6 +478,24 @@ func (t *Table) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		cmd := t.handleJumpTo(msg.Index)
 		return t, cmd
 
+	case core.JumpToPercentMsg:
+		if t.totalItems == 0 {
+			return t, nil
+		}
+		return t, core.JumpToCmd(viewport.PercentIndex(msg.Percent, t.totalItems))
+
+	case core.SetMarkMsg:
+		t.handleSetMark(msg.Name)
+		return t, nil
+
+	case core.JumpToMarkMsg:
+		cmd := t.handleJumpToMark(msg.Name)
+		return t, cmd
+
+	case markLocatedMsg:
+		cmd := t.handleMarkLocated(msg)
+		return t, cmd
+
 	// === HORIZONTAL SCROLLING MESSAGES ===
 	case core.HorizontalScrollLeftMsg:
 		cmd := t.handleHorizontalScrollLeft()
@@ -365,9 +552,18 @@ func (t *Table) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 
 	// ===== Data Messages - Reuse List logic =====
 	case core.DataRefreshMsg:
+		if deferred, delay := t.refreshThrottle.DeferRefresh(t.config.ViewportConfig.MinRefreshInterval, time.Now()); deferred {
+			return t, t.scheduleRefreshFlush(delay)
+		}
+		t.refreshThrottle.Applied(time.Now())
+		t.rememberRefreshCursor()
 		cmd := t.handleDataRefresh()
 		return t, cmd
 
+	case core.RefreshFlushMsg:
+		cmd := t.handleRefreshFlush()
+		return t, cmd
+
 	case core.DataChunksRefreshMsg:
 		t.chunks = make(map[int]core.Chunk[any])
 		t.loadingChunks = make(map[int]bool)
@@ -379,8 +575,31 @@ func (t *Table) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		cmd := t.handleDataChunkLoaded(msg)
 		return t, cmd
 
+	case core.LoadingTickMsg:
+		cmd := t.handleLoadingTick()
+		return t, cmd
+
+	case core.AggregatesComputedMsg:
+		if msg.Error != nil {
+			t.lastError = msg.Error
+			return t, nil
+		}
+		t.aggregates = msg.Cells
+		return t, nil
+
+	case core.RowTransitionTickMsg:
+		cmd := t.handleRowTransitionTick()
+		return t, cmd
+
 	case core.DataChunkErrorMsg:
 		t.lastError = msg.Error
+		t.logChunkEvent(core.ChunkEvent{
+			Type:       core.ChunkEventLoadFailed,
+			StartIndex: msg.StartIndex,
+			Size:       msg.Request.Count,
+			Request:    msg.Request,
+			Error:      msg.Error,
+		})
 		return t, core.ErrorCmd(msg.Error, "chunk_load")
 
 	case core.DataTotalMsg:
@@ -389,26 +608,46 @@ func (t *Table) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		t.viewport.ViewportStartIndex = 0
 		t.viewport.CursorIndex = t.config.ViewportConfig.InitialIndex
 		t.viewport.CursorViewportIndex = t.config.ViewportConfig.InitialIndex
-		return t, t.smartChunkManagement()
+		// Keep the cursor valid for empty and single-row datasets
+		t.viewport = viewport.ClampCursor(t.viewport, t.config.ViewportConfig, t.totalItems)
+		// Restore the position of a request applied with SetRequest
+		if t.pendingRequestStart >= 0 {
+			t.viewport = viewport.CalculateJumpTo(t.pendingRequestStart, t.config.ViewportConfig, t.totalItems)
+			t.pendingRequestStart = -1
+		}
+		locate := t.restoreRefreshCursor()
+		return t, tea.Batch(t.smartChunkManagement(), t.locateRestoredCursor(), locate, t.computeAggregates(), t.locatePinnedRows())
 
-	case core.DataTotalUpdateMsg:
-		oldTotal := t.totalItems
-		t.totalItems = msg.Total
-		t.updateViewportBounds()
+	case core.IndexOfIDMsg:
+		cmd := t.handleIndexOfID(msg)
+		return t, cmd
 
-		if t.viewport.CursorIndex >= t.totalItems && t.totalItems > 0 {
-			t.viewport.CursorIndex = t.totalItems - 1
-			t.viewport.CursorViewportIndex = t.viewport.CursorIndex - t.viewport.ViewportStartIndex
-			if t.viewport.CursorViewportIndex < 0 {
-				t.viewport.ViewportStartIndex = t.viewport.CursorIndex
-				t.viewport.CursorViewportIndex = 0
-			}
-		}
+	case totalRefreshedMsg:
+		cmd := t.handleTotalRefreshed(msg.total)
+		return t, cmd
 
-		if oldTotal != t.totalItems {
-			return t, t.smartChunkManagement()
+	case core.StateRestoreMsg:
+		cmd := t.handleStateRestore(msg.State)
+		return t, cmd
+
+	case restoredCursorMsg:
+		cmd := t.handleRestoredCursor(msg)
+		return t, cmd
+
+	case core.DataRequestSetMsg:
+		cmd := t.handleDataRequestSet(msg.Request)
+		return t, cmd
+
+	case core.DataTotalUpdateMsg:
+		// Shrinking past the cursor is applied immediately, bypassing the throttle
+		if msg.Total > t.viewport.CursorIndex {
+			if deferred, delay := t.refreshThrottle.DeferTotal(msg.Total, t.config.ViewportConfig.MinRefreshInterval, time.Now()); deferred {
+				return t, t.scheduleRefreshFlush(delay)
+			}
 		}
-		return t, nil
+		t.refreshThrottle.ApplyTotalNow(time.Now())
+		cmd := t.handleDataTotalUpdate(msg.Total)
+		return t, cmd
 
 	case core.DataLoadErrorMsg:
 		t.lastError = msg.Error
@@ -422,8 +661,22 @@ func (t *Table) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 
 	case core.DataSourceSetMsg:
 		t.dataSource = msg.DataSource
+		t.groupSource = nil
+		t.applyGrouping()
 		return t, t.dataSource.GetTotal()
 
+	case core.RetryItemMsg:
+		cmd := t.handleRetryItem(msg.ID)
+		return t, cmd
+
+	case core.RetryAllErroredMsg:
+		cmd := t.handleRetryAllErrored()
+		return t, cmd
+
+	case core.ItemRetryResultMsg:
+		t.handleItemRetryResult(msg)
+		return t, nil
+
 	case core.ChunkUnloadedMsg:
 		// Handle chunk unloaded notification (for UI feedback)
 		return t, nil
@@ -441,19 +694,44 @@ func (t *Table) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		cmd := t.handleSelectAll()
 		return t, cmd
 
+	case core.SelectAllToggleMsg:
+		cmd := t.handleSelectAllToggle()
+		return t, cmd
+
 	case core.SelectClearMsg:
 		if t.dataSource == nil {
 			return t, nil
 		}
+		t.selectionHistory = nil
 		return t, t.dataSource.ClearSelection()
 
 	case core.SelectRangeMsg:
 		cmd := t.handleSelectRange(msg.StartID, msg.EndID)
 		return t, cmd
 
+	case core.ActiveCellMoveMsg:
+		cmd := t.handleActiveCellMove(msg.DRow, msg.DCol)
+		return t, cmd
+
+	case core.SelectionAnchorSetMsg:
+		cmd := t.handleSelectionAnchorSet()
+		return t, cmd
+
+	case core.SelectionAnchorCommitMsg:
+		cmd := t.handleSelectionAnchorEnd(true)
+		return t, cmd
+
+	case core.SelectionAnchorClearMsg:
+		cmd := t.handleSelectionAnchorEnd(false)
+		return t, cmd
+
 	case core.SelectionModeSetMsg:
 		t.config.SelectionMode = msg.Mode
+		if msg.Mode != core.SelectionMultiple {
+			t.rangeSelection = nil
+		}
 		if msg.Mode == core.SelectionNone {
+			t.selectionHistory = nil
 			t.clearSelection()
 		}
 		return t, nil
@@ -466,6 +744,12 @@ func (t *Table) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	case core.ColumnSetMsg:
 		t.columns = msg.Columns
 		t.config.Columns = msg.Columns
+		t.applyOverflowStrategy()
+		// Loaded rows were arranged for moved columns; the new columns index cells directly
+		if t.columnCells != nil {
+			t.columnCells = nil
+			return t, t.refreshChunks()
+		}
 		return t, nil
 
 	case core.ColumnUpdateMsg:
@@ -473,8 +757,29 @@ func (t *Table) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			t.columns[msg.Index] = msg.Column
 			t.config.Columns[msg.Index] = msg.Column
 		}
+		t.applyOverflowStrategy()
+		return t, nil
+
+	case tea.WindowSizeMsg:
+		t.availableWidth = msg.Width
+		t.applyOverflowStrategy()
+		cmd := t.applyAutoHeight(msg.Height)
+		return t, cmd
+
+	case core.OverflowStrategySetMsg:
+		t.config.OverflowStrategy = msg.Strategy
+		t.applyOverflowStrategy()
+		return t, nil
+
+	case core.ResponsiveCardSetMsg:
+		t.config.ResponsiveCard = msg.Enabled
+		t.applyOverflowStrategy()
 		return t, nil
 
+	case core.ColumnTypesDetectMsg:
+		cmd := t.handleDetectColumnTypes(msg.SampleRows)
+		return t, cmd
+
 	case core.HeaderVisibilityMsg:
 		t.config.ShowHeader = msg.Visible
 		return t, nil
@@ -517,6 +822,22 @@ func (t *Table) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		t.headerCellFormatters[msg.ColumnIndex] = msg.Formatter
 		return t, nil
 
+	case core.CellFormatterSetByFieldMsg:
+		if msg.Formatter == nil {
+			delete(t.fieldCellFormatters, msg.Field)
+		} else {
+			t.fieldCellFormatters[msg.Field] = msg.Formatter
+		}
+		return t, nil
+
+	case core.HeaderFormatterSetByFieldMsg:
+		if msg.Formatter == nil {
+			delete(t.fieldHeaderFormatters, msg.Field)
+		} else {
+			t.fieldHeaderFormatters[msg.Field] = msg.Formatter
+		}
+		return t, nil
+
 	case core.LoadingFormatterSetMsg:
 		t.loadingFormatter = msg.Formatter
 		return t, nil
@@ -530,7 +851,7 @@ func (t *Table) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		return t, nil
 
 	case core.TableThemeSetMsg:
-		t.config.Theme = msg.Theme
+		t.config.Theme = msg.Theme.Degrade(t.colorProfile)
 		return t, nil
 
 	case core.FullRowHighlightToggleMsg:
@@ -541,6 +862,18 @@ func (t *Table) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		t.config.FullRowHighlighting = msg.Enabled
 		return t, nil
 
+	case core.ZebraStripingEnableMsg:
+		t.config.ZebraStriping = msg.Enabled
+		return t, nil
+
+	case core.RowStyleFuncSetMsg:
+		t.config.RowStyleFunc = msg.Func
+		return t, nil
+
+	case core.FooterRowSetMsg:
+		t.config.FooterRow = msg.Func
+		return t, nil
+
 	case core.ActiveCellIndicationModeSetMsg:
 		t.config.ActiveCellIndicationEnabled = msg.Enabled
 		return t, nil
@@ -549,6 +882,32 @@ func (t *Table) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		t.config.ActiveCellBackgroundColor = msg.Color
 		return t, nil
 
+	case core.ActiveCellBorderStyleSetMsg:
+		t.config.ActiveCellBorderStyle = msg.Style
+		return t, nil
+
+	case core.StatusLineSetMsg:
+		t.statusLine = msg.Text
+		return t, nil
+
+	case core.GroupBySetMsg:
+		t.config.GroupBy = msg.Fields
+		t.collapsedGroups = make(map[string]bool)
+		return t, t.refreshGrouping()
+
+	case core.GroupByFieldsSetMsg:
+		t.config.GroupByFields = msg.Fields
+		t.collapsedGroups = make(map[string]bool)
+		return t, t.refreshGrouping()
+
+	case core.GroupToggleMsg:
+		if t.collapsedGroups[msg.Key] {
+			delete(t.collapsedGroups, msg.Key)
+		} else {
+			t.collapsedGroups[msg.Key] = true
+		}
+		return t, tea.Batch(t.refreshGrouping(), core.GroupToggledCmd(msg.Key, t.collapsedGroups[msg.Key]))
+
 	// ===== Configuration Messages =====
 	case core.ViewportConfigMsg:
 		t.config.ViewportConfig = msg.Config
@@ -561,17 +920,81 @@ func (t *Table) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 
 	// ===== Filter Messages - Reuse List logic =====
 	case core.FilterSetMsg:
-		t.filters[msg.Field] = msg.Value
-		cmd := t.handleFilterChange()
+		if regex, ok := msg.Value.(core.RegexFilter); ok {
+			// An invalid pattern is reported instead of reaching the data source
+			if err := core.ValidateFilter(regex); err != nil {
+				return t, core.DataLoadErrorCmd(err)
+			}
+		}
+		cmd := t.changeFilter(msg.Field, msg.Value, false)
 		return t, cmd
 
 	case core.FilterClearMsg:
-		delete(t.filters, msg.Field)
+		delete(t.filterInputs, msg.Field)
+		cmd := t.changeFilter(msg.Field, nil, true)
+		return t, cmd
+
+	case filterDebounceMsg:
+		cmd := t.handleFilterDebounce(msg)
+		return t, cmd
+
+	case core.FiltersFlushMsg:
+		cmd := t.flushPendingFilters()
+		return t, cmd
+
+	case core.ColumnFilterEditMsg:
+		cmd := t.handleColumnFilterEdit(msg.Column)
+		return t, cmd
+
+	case core.CellEditStartMsg:
+		cmd := t.handleCellEditStart()
+		return t, cmd
+
+	case core.ColumnResizeModeMsg:
+		t.resizeMode = msg.Enabled && len(t.columns) > 0
+		return t, nil
+
+	case core.ColumnResizeMsg:
+		cmd := t.resizeColumn(msg.Index, msg.Delta)
+		return t, cmd
+
+	case core.ColumnMoveMsg:
+		cmd := t.handleColumnMove(msg.Delta)
+		return t, cmd
+
+	case core.ColumnWidthSetMsg:
+		cmd := t.setColumnWidth(msg.Index, msg.Width)
+		return t, cmd
+
+	case core.ColumnsAutoSizeMsg:
+		cmd := t.handleAutoSizeColumns()
+		return t, cmd
+
+	case core.RowPinMsg:
+		cmd := t.handleRowPin(msg.ID, msg.Pinned)
+		return t, cmd
+
+	case pinnedRowsMsg:
+		t.pinnedItems = msg.items
+		return t, nil
+
+	case core.ColumnPinMsg:
+		cmd := t.handleColumnPin(msg.Index, msg.Pinned)
+		return t, cmd
+
+	case core.ColumnVisibilityMsg:
+		cmd := t.handleColumnVisibility(msg.Field, msg.Visible)
+		return t, cmd
+
+	case core.FuzzyFieldsSetMsg:
+		t.fuzzyFields = copyStrings(msg.Fields)
 		cmd := t.handleFilterChange()
 		return t, cmd
 
 	case core.FiltersClearAllMsg:
+		t.discardPendingFilters()
 		t.filters = make(map[string]any)
+		t.filterInputs = make(map[string]string)
 		cmd := t.handleFilterChange()
 		return t, cmd
 
@@ -592,6 +1015,10 @@ func (t *Table) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		cmd := t.handleSortRemove(msg.Field)
 		return t, cmd
 
+	case core.CycleSortMsg:
+		cmd := t.handleCycleSort(msg.Field)
+		return t, cmd
+
 	case core.SortsClearAllMsg:
 		t.sortFields = nil
 		t.sortDirs = nil
@@ -618,12 +1045,47 @@ func (t *Table) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		t.searchQuery = ""
 		t.searchField = ""
 		t.searchResults = nil
+		t.activeSearch = nil
 		return t, nil
 
 	case core.SearchResultMsg:
 		t.searchResults = msg.Results
 		return t, nil
 
+	case core.ExportCSVMsg:
+		cmd := t.handleExportCSV(msg)
+		return t, cmd
+
+	case core.CopySelectionMsg:
+		cmd := t.handleCopySelection(msg.Format)
+		return t, cmd
+
+	case osc52CopyMsg:
+		cmd := t.handleOSC52Copy(msg)
+		return t, cmd
+
+	case osc52SentMsg:
+		if msg.seq == t.clipboardSeq {
+			t.clipboardSequence = ""
+		}
+		return t, nil
+
+	case core.SearchProgressMsg:
+		cmd := t.handleSearchProgress(msg)
+		return t, cmd
+
+	case core.SearchCompleteMsg:
+		cmd := t.handleSearchComplete(msg)
+		return t, cmd
+
+	case core.MatchSearchProgressMsg:
+		cmd := t.handleMatchSearchProgress(msg)
+		return t, cmd
+
+	case core.MatchSearchCompleteMsg:
+		cmd := t.handleMatchSearchComplete(msg)
+		return t, cmd
+
 	// ===== Error Messages =====
 	case core.ErrorMsg:
 		t.lastError = msg.Error
@@ -639,7 +1101,7 @@ func (t *Table) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	case core.BatchMsg:
 		for _, subMsg := range msg.Messages {
 			var cmd tea.Cmd
-			_, cmd = t.Update(subMsg)
+			_, cmd = t.update(subMsg)
 			if cmd != nil {
 				cmds = append(cmds, cmd)
 			}
@@ -650,6 +1112,10 @@ func (t *Table) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	case tea.KeyMsg:
 		cmd := t.handleKeyPress(msg)
 		return t, cmd
+
+	case tea.MouseMsg:
+		cmd := t.handleMouse(msg)
+		return t, cmd
 	}
 
 	return t, nil
@@ -657,6 +1123,14 @@ func (t *Table) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 
 // View renders the table
 func (t *Table) View() string {
+	if t.clipboardSequence != "" {
+		return t.clipboardSequence + t.render()
+	}
+	return t.render()
+}
+
+// render draws the table
+func (t *Table) render() string {
 	var builder strings.Builder
 
 	// Special case for empty dataset
@@ -670,9 +1144,12 @@ func (t *Table) View() string {
 		builder.WriteString("\n")
 	}
 
-	// Render header if enabled
-	if t.config.ShowHeader {
+	// Render header if enabled, or the blank lines standing in for it
+	if t.config.ShowHeader || t.config.ReserveHeaderSpace {
 		header := t.renderHeader()
+		if !t.config.ShowHeader {
+			header = blankLines(header)
+		}
 		if header != "" {
 			builder.WriteString(header)
 			builder.WriteString("\n")
@@ -685,10 +1162,29 @@ func (t *Table) View() string {
 		}
 	}
 
+	// Pinned rows stay above the scrolling rows, taking their lines from the
+	// viewport height
+	height := t.config.ViewportConfig.Height
+	pinned := t.renderPinnedRows()
+	if pinned != "" {
+		builder.WriteString(pinned)
+		builder.WriteString("\n")
+		height = max(height-strings.Count(pinned, "\n")-1, 1)
+	}
+
 	// Ensure visible items are up to date
 	t.updateVisibleItems()
 
 	// Render each visible row
+	var rows []string
+	var indices []int
+	cursorRow := -1
+	width := t.rowWidth()
+	wrapped := t.hasMultiLineRows()
+	hintFrom, hintTo, hinted := 0, 0, false
+	if wrapped {
+		hintFrom, hintTo, hinted = t.hintedRowWindow(width)
+	}
 	for i, item := range t.visibleItems {
 		absoluteIndex := t.viewport.ViewportStartIndex + i
 
@@ -696,15 +1192,51 @@ func (t *Table) View() string {
 			break
 		}
 
+		// Pinned rows are already shown above
+		if t.isPinnedRow(item.ID) {
+			continue
+		}
+
+		// Rows the height hints leave out are not rendered at all
+		if hinted && (i < hintFrom || i >= hintTo) {
+			continue
+		}
+
 		isCursor := i == t.viewport.CursorViewportIndex
+		if isCursor {
+			cursorRow = len(rows)
+		}
+
+		rows = append(rows, t.renderScrollingRow(item, absoluteIndex, isCursor, width))
+		indices = append(indices, absoluteIndex)
+	}
 
-		renderedRow := t.renderRow(item, absoluteIndex, isCursor)
+	// Wrapped and card rows span several lines, and pinned rows take some of
+	// the height, so only the rows fitting the rest show
+	if wrapped || pinned != "" {
+		var skipped int
+		rows, skipped = fitRowsToHeight(rows, cursorRow, height)
+		indices = indices[skipped : skipped+len(rows)]
+	}
+	rows, indices = t.insertExitingRows(rows, indices)
+	t.recordRowLayout(strings.Count(builder.String(), "\n"), rows, indices)
+	body := strings.Join(rows, "\n")
 
-		builder.WriteString(renderedRow)
+	// The scrollbar runs along the rows, outside the right border
+	if t.config.ViewportConfig.ShowScrollbar {
+		offset, length := viewport.CalculateScrollbarThumb(t.viewport, t.config.ViewportConfig, t.totalItems, len(t.lineRows))
+		body = render.AppendScrollbar(body, offset, length, t.config.Theme.ScrollbarStyle, t.config.Theme.ScrollbarThumbStyle)
+	}
+	builder.WriteString(body)
 
-		if i < len(t.visibleItems)-1 && absoluteIndex < t.totalItems-1 {
+	// The footer row stays pinned below the rows
+	if footer := t.renderFooter(); footer != "" {
+		if t.config.ShowFooterSeparator {
 			builder.WriteString("\n")
+			builder.WriteString(t.constructHeaderSeparator())
 		}
+		builder.WriteString("\n")
+		builder.WriteString(footer)
 	}
 
 	// Add bottom border if enabled
@@ -713,18 +1245,92 @@ func (t *Table) View() string {
 		builder.WriteString(t.constructBottomBorder())
 	}
 
+	if t.config.ShowPageIndicator {
+		builder.WriteString("\n")
+		builder.WriteString(t.renderPageIndicator())
+	}
+
+	if t.config.ShowHorizontalScrollIndicator {
+		builder.WriteString("\n")
+		builder.WriteString(t.renderHorizontalScrollIndicator())
+	}
+
+	// Add managed status line if set
+	if t.statusLine != "" {
+		builder.WriteString("\n")
+		builder.WriteString(t.renderStatusLine())
+	}
+
+	// Add the incremental search prompt while it is open
+	if t.IsSearching() {
+		builder.WriteString("\n")
+		builder.WriteString(t.renderSearchPrompt())
+	}
+
 	return builder.String()
 }
 
+// frameWidth returns the rendered width of the table, that is a row plus the
+// scrollbar column when ShowScrollbar is set
+func (t *Table) frameWidth() int {
+	width := t.rowWidth()
+	if t.config.ViewportConfig.ShowScrollbar {
+		width++
+	}
+	return width
+}
+
+// rowWidth returns the rendered width of a table row, including the
+// indicator column, column separators and outer borders
+func (t *Table) rowWidth() int {
+	// Indicator column plus one separator per visible column
+	width := 4
+	for i, col := range t.columns {
+		if !t.hiddenColumns[i] {
+			width += col.Width + 1
+		}
+	}
+	if t.config.ShowBorders {
+		width += 2
+	}
+	return width
+}
+
+// renderStatusLine renders the status line fitted to the table frame width,
+// truncating or wrapping it depending on StatusLineWrap
+func (t *Table) renderStatusLine() string {
+	width := t.frameWidth()
+
+	var lines []string
+	if t.config.StatusLineWrap {
+		wrapped := lipgloss.NewStyle().Width(width).Render(t.statusLine)
+		lines = strings.Split(wrapped, "\n")
+	} else {
+		firstLine := strings.SplitN(t.statusLine, "\n", 2)[0]
+		lines = []string{render.TruncateText(firstLine, width)}
+	}
+
+	for i, line := range lines {
+		if pad := width - lipgloss.Width(line); pad > 0 {
+			line += strings.Repeat(" ", pad)
+		}
+		lines[i] = t.config.Theme.StatusStyle.Render(line)
+	}
+
+	return strings.Join(lines, "\n")
+}
+
 // Focus sets the table as focused
 func (t *Table) Focus() tea.Cmd {
 	t.focused = true
+	t.InvalidateRenderCache()
 	return nil
 }
 
 // Blur removes focus from the table
 func (t *Table) Blur() {
 	t.focused = false
+	t.InvalidateRenderCache()
 }
 
 // IsFocused returns whether the table has focus
@@ -737,6 +1343,23 @@ func (t *Table) GetState() core.ViewportState {
 	return t.viewport
 }
 
+// DebugSnapshot returns the viewport math, loaded chunks, active column and
+// horizontal scroll offsets of the table, to attach to bug reports about
+// scrolling and chunk loading
+func (t *Table) DebugSnapshot() core.DebugSnapshot {
+	snapshot := core.NewDebugSnapshot(t.viewport, t.config.ViewportConfig, t.totalItems, t.chunks, t.loadingChunks)
+	snapshot.ActiveColumn = t.currentColumn
+	for col, offset := range t.horizontalScrollOffsets {
+		if offset > 0 {
+			if snapshot.ScrollOffsets == nil {
+				snapshot.ScrollOffsets = make(map[int]int)
+			}
+			snapshot.ScrollOffsets[col] = offset
+		}
+	}
+	return snapshot
+}
+
 // GetTotalItems returns the total number of items
 func (t *Table) GetTotalItems() int {
 	return t.totalItems
@@ -781,6 +1404,21 @@ func (t *Table) GetSelectedIDs() []string {
 	return ids
 }
 
+// GetColumnTypes returns the data type of each column, in column order
+func (t *Table) GetColumnTypes() []core.ColumnType {
+	types := make([]core.ColumnType, len(t.columns))
+	for i, col := range t.columns {
+		types[i] = col.Type
+	}
+	return types
+}
+
+// DetectColumnTypes infers each column's type from up to sampleRows loaded rows.
+// Columns whose Type is already set, other than ColumnString, keep it.
+func (t *Table) DetectColumnTypes(sampleRows int) tea.Cmd {
+	return core.ColumnTypesDetectCmd(sampleRows)
+}
+
 // GetCurrentRow returns the currently selected row
 func (t *Table) GetCurrentRow() (core.TableRow, bool) {
 	item, exists := t.getItemAtIndex(t.viewport.CursorIndex)
@@ -888,6 +1526,8 @@ func (t *Table) reset() {
 	t.searchQuery = ""
 	t.searchField = ""
 	t.searchResults = nil
+	t.marks = nil
+	t.rangeSelection = nil
 }
 
 // handleScrollResetOnNavigation resets scroll offsets when navigating between rows if enabled
@@ -901,6 +1541,7 @@ func (t *Table) handleScrollResetOnNavigation() {
 	// The scrollAllRows scope only affects which cells get scrolled during rendering,
 	// not whether to reset on navigation
 	t.horizontalScrollOffsets = make(map[int]int)
+	t.InvalidateRenderCache()
 
 	// Update the previous cursor position
 	t.previousCursorIndex = t.viewport.CursorIndex
@@ -916,16 +1557,35 @@ func (t *Table) loadInitialData() tea.Cmd {
 
 // handleCursorUp moves cursor up one position
 func (t *Table) handleCursorUp() tea.Cmd {
-	if t.totalItems == 0 || !t.canScroll {
+	if !t.canScroll {
 		return nil
 	}
 
-	if t.viewport.CursorIndex <= 0 {
-		return nil
+	if t.totalItems == 0 || t.viewport.CursorIndex <= 0 {
+		if t.config.ViewportConfig.WrapNavigation && t.totalItems > 1 {
+			return tea.Batch(t.handleJumpToEnd(), core.NavigationWrappedCmd("up"))
+		}
+		return core.BoundaryReachedCmd(true)
+	}
+
+	steps := 1
+	if t.config.ViewportConfig.SkipDisabled || len(t.pinnedRows) > 0 {
+		target, ok := t.nextNavigableIndex(-1)
+		if !ok {
+			return core.BoundaryReachedCmd(true)
+		}
+		steps = t.viewport.CursorIndex - target
 	}
 
 	previousState := t.viewport
-	t.viewport = viewport.CalculateCursorUp(t.viewport, t.config.ViewportConfig, t.totalItems)
+	if t.scrollsByLines() {
+		t.viewport.CursorIndex -= steps
+		t.fitViewportToLines()
+	} else {
+		for range steps {
+			t.viewport = viewport.CalculateCursorUp(t.viewport, t.config.ViewportConfig, t.totalItems)
+		}
+	}
 
 	// Handle scroll reset if enabled and cursor position changed
 	t.handleScrollResetOnNavigation()
@@ -938,18 +1598,52 @@ func (t *Table) handleCursorUp() tea.Cmd {
 	return nil
 }
 
+// nextNavigableIndex returns the index one step up or down from the cursor,
+// moving past pinned rows, which show above the scrolling rows instead, and
+// past disabled rows with SkipDisabled. Rows not loaded count as navigable. It
+// returns false when no row in that direction is.
+func (t *Table) nextNavigableIndex(step int) (int, bool) {
+	from := t.viewport.CursorIndex
+	for index := from + step; index >= 0 && index < t.totalItems; index += step {
+		item, ok := t.getItemAtIndex(index)
+		if !ok || !(t.isPinnedRow(item.ID) || (t.config.ViewportConfig.SkipDisabled && item.Disabled)) {
+			return index, true
+		}
+	}
+	return from, false
+}
+
 // handleCursorDown moves cursor down one position
 func (t *Table) handleCursorDown() tea.Cmd {
-	if t.totalItems == 0 || !t.canScroll {
+	if !t.canScroll {
 		return nil
 	}
 
-	if t.viewport.CursorIndex >= t.totalItems-1 {
-		return nil
+	if t.totalItems == 0 || t.viewport.CursorIndex >= t.totalItems-1 {
+		if t.config.ViewportConfig.WrapNavigation && t.totalItems > 1 {
+			return tea.Batch(t.handleJumpToStart(), core.NavigationWrappedCmd("down"))
+		}
+		return core.BoundaryReachedCmd(false)
+	}
+
+	steps := 1
+	if t.config.ViewportConfig.SkipDisabled || len(t.pinnedRows) > 0 {
+		target, ok := t.nextNavigableIndex(1)
+		if !ok {
+			return core.BoundaryReachedCmd(false)
+		}
+		steps = target - t.viewport.CursorIndex
 	}
 
 	previousState := t.viewport
-	t.viewport = viewport.CalculateCursorDown(t.viewport, t.config.ViewportConfig, t.totalItems)
+	if t.scrollsByLines() {
+		t.viewport.CursorIndex += steps
+		t.fitViewportToLines()
+	} else {
+		for range steps {
+			t.viewport = viewport.CalculateCursorDown(t.viewport, t.config.ViewportConfig, t.totalItems)
+		}
+	}
 
 	// Handle scroll reset if enabled and cursor position changed
 	t.handleScrollResetOnNavigation()
@@ -964,12 +1658,25 @@ func (t *Table) handleCursorDown() tea.Cmd {
 
 // handlePageUp moves cursor up one page
 func (t *Table) handlePageUp() tea.Cmd {
-	if t.totalItems == 0 || !t.canScroll {
+	if !t.canScroll {
 		return nil
 	}
 
+	if t.totalItems == 0 || t.viewport.CursorIndex <= 0 {
+		if t.config.ViewportConfig.WrapPageNavigation && t.totalItems > 1 {
+			return tea.Batch(t.handleJumpToEnd(), core.NavigationWrappedCmd("up"))
+		}
+		return core.BoundaryReachedCmd(true)
+	}
+
 	previousState := t.viewport
-	t.viewport = viewport.CalculatePageUp(t.viewport, t.config.ViewportConfig, t.totalItems)
+	if t.scrollsByLines() {
+		// A page is the rows fully shown
+		t.viewport.CursorIndex = viewport.CalculatePageMovement(t.viewport.CursorIndex, t.linePageRows(), t.totalItems, -1)
+		t.fitViewportToLines()
+	} else {
+		t.viewport = viewport.CalculatePageUp(t.viewport, t.config.ViewportConfig, t.totalItems)
+	}
 
 	// Handle scroll reset if enabled and cursor position changed
 	t.handleScrollResetOnNavigation()
@@ -983,12 +1690,25 @@ func (t *Table) handlePageUp() tea.Cmd {
 
 // handlePageDown moves cursor down one page
 func (t *Table) handlePageDown() tea.Cmd {
-	if t.viewport.CursorIndex >= t.totalItems-1 {
+	if !t.canScroll {
 		return nil
 	}
 
+	if t.totalItems == 0 || t.viewport.CursorIndex >= t.totalItems-1 {
+		if t.config.ViewportConfig.WrapPageNavigation && t.totalItems > 1 {
+			return tea.Batch(t.handleJumpToStart(), core.NavigationWrappedCmd("down"))
+		}
+		return core.BoundaryReachedCmd(false)
+	}
+
 	previousState := t.viewport
-	t.viewport = viewport.CalculatePageDown(t.viewport, t.config.ViewportConfig, t.totalItems)
+	if t.scrollsByLines() {
+		// A page is the rows fully shown
+		t.viewport.CursorIndex = viewport.CalculatePageMovement(t.viewport.CursorIndex, t.linePageRows(), t.totalItems, 1)
+		t.fitViewportToLines()
+	} else {
+		t.viewport = viewport.CalculatePageDown(t.viewport, t.config.ViewportConfig, t.totalItems)
+	}
 
 	// Handle scroll reset if enabled and cursor position changed
 	t.handleScrollResetOnNavigation()
@@ -1049,7 +1769,9 @@ func (t *Table) handleJumpTo(index int) tea.Cmd {
 
 // handleDataRefresh refreshes all data
 func (t *Table) handleDataRefresh() tea.Cmd {
+	t.beginRowTransition()
 	t.chunks = make(map[int]core.Chunk[any])
+	t.resetGroupSource()
 
 	if t.dataSource == nil {
 		return nil
@@ -1061,18 +1783,125 @@ func (t *Table) handleDataRefresh() tea.Cmd {
 	return tea.Batch(cmds...)
 }
 
+// handleDataRequestSet replaces sorting and filters with those of a request and
+// reloads, restoring the request's start position once the total is known
+func (t *Table) handleDataRequestSet(request core.DataRequest) tea.Cmd {
+	t.sortFields = copyStrings(request.SortFields)
+	t.sortDirs = copyStrings(request.SortDirections)
+	t.discardPendingFilters()
+	t.filters = copyFilters(request.Filters)
+	t.fuzzyFields = copyStrings(request.FuzzyFields)
+	t.foldDiacritics = request.FilterFoldDiacritics
+
+	// Drop inline filter text for fields the request no longer filters
+	for field := range t.filterInputs {
+		if _, ok := t.filters[field]; !ok {
+			delete(t.filterInputs, field)
+		}
+	}
+
+	// The request's start replaces the position a pending refresh restores
+	t.refreshCursor = nil
+	t.pendingRequestStart = request.Start
+	return t.handleDataRefresh()
+}
+
+// handleDataTotalUpdate updates the total while preserving the cursor position
+func (t *Table) handleDataTotalUpdate(total int) tea.Cmd {
+	oldTotal := t.totalItems
+	t.totalItems = total
+	t.updateViewportBounds()
+
+	if t.viewport.CursorIndex >= t.totalItems {
+		t.viewport = viewport.ClampCursor(t.viewport, t.config.ViewportConfig, t.totalItems)
+	}
+
+	if oldTotal != t.totalItems {
+		return t.smartChunkManagement()
+	}
+	return nil
+}
+
+// scheduleRefreshFlush returns a delayed RefreshFlushMsg when a flush needs scheduling
+func (t *Table) scheduleRefreshFlush(delay time.Duration) tea.Cmd {
+	if delay <= 0 {
+		return nil
+	}
+	return core.DelayCmd(delay, core.RefreshFlushMsg{})
+}
+
+// handleRefreshFlush applies the total update and refresh deferred by MinRefreshInterval
+func (t *Table) handleRefreshFlush() tea.Cmd {
+	total, hasTotal, refresh := t.refreshThrottle.Flush()
+	if !hasTotal && !refresh {
+		return nil
+	}
+	t.refreshThrottle.Applied(time.Now())
+
+	var cmds []tea.Cmd
+	if hasTotal {
+		cmds = append(cmds, t.handleDataTotalUpdate(total))
+	}
+	if refresh {
+		t.rememberRefreshCursor()
+		cmds = append(cmds, t.handleDataRefresh())
+	}
+	return tea.Batch(cmds...)
+}
+
+// applyGrouping passes the grouping fields and collapsed groups to the data
+// source if it supports grouping, or wraps the data source when the table
+// groups rows itself, and reports whether the rows changed
+func (t *Table) applyGrouping() bool {
+	wasGrouping := t.groupSource != nil
+	if wasGrouping {
+		t.dataSource = t.groupSource.DataSource
+		t.groupSource = nil
+	}
+
+	if len(t.config.GroupByFields) > 0 && t.dataSource != nil {
+		t.groupSource = &fieldGroupingSource{DataSource: t.dataSource}
+		t.dataSource = t.groupSource
+		t.resetGroupSource()
+		return true
+	}
+
+	groupingSource, ok := t.dataSource.(core.GroupingDataSource)
+	if !ok {
+		return wasGrouping
+	}
+
+	collapsed := make(map[string]bool, len(t.collapsedGroups))
+	for key, value := range t.collapsedGroups {
+		collapsed[key] = value
+	}
+	groupingSource.SetGrouping(t.config.GroupBy, collapsed)
+	return true
+}
+
+// refreshGrouping applies the grouping and reloads the data, since grouping
+// changes both the rows and the total
+func (t *Table) refreshGrouping() tea.Cmd {
+	if !t.applyGrouping() {
+		return nil
+	}
+	return core.DataRefreshCmd()
+}
+
 // handleDataChunkLoaded processes a loaded data chunk
 func (t *Table) handleDataChunkLoaded(msg core.DataChunkLoadedMsg) tea.Cmd {
 	chunk := core.Chunk[any]{
 		StartIndex: msg.StartIndex,
 		EndIndex:   msg.StartIndex + len(msg.Items) - 1,
-		Items:      msg.Items,
+		Items:      arrangeItems(msg.Items, t.columnCells),
 		LoadedAt:   time.Now(),
 
 		Request: msg.Request,
 	}
 
 	t.chunks[msg.StartIndex] = chunk
+	t.touchChunk(msg.StartIndex)
+	t.trackPinnedItems(chunk.Items)
 
 	delete(t.loadingChunks, msg.StartIndex)
 
@@ -1089,10 +1918,27 @@ func (t *Table) handleDataChunkLoaded(msg core.DataChunkLoadedMsg) tea.Cmd {
 	var cmds []tea.Cmd
 
 	cmds = append(cmds, core.ChunkLoadingCompletedCmd(msg.StartIndex, len(msg.Items), msg.Request))
+	if shapeCmd := t.checkDataShape(msg.StartIndex, msg.Items); shapeCmd != nil {
+		cmds = append(cmds, shapeCmd)
+	}
+	t.logChunkEvent(core.ChunkEvent{
+		Type:       core.ChunkEventLoadCompleted,
+		StartIndex: msg.StartIndex,
+		Size:       len(msg.Items),
+		Request:    msg.Request,
+	})
 
 	if unloadCmd := t.unloadOldChunks(); unloadCmd != nil {
 		cmds = append(cmds, unloadCmd)
 	}
+	// A chunk loaded ahead may take the cache past MaxLoadedChunks
+	if evictCmd := t.evictChunks(); evictCmd != nil {
+		cmds = append(cmds, evictCmd)
+	}
+	cmds = append(cmds, t.settleRowTransition())
+	if t.pendingAutoSize && !t.hasLoadingChunks {
+		cmds = append(cmds, t.handleAutoSizeColumns())
+	}
 
 	return tea.Batch(cmds...)
 }
@@ -1108,7 +1954,18 @@ func (t *Table) handleSelectCurrent() tea.Cmd {
 		return nil
 	}
 
-	return t.toggleItemSelection(item.ID)
+	// Selecting a group header collapses or expands its group instead
+	if row, ok := item.Item.(core.TableRow); ok && row.Kind != core.TableRowData {
+		if row.Kind == core.TableRowGroupHeader {
+			return core.GroupToggleCmd(row.GroupKey)
+		}
+		return nil
+	}
+	if item.Disabled {
+		return core.SelectionRejectedCmd(t.viewport.CursorIndex, "disabled")
+	}
+
+	return t.advanceAfterSelect(t.toggleItemSelection(item.ID))
 }
 
 // handleSelectToggle toggles selection for a specific item
@@ -1121,16 +1978,40 @@ func (t *Table) handleSelectToggle(index int) tea.Cmd {
 	if !exists {
 		return nil
 	}
+	if item.Disabled {
+		return core.SelectionRejectedCmd(index, "disabled")
+	}
 
 	return t.toggleItemSelection(item.ID)
 }
 
 // handleSelectAll selects all items via DataSource
 func (t *Table) handleSelectAll() tea.Cmd {
+	if t.config.SelectionMode != core.SelectionMultiple || t.dataSource == nil || t.config.MaxSelections > 0 {
+		return nil
+	}
+
+	return t.dataSource.SelectAll()
+}
+
+// handleSelectAllToggle selects all items, or clears the selection when all items are already selected
+func (t *Table) handleSelectAllToggle() tea.Cmd {
 	if t.config.SelectionMode != core.SelectionMultiple || t.dataSource == nil {
 		return nil
 	}
 
+	// Prefer the DataSource's count since chunks only cover the loaded items
+	var selectedCount int
+	if counter, ok := t.idSource().(core.SelectionCounter); ok {
+		selectedCount = counter.GetSelectionCount()
+	} else {
+		selectedCount = data.GetSelectionCount(t.chunks)
+	}
+
+	if (t.totalItems > 0 && selectedCount >= t.totalItems) || t.config.MaxSelections > 0 {
+		t.selectionHistory = nil
+		return t.dataSource.ClearSelection()
+	}
 	return t.dataSource.SelectAll()
 }
 
@@ -1167,6 +2048,94 @@ func (t *Table) handleFilterChange() tea.Cmd {
 	return t.handleDataRefresh()
 }
 
+// handleDetectColumnTypes samples loaded rows in index order and stores the inferred type on each column
+// whose type is unset
+func (t *Table) handleDetectColumnTypes(sampleRows int) tea.Cmd {
+	chunkStarts := make([]int, 0, len(t.chunks))
+	for start := range t.chunks {
+		chunkStarts = append(chunkStarts, start)
+	}
+	sort.Ints(chunkStarts)
+
+	// Collect the cell values of each column from the sampled rows
+	samples := make([][]string, len(t.columns))
+	sampled := 0
+	for _, start := range chunkStarts {
+		for _, item := range t.chunks[start].Items {
+			if sampleRows > 0 && sampled >= sampleRows {
+				break
+			}
+			row, ok := item.Item.(core.TableRow)
+			if !ok {
+				continue
+			}
+			for i := range t.columns {
+				if i < len(row.Cells) {
+					samples[i] = append(samples[i], row.Cells[i])
+				}
+			}
+			sampled++
+		}
+	}
+
+	if sampled == 0 {
+		return nil
+	}
+
+	// Columns configured with a type keep it
+	types := make([]core.ColumnType, len(t.columns))
+	for i := range t.columns {
+		if t.columns[i].Type != core.ColumnString {
+			types[i] = t.columns[i].Type
+			continue
+		}
+		types[i] = core.DetectColumnType(samples[i])
+		t.columns[i].Type = types[i]
+		if i < len(t.config.Columns) {
+			t.config.Columns[i].Type = types[i]
+		}
+	}
+
+	cmds := []tea.Cmd{core.ColumnTypesDetectedCmd(types)}
+
+	// Sorted data must be reloaded so the DataSource can compare by the new types
+	if len(t.sortFields) > 0 {
+		cmds = append(cmds, t.refreshChunks())
+	}
+
+	return tea.Batch(cmds...)
+}
+
+// fieldTypes returns the known column types keyed by field for data requests
+func (t *Table) fieldTypes() map[string]core.ColumnType {
+	var types map[string]core.ColumnType
+	for _, col := range t.columns {
+		if col.Field == "" || col.Type == core.ColumnString {
+			continue
+		}
+		if types == nil {
+			types = make(map[string]core.ColumnType)
+		}
+		types[col.Field] = col.Type
+	}
+	return types
+}
+
+// sortComparators returns the custom comparators of the columns, keyed by field
+func (t *Table) sortComparators() map[string]func(a, b string) int {
+	var comparators map[string]func(a, b string) int
+	for _, col := range t.columns {
+		if col.Field == "" || col.SortComparator == nil {
+			continue
+		}
+		if comparators == nil {
+			comparators = make(map[string]func(a, b string) int)
+		}
+		comparators[col.Field] = col.SortComparator
+	}
+	return comparators
+}
+
 // handleSortToggle toggles sorting on a field
 func (t *Table) handleSortToggle(field string) tea.Cmd {
 	// Simplified implementation - just toggle between asc/desc for now
@@ -1186,6 +2155,26 @@ func (t *Table) handleSortToggle(field string) tea.Cmd {
 	return t.handleDataRefresh()
 }
 
+// handleCycleSort advances a field's sort through its column's SortCycle
+func (t *Table) handleCycleSort(field string) tea.Cmd {
+	var cycle []string
+	for _, col := range t.columns {
+		if col.Field == field {
+			cycle = col.SortCycle
+			break
+		}
+	}
+
+	sortState := data.CycleSortField(data.SortState{Fields: t.sortFields, Directions: t.sortDirs}, field, cycle)
+	t.sortFields = sortState.Fields
+	t.sortDirs = sortState.Directions
+
+	return tea.Batch(
+		t.handleDataRefresh(),
+		core.SortChangedCmd(append([]string{}, t.sortFields...), append([]string{}, t.sortDirs...)),
+	)
+}
+
 // handleSortSet sets sorting on a field
 func (t *Table) handleSortSet(field, direction string) tea.Cmd {
 	t.sortFields = []string{field}
@@ -1227,8 +2216,44 @@ func (t *Table) handleKeyPress(msg tea.KeyMsg) tea.Cmd {
 		return nil
 	}
 
+	// An open column filter input captures all keys
+	if t.filterEditor != nil {
+		return t.handleColumnFilterKey(msg)
+	}
+
+	// So does an open cell editor
+	if t.cellEditor != nil {
+		return t.handleCellEditKey(msg)
+	}
+
+	// An open search prompt captures all keys
+	if t.IsSearching() {
+		return t.handleSearchKey(msg)
+	}
+
+	// Resize mode takes over left, right and escape
+	if t.resizeMode {
+		if cmd, handled := t.handleResizeKey(msg); handled {
+			return cmd
+		}
+	}
+
 	key := msg.String()
 
+	// A count prefix is typed before JumpToPercent; any other key drops it
+	prefix := t.countPrefix
+	t.countPrefix = ""
+	if len(t.config.KeyMap.JumpToPercent) > 0 {
+		if len(key) == 1 && key[0] >= '0' && key[0] <= '9' {
+			t.countPrefix = prefix + key
+			return nil
+		}
+		if slices.Contains(t.config.KeyMap.JumpToPercent, key) && prefix != "" {
+			percent, _ := strconv.Atoi(prefix)
+			return core.JumpToPercentCmd(float64(percent) / 100)
+		}
+	}
+
 	// Check navigation keys
 	for _, upKey := range t.config.KeyMap.Up {
 		if key == upKey {
@@ -1278,6 +2303,42 @@ func (t *Table) handleKeyPress(msg tea.KeyMsg) tea.Cmd {
 		}
 	}
 
+	for _, searchKey := range t.config.KeyMap.Search {
+		if key == searchKey {
+			return t.StartSearch()
+		}
+	}
+
+	for _, filterKey := range t.config.KeyMap.Filter {
+		if key == filterKey {
+			return core.ColumnFilterEditCmd(t.currentColumn)
+		}
+	}
+
+	for _, leftKey := range t.config.KeyMap.Left {
+		if key == leftKey {
+			return core.ActiveCellMoveCmd(0, -1)
+		}
+	}
+
+	for _, rightKey := range t.config.KeyMap.Right {
+		if key == rightKey {
+			return core.ActiveCellMoveCmd(0, 1)
+		}
+	}
+
+	for _, tabKey := range t.config.KeyMap.Tab {
+		if key == tabKey {
+			return t.handleTab(true)
+		}
+	}
+
+	for _, shiftTabKey := range t.config.KeyMap.ShiftTab {
+		if key == shiftTabKey {
+			return t.handleTab(false)
+		}
+	}
+
 	// // === HORIZONTAL SCROLLING KEYS ===
 	// switch key {
 	// case "left":
@@ -1307,9 +2368,26 @@ func (t *Table) handleKeyPress(msg tea.KeyMsg) tea.Cmd {
 	return nil
 }
 
-// renderHeader renders the table header
+// HeaderView renders the header of the table on its own, whether or not the
+// table shows it, e.g. to keep it above a pane scrolling the table. Combine it
+// with ReserveHeaderSpace to line the columns up with the rows.
+func (t *Table) HeaderView() string {
+	return t.renderHeader()
+}
+
+// blankLines returns as many lines of spaces as text has, each as wide as
+// the widest line of text
+func blankLines(text string) string {
+	if text == "" {
+		return ""
+	}
+	blank := strings.Repeat(" ", lipgloss.Width(text))
+	return strings.Repeat(blank+"\n", strings.Count(text, "\n")) + blank
+}
+
+// renderHeader renders the table header, shown or not
 func (t *Table) renderHeader() string {
-	if !t.config.ShowHeader || len(t.columns) == 0 {
+	if len(t.columns) == 0 {
 		return ""
 	}
 
@@ -1323,6 +2401,10 @@ func (t *Table) renderHeader() string {
 	// Add indicator column header since component renderer is always enabled
 	indicatorWidth := 4
 	indicatorHeader := "●" // Use a dot/bullet as indicator
+	if hidden := t.droppedColumnCount(); hidden > 0 {
+		// Show how many columns were dropped to fit the width
+		indicatorHeader = fmt.Sprintf("+%d", hidden)
+	}
 
 	// Create constraint for indicator header
 	indicatorConstraint := core.CellConstraint{
@@ -1336,18 +2418,28 @@ func (t *Table) renderHeader() string {
 	parts = append(parts, styledIndicatorHeader)
 
 	for i, col := range t.columns {
+		if t.hiddenColumns[i] {
+			continue
+		}
+
 		var headerText string
 
+		// An open filter input replaces the header cell of its column
+		if t.filterEditor != nil && t.filterEditor.column == i {
+			parts = append(parts, t.renderColumnFilterEditor(col))
+			continue
+		}
+
 		// Use HeaderCellFormatter if available for this specific column
 		// IMPORTANT: Use the original column index i, NOT shifted by indicator column
-		if formatter, exists := t.headerCellFormatters[i]; exists {
+		if formatter, exists := t.headerFormatterFor(i); exists {
 			// Get the formatted header content
 			formattedHeader := formatter(col, t.renderContext)
 
 			// Determine which alignment and constraint to use
 			headerAlignment := col.HeaderAlignment
 			if headerAlignment == 0 {
-				headerAlignment = col.Alignment // Fall back to column alignment if not specified
+				headerAlignment = core.ColumnAlignment(col) // Fall back to column alignment if not specified
 			}
 
 			// Use header constraint if specified, otherwise create default constraint
@@ -1368,18 +2460,21 @@ func (t *Table) renderHeader() string {
 			}
 
 			// CRITICAL: Apply constraints to the formatted header to ensure exact column width
-			constrainedHeader := t.applyCellConstraints(formattedHeader, constraint, -1) // Use -1 to skip horizontal scrolling for headers
-
-			// Apply header styling to the constrained content
-			headerText = t.config.Theme.HeaderStyle.Render(constrainedHeader)
+			headerText = t.renderHeaderText(formattedHeader, constraint, col.Field)
 		} else {
 			// Default header cell rendering
 
 			headerText = col.Title
 
-			// Add sort indicator if this column is sorted
+			// Mark the column being resized
+			if t.resizeMode && i == t.currentColumn {
+				headerText += " ↔"
+			}
+
+			// Add sort indicator if this column is sorted, unless the styled
+			// indicators are decorating the header instead
 			for j, field := range t.sortFields {
-				if field == col.Field {
+				if field == col.Field && !t.config.ShowSortIndicators {
 					if t.sortDirs[j] == "asc" {
 						headerText += " ↑"
 					} else {
@@ -1392,7 +2487,7 @@ func (t *Table) renderHeader() string {
 			// Determine which alignment and constraint to use
 			headerAlignment := col.HeaderAlignment
 			if headerAlignment == 0 {
-				headerAlignment = col.Alignment // Fall back to column alignment if not specified
+				headerAlignment = core.ColumnAlignment(col) // Fall back to column alignment if not specified
 			}
 
 			// Use header constraint if specified, otherwise create default constraint
@@ -1412,17 +2507,14 @@ func (t *Table) renderHeader() string {
 				}
 			}
 
-			// Apply constraints to header text
-			styledHeader := t.applyCellConstraints(headerText, constraint, -1) // Use -1 to skip horizontal scrolling for headers
-
-			// Apply header styling
-			headerText = t.config.Theme.HeaderStyle.Render(styledHeader)
+			// Apply constraints and header styling to header text
+			headerText = t.renderHeaderText(headerText, constraint, col.Field)
 		}
 
 		parts = append(parts, headerText)
 	}
 
-	result := strings.Join(parts, t.getBorderChar())
+	result := t.joinCells(parts, 1, t.getBorderChar(), t.pinnedBorderChar())
 
 	if t.config.ShowBorders {
 		result = t.getBorderChar() + result + t.getBorderChar()
@@ -1431,13 +2523,22 @@ func (t *Table) renderHeader() string {
 	return result
 }
 
+// isPlaceholderID reports whether an item ID belongs to a placeholder shown
+// for a row that is not loaded
+func isPlaceholderID(id string) bool {
+	return strings.HasPrefix(id, "loading-") || strings.HasPrefix(id, "missing-")
+}
+
 // renderRow renders a single table row using proper table layout
 func (t *Table) renderRow(item core.Data[any], absoluteIndex int, isCursor bool) string {
 	// Handle loading placeholders with custom formatter
-	if strings.HasPrefix(item.ID, "loading-") || strings.HasPrefix(item.ID, "missing-") {
+	if isPlaceholderID(item.ID) {
 		if t.loadingFormatter != nil {
 			return t.loadingFormatter(absoluteIndex, t.columns, t.renderContext, isCursor)
 		}
+		if placeholder := t.config.ViewportConfig.LoadingPlaceholder; placeholder != nil && t.IsIndexLoading(absoluteIndex) {
+			return t.renderLoadingPlaceholder(placeholder(absoluteIndex, t.renderContext), isCursor)
+		}
 		// Default loading behavior - show empty cells with proper column widths
 		return t.renderDefaultLoadingRow(absoluteIndex, isCursor)
 	}
@@ -1455,14 +2556,19 @@ func (t *Table) renderRow(item core.Data[any], absoluteIndex int, isCursor bool)
 	}
 
 	// Use component rendering for all rows - it's now the only system
-	var parts []string
+	var cells []rowCell
 
 	// FIRST: Add a separate indicator column for cursor/selection
 	indicatorWidth := 4 // Width for "► ✓ "
 	var indicatorContent string
 
 	// Build indicators separately from content
-	if isCursor && item.Selected {
+	if item.Error != nil && row.Kind == core.TableRowData {
+		indicatorContent = errorRowGlyph
+		if isCursor {
+			indicatorContent = "►" + errorRowGlyph
+		}
+	} else if isCursor && item.Selected {
 		indicatorContent = "►✓"
 	} else if isCursor {
 		indicatorContent = "► "
@@ -1480,30 +2586,53 @@ func (t *Table) renderRow(item core.Data[any], absoluteIndex int, isCursor bool)
 	}
 	constrainedIndicator := t.applyCellConstraints(indicatorContent, indicatorConstraint, -1) // Use -1 for indicator column
 
+	// Full-row styling also covers the indicator column and the gaps between cells
+	fillStyle, filled := t.rowFillStyle(item, absoluteIndex, isCursor)
+
 	// Style the indicator column
-	var styledIndicator string
-	if isCursor {
-		styledIndicator = t.config.Theme.CursorStyle.Render(constrainedIndicator)
+	var indicatorStyle lipgloss.Style
+	if filled && (!isCursor || t.config.FullRowHighlighting) {
+		indicatorStyle = fillStyle
+	} else if isCursor {
+		indicatorStyle = t.cursorStyle()
 	} else if item.Selected {
-		styledIndicator = t.config.Theme.SelectedStyle.Render(constrainedIndicator)
+		indicatorStyle = t.config.Theme.SelectedStyle
 	} else {
-		styledIndicator = t.config.Theme.CellStyle.Render(constrainedIndicator)
+		indicatorStyle = t.config.Theme.CellStyle
+	}
+	styledIndicator := indicatorStyle.Render(constrainedIndicator)
+	if t.config.ShowCheckboxColumn {
+		styledIndicator = t.renderCheckboxIndicator(item, row, absoluteIndex, isCursor, indicatorStyle)
 	}
-
-	parts = append(parts, styledIndicator)
 
 	// THEN: Render each actual data cell WITHOUT contamination
 	for i, col := range t.columns {
+		if t.hiddenColumns[i] {
+			continue
+		}
+
+		// The cell being edited shows the editor instead of its value
+		if t.isEditingCell(row.ID, i) {
+			cells = append(cells, rowCell{
+				lines:  []string{t.renderCellEditor(col)},
+				blank:  t.config.Theme.CellStyle.Render(t.applyCellConstraints("", core.CellConstraint{Width: col.Width, Height: 1}, -1)),
+				valign: col.VerticalAlignment,
+			})
+			continue
+		}
+
 		var cellValue string
 		if i < len(row.Cells) {
 			cellValue = row.Cells[i]
 		}
 
 		// Apply cell formatter to original content (NO prefix contamination!)
+		// Group header and total rows bypass formatters meant for data cells,
+		// and cells missing from a short row render empty
 		var formattedContent string
-		if formatter, exists := t.cellFormatters[i]; exists {
+		if formatter, exists := t.cellFormatterFor(i); exists && row.Kind == core.TableRowData && i < len(row.Cells) {
 			isActiveCell := t.isActiveCell(i, isCursor)
-			formattedContent = formatter(cellValue, absoluteIndex, col, t.renderContext, isCursor, item.Selected, isActiveCell)
+			formattedContent = t.formatCell(formatter, i, item.ID, cellValue, absoluteIndex, col, isCursor, item.Selected, isActiveCell)
 		} else {
 			formattedContent = cellValue
 		}
@@ -1512,63 +2641,169 @@ func (t *Table) renderRow(item core.Data[any], absoluteIndex int, isCursor bool)
 		constraint := core.CellConstraint{
 			Width:     col.Width,
 			Height:    1,
-			Alignment: col.Alignment,
+			Alignment: core.ColumnAlignment(col),
 		}
 
-		constrainedContent := t.applyCellConstraintsWithRowInfo(formattedContent, constraint, i, isCursor)
+		// An outlined active cell reserves one column on each side for its edges
+		outlined := t.isActiveCellOutlined(i, isCursor, col.Width)
+		if outlined {
+			constraint.Width = col.Width - 2
+		}
 
-		// When full-row highlighting is on, the active cell indication must be layered on top.
-		// This block ensures the active cell's background overrides the full-row highlight.
-		// Apply full row highlighting if enabled (overrides all other styling)
-		var styledCell string
-		if t.config.FullRowHighlighting && isCursor {
-			// Full row highlighting takes over - strip existing styling and apply uniform background
-			plainContent := stripANSI(constrainedContent)
-			fullRowStyle := t.config.Theme.FullRowCursorStyle
+		// styleLine styles one constrained line of the cell
+		styleLine := func(constrainedContent string) string {
+			// When full-row highlighting is on, the active cell indication must be layered on top.
+			// This block ensures the active cell's background overrides the full-row highlight.
+			// Apply full row highlighting if enabled (overrides all other styling)
+			var styledCell string
+			if t.config.FullRowHighlighting && isCursor {
+				// Full row highlighting takes over - strip existing styling and apply uniform background
+				plainContent := stripANSI(constrainedContent)
+				fullRowStyle := t.fullRowCursorStyle()
 
-			// Check for active cell and override background if needed
-			isActiveCell := t.isActiveCell(i, isCursor)
-			if isActiveCell && t.config.ActiveCellIndicationEnabled {
-				// Active cell background overrides full row cursor background
-				activeCellStyle := fullRowStyle.Copy().
-					Background(lipgloss.Color(t.config.ActiveCellBackgroundColor))
-				styledCell = activeCellStyle.Render(plainContent)
-			} else {
-				styledCell = fullRowStyle.Render(plainContent)
-			}
-		} else if item.Selected {
-			// Apply full-row selection styling - strip existing styling and apply uniform selection background
-			plainContent := stripANSI(constrainedContent)
-			selectionStyle := t.config.Theme.SelectedStyle
-			styledCell = selectionStyle.Render(plainContent)
-		} else if isCursor {
-			// Check if this is an active cell that should override cursor styling
-			isActiveCell := t.isActiveCell(i, isCursor)
-			if isActiveCell && t.config.ActiveCellIndicationEnabled {
-				// Active cell background overrides cursor background
-				activeCellStyle := lipgloss.NewStyle().
-					Background(lipgloss.Color(t.config.ActiveCellBackgroundColor)).
-					Foreground(t.config.Theme.CursorStyle.GetForeground())
-				styledCell = activeCellStyle.Render(stripANSI(constrainedContent))
+				// Check for active cell and override background if needed
+				isActiveCell := t.isActiveCell(i, isCursor)
+				if outlined {
+					// Outlined active cell keeps the row background and draws its edges instead
+					styledCell = t.applyActiveCellOutline(fullRowStyle.Render(plainContent), fullRowStyle)
+				} else if isActiveCell && t.config.ActiveCellIndicationEnabled {
+					// Active cell background overrides full row cursor background
+					activeCellStyle := fullRowStyle.Copy().
+						Background(lipgloss.Color(t.config.ActiveCellBackgroundColor))
+					styledCell = activeCellStyle.Render(plainContent)
+				} else {
+					styledCell = fullRowStyle.Render(plainContent)
+				}
+			} else if t.isPinnedRow(item.ID) && absoluteIndex < 0 {
+				// Pinned rows above the scrolling rows replace the formatting
+				styledCell = t.config.Theme.PinnedRowStyle.Render(stripANSI(constrainedContent))
+			} else if item.Error != nil && row.Kind == core.TableRowData && !isCursor {
+				// Errored rows replace whatever the formatter produced
+				styledCell = t.config.Theme.ErrorRowStyle.Render(stripANSI(constrainedContent))
+			} else if style, ok := t.transitionStyle(item.ID); ok && !isCursor {
+				// Rows a refresh changed are marked while the transition plays
+				styledCell = style.Render(stripANSI(constrainedContent))
+			} else if item.Disabled && !isCursor {
+				// Disabled rows are dimmed over whatever the formatter produced
+				styledCell = t.config.Theme.DisabledStyle.Render(stripANSI(constrainedContent))
+			} else if item.Selected {
+				// Apply full-row selection styling over whatever the formatter produced
+				styledCell = t.styleSelectedCell(constrainedContent)
+				if outlined {
+					styledCell = t.applyActiveCellOutline(styledCell, t.config.Theme.SelectedStyle)
+				}
+			} else if isCursor {
+				// Check if this is an active cell that should override cursor styling
+				isActiveCell := t.isActiveCell(i, isCursor)
+				if outlined {
+					// Outline replaces the background fill of the active cell
+					styledCell = t.applyActiveCellOutline(t.cursorStyle().Render(constrainedContent), t.cursorStyle())
+				} else if isActiveCell && t.config.ActiveCellIndicationEnabled {
+					// Active cell background overrides cursor background
+					activeCellStyle := lipgloss.NewStyle().
+						Background(lipgloss.Color(t.config.ActiveCellBackgroundColor)).
+						Foreground(t.cursorStyle().GetForeground())
+					styledCell = activeCellStyle.Render(stripANSI(constrainedContent))
+				} else {
+					// Apply normal cursor styling to formatted content
+					styledCell = t.highlightSearchMatches(constrainedContent, t.cursorStyle())
+				}
+			} else if row.Kind == core.TableRowGroupHeader {
+				styledCell = t.config.Theme.GroupHeaderStyle.Render(constrainedContent)
+			} else if row.Kind != core.TableRowData {
+				styledCell = t.config.Theme.SubtotalStyle.Render(constrainedContent)
+			} else if filled {
+				// Zebra stripe behind the formatted content
+				styledCell = t.highlightSearchMatches(constrainedContent, fillStyle)
 			} else {
-				// Apply normal cursor styling to formatted content
-				styledCell = t.config.Theme.CursorStyle.Render(constrainedContent)
+				// Use the formatted and constrained content as-is
+				styledCell = t.highlightSearchMatches(constrainedContent, lipgloss.NewStyle())
 			}
-		} else {
-			// Use the formatted and constrained content as-is
-			styledCell = constrainedContent
+			return styledCell
 		}
 
-		parts = append(parts, styledCell)
+		// Wrapped columns yield one line per wrapped line of text
+		contentLines := []string{formattedContent}
+		if col.WrapText {
+			contentLines = wrapCellText(formattedContent, constraint.Width)
+		}
+
+		cell := rowCell{
+			lines:  make([]string, len(contentLines)),
+			blank:  styleLine(t.applyCellConstraints("", constraint, -1)),
+			valign: col.VerticalAlignment,
+		}
+		for k, line := range contentLines {
+			cell.lines[k] = styleLine(t.applyCellConstraintsWithRowInfo(line, constraint, i, isCursor))
+		}
+		cells = append(cells, cell)
 	}
 
-	result := strings.Join(parts, t.getBorderChar())
+	separator := t.getBorderChar()
+	pinnedSeparator := t.pinnedBorderChar()
+	if filled {
+		separator = t.rowGapStyle(fillStyle).Render(separator)
+		pinnedSeparator = t.rowGapStyle(fillStyle).Render(pinnedSeparator)
+	}
+
+	// The indicator column is blank below the first line of a tall row
+	indicatorBlank := indicatorStyle.Render(strings.Repeat(" ", indicatorWidth))
 
+	lines := t.composeRowLines(styledIndicator, indicatorBlank, cells, separator, pinnedSeparator)
 	if t.config.ShowBorders {
-		result = t.getBorderChar() + result + t.getBorderChar()
+		for k, line := range lines {
+			lines[k] = t.getBorderChar() + line + t.getBorderChar()
+		}
 	}
 
-	return result
+	return strings.Join(lines, "\n")
+}
+
+// rowFillStyle returns the style that fills a whole row, if any: the full-row
+// cursor style when FullRowHighlighting is on, otherwise the selection style,
+// otherwise the RowStyleFunc style and zebra stripe of data rows
+func (t *Table) rowFillStyle(item core.Data[any], absoluteIndex int, isCursor bool) (lipgloss.Style, bool) {
+	if t.config.FullRowHighlighting && isCursor {
+		return t.fullRowCursorStyle(), true
+	}
+	if item.Selected {
+		return t.config.Theme.SelectedStyle, true
+	}
+
+	row, ok := item.Item.(core.TableRow)
+	if !ok || row.Kind != core.TableRowData {
+		return lipgloss.Style{}, false
+	}
+
+	var style lipgloss.Style
+	filled := false
+	if t.config.ZebraStriping {
+		style, filled = t.stripeStyle(absoluteIndex), true
+	}
+	if t.config.RowStyleFunc != nil {
+		if rowStyle := t.config.RowStyleFunc(row, absoluteIndex, isCursor, item.Selected); rowStyle != nil {
+			// The row style wins over the stripe where both set a property
+			style, filled = rowStyle.Inherit(style), true
+		}
+	}
+	return style, filled
+}
+
+// stripeStyle returns the zebra stripe style for an absolute row index
+func (t *Table) stripeStyle(absoluteIndex int) lipgloss.Style {
+	if absoluteIndex%2 == 0 {
+		return t.config.Theme.EvenRowStyle
+	}
+	return t.config.Theme.OddRowStyle
+}
+
+// rowGapStyle returns the style for the separators between the cells of a
+// filled row. Only the fill's background and reverse attribute carry over so
+// the column separators keep their own look
+func (t *Table) rowGapStyle(fill lipgloss.Style) lipgloss.Style {
+	return lipgloss.NewStyle().
+		Background(fill.GetBackground()).
+		Reverse(fill.GetReverse())
 }
 
 // renderCellsForRow renders all cells for a row and returns CellRenderResults
@@ -1576,22 +2811,27 @@ func (t *Table) renderCellsForRow(row core.TableRow, absoluteIndex int, isCursor
 	var results []core.CellRenderResult
 
 	for i, col := range t.columns {
+		if t.hiddenColumns[i] {
+			continue
+		}
+
 		var cellValue string
 		if i < len(row.Cells) {
 			cellValue = row.Cells[i]
 		}
 
-		// Use regular formatter or default
+		// Use regular formatter or default; cells missing from a short row
+		// render empty
 		var finalCellValue string
-		if formatter, exists := t.cellFormatters[i]; exists {
+		if formatter, exists := t.cellFormatterFor(i); exists && i < len(row.Cells) {
 			isActiveCell := t.isActiveCell(i, isCursor)
-			formattedValue := formatter(cellValue, absoluteIndex, col, t.renderContext, isCursor, isSelected, isActiveCell)
+			formattedValue := t.formatCell(formatter, i, row.ID, cellValue, absoluteIndex, col, isCursor, isSelected, isActiveCell)
 
 			// Apply full row highlighting if enabled (overrides formatter styling)
 			if t.config.FullRowHighlighting && isCursor {
 				// Full row highlighting takes over - strip existing styling and apply uniform background
 				plainContent := stripANSI(formattedValue)
-				fullRowStyle := t.config.Theme.FullRowCursorStyle
+				fullRowStyle := t.fullRowCursorStyle()
 
 				isActiveCell := t.isActiveCell(i, isCursor)
 				if isActiveCell && t.config.ActiveCellIndicationEnabled {
@@ -1608,7 +2848,7 @@ func (t *Table) renderCellsForRow(row core.TableRow, absoluteIndex int, isCursor
 			// Apply full row highlighting if enabled, otherwise use plain value
 			if t.config.FullRowHighlighting && isCursor {
 				plainContent := stripANSI(cellValue)
-				fullRowStyle := t.config.Theme.FullRowCursorStyle
+				fullRowStyle := t.fullRowCursorStyle()
 
 				isActiveCell := t.isActiveCell(i, isCursor)
 				if isActiveCell && t.config.ActiveCellIndicationEnabled {
@@ -1637,22 +2877,34 @@ func (t *Table) renderCellsForRow(row core.TableRow, absoluteIndex int, isCursor
 	return results
 }
 
-// renderDefaultLoadingRow renders a default loading row with empty cells
+// renderDefaultLoadingRow renders a default loading row, with an ellipsis in
+// each cell while its chunk is loading and empty cells otherwise
 func (t *Table) renderDefaultLoadingRow(absoluteIndex int, isCursor bool) string {
-	var parts []string
+	loading := t.IsIndexLoading(absoluteIndex)
+
+	// Keep the indicator column so the cells line up with the header
+	indicatorStyle := t.config.Theme.CellStyle
+	if isCursor {
+		indicatorStyle = t.cursorStyle()
+	}
+	parts := []string{indicatorStyle.Render("    ")}
 
 	// Create empty cells for each column
-	for _, col := range t.columns {
+	for i, col := range t.columns {
+		if t.hiddenColumns[i] {
+			continue
+		}
+
 		constraint := core.CellConstraint{
 			Width:     col.Width,
 			Height:    1,
-			Alignment: col.Alignment,
+			Alignment: core.ColumnAlignment(col),
 		}
 
 		// Use loading indicator or empty space
 		loadingText := ""
-		if col.Width >= 10 {
-			loadingText = "Loading..."
+		if loading {
+			loadingText = "…"
 		}
 
 		constrainedContent := t.applyCellConstraints(loadingText, constraint, -1) // Use -1 for loading cells
@@ -1661,10 +2913,12 @@ func (t *Table) renderDefaultLoadingRow(absoluteIndex int, isCursor bool) string
 		var styledCell string
 		if t.config.FullRowHighlighting && isCursor {
 			// Apply full row highlighting to loading rows too
-			fullRowStyle := t.config.Theme.FullRowCursorStyle
+			fullRowStyle := t.fullRowCursorStyle()
 			styledCell = fullRowStyle.Render(constrainedContent)
 		} else if isCursor {
-			styledCell = t.config.Theme.CursorStyle.Render(constrainedContent)
+			styledCell = t.cursorStyle().Render(constrainedContent)
+		} else if loading {
+			styledCell = t.config.Theme.CellStyle.Faint(true).Render(constrainedContent)
 		} else {
 			styledCell = t.config.Theme.CellStyle.Render(constrainedContent)
 		}
@@ -1672,7 +2926,7 @@ func (t *Table) renderDefaultLoadingRow(absoluteIndex int, isCursor bool) string
 		parts = append(parts, styledCell)
 	}
 
-	result := strings.Join(parts, t.getBorderChar())
+	result := t.joinCells(parts, 1, t.getBorderChar(), t.pinnedBorderChar())
 
 	if t.config.ShowBorders {
 		result = t.getBorderChar() + result + t.getBorderChar()
@@ -1702,6 +2956,11 @@ func (t *Table) applyCellConstraintsWithRowInfo(text string, constraint core.Cel
 		shouldApplyHorizontalScrolling = isCurrentRow && columnIndex == t.currentColumn
 	}
 
+	// Pinned columns never scroll
+	if t.isColumnPinned(columnIndex) {
+		shouldApplyHorizontalScrolling = false
+	}
+
 	// Apply horizontal scrolling first (before any constraints)
 	var scrolledText string
 	if shouldApplyHorizontalScrolling {
@@ -1748,9 +3007,10 @@ func (t *Table) applyCellConstraintsWithRowInfo(text string, constraint core.Cel
 		measureWidth = lipgloss.Width
 		truncateFunc = ansiTruncate
 	} else {
-		// For plain text: use runewidth for proper Unicode handling
-		measureWidth = runewidth.StringWidth
-		truncateFunc = ansiTruncateWithRunewidth
+		// For plain text: measure grapheme clusters, so emoji sequences and
+		// combining marks are neither miscounted nor split
+		measureWidth = uniseg.StringWidth
+		truncateFunc = truncateGraphemes
 	}
 
 	// Check if we need to truncate
@@ -1759,7 +3019,7 @@ func (t *Table) applyCellConstraintsWithRowInfo(text string, constraint core.Cel
 		showEllipsis := t.shouldShowEllipsis(originalText, columnIndex, scrolledText, isCurrentRow)
 
 		if showEllipsis {
-			scrolledText = truncateFunc(scrolledText, width, "...")
+			scrolledText = core.TruncateText(scrolledText, width, t.truncateMode(columnIndex, hasHorizontalScrolling), t.ellipsis())
 		} else {
 			// No ellipsis - we're at the end of the content
 
@@ -1831,6 +3091,23 @@ func (t *Table) applyCellConstraintsWithRowInfo(text string, constraint core.Cel
 	return scrolledText
 }
 
+// truncateMode returns where a cell of the column is cut. A cell scrolled
+// horizontally is always cut at the end, past what scrolling revealed.
+func (t *Table) truncateMode(columnIndex int, scrolled bool) core.TruncateMode {
+	if scrolled || columnIndex < 0 || columnIndex >= len(t.columns) {
+		return core.TruncateEnd
+	}
+	return t.columns[columnIndex].TruncateMode
+}
+
+// ellipsis returns the theme's ellipsis, "..." unless set
+func (t *Table) ellipsis() string {
+	if t.config.Theme.Ellipsis == "" {
+		return "..."
+	}
+	return t.config.Theme.Ellipsis
+}
+
 // shouldShowEllipsis determines if ellipsis should be shown based on scroll position and scope
 func (t *Table) shouldShowEllipsis(originalText string, columnIndex int, scrolledText string, isCurrentRow bool) bool {
 	// Check if this specific row/column combination is actually being scrolled
@@ -1868,7 +3145,7 @@ func (t *Table) shouldShowEllipsis(originalText string, columnIndex int, scrolle
 		// If we've scrolled past or to the last few words, don't show ellipsis
 		return scrollOffset < len(words)-2
 	case "smart":
-		boundaries := t.findSmartBoundariesInSegments(t.parseTextWithANSI(originalText))
+		boundaries := smartScrollBoundaries(stripANSI(originalText))
 		// If we're at the last boundary, don't show ellipsis
 		return scrollOffset < len(boundaries)-1
 	default: // "character"
@@ -2027,6 +3304,32 @@ func ansiTruncateWithRunewidth(text string, maxWidth int, suffix string) string
 	return result.String()
 }
 
+// cursorStyle returns the theme's cursor style, falling back to reverse video
+// when it has no colors and CursorFallbackReverse is enabled
+func (t *Table) cursorStyle() lipgloss.Style {
+	return t.withCursorFallback(t.config.Theme.CursorStyle)
+}
+
+// fullRowCursorStyle returns the theme's full-row cursor style with the same
+// reverse video fallback as cursorStyle
+func (t *Table) fullRowCursorStyle() lipgloss.Style {
+	return t.withCursorFallback(t.config.Theme.FullRowCursorStyle)
+}
+
+// withCursorFallback applies reverse video to a cursor style that sets neither
+// a foreground nor a background
+func (t *Table) withCursorFallback(style lipgloss.Style) lipgloss.Style {
+	if !t.config.CursorFallbackReverse {
+		return style
+	}
+	_, noForeground := style.GetForeground().(lipgloss.NoColor)
+	_, noBackground := style.GetBackground().(lipgloss.NoColor)
+	if noForeground && noBackground && !style.GetReverse() {
+		return style.Copy().Reverse(true)
+	}
+	return style
+}
+
 // getBorderChar returns the appropriate border character
 func (t *Table) getBorderChar() string {
 	if t.config.ShowBorders {
@@ -2086,11 +3389,121 @@ func (t *Table) SetHeaderFormatter(columnIndex int, formatter core.SimpleHeaderF
 	return core.HeaderFormatterSetCmd(columnIndex, formatter)
 }
 
+// SetCellFormatterByField sets a cell formatter for the column showing field
+func (t *Table) SetCellFormatterByField(field string, formatter core.SimpleCellFormatter) tea.Cmd {
+	return core.CellFormatterSetByFieldCmd(field, formatter)
+}
+
+// SetHeaderFormatterByField sets a header formatter for the column showing field
+func (t *Table) SetHeaderFormatterByField(field string, formatter core.SimpleHeaderFormatter) tea.Cmd {
+	return core.HeaderFormatterSetByFieldCmd(field, formatter)
+}
+
+// SetGroupBy sets the fields rows are grouped by, outermost first. An empty
+// slice removes grouping. It requires a data source implementing
+// core.GroupingDataSource.
+func (t *Table) SetGroupBy(fields []string) tea.Cmd {
+	return core.GroupBySetCmd(fields)
+}
+
+// SetGroupByFields sets the fields the table groups rows by itself, outermost
+// first, whatever its data source. An empty slice removes grouping.
+func (t *Table) SetGroupByFields(fields []string) tea.Cmd {
+	return core.GroupByFieldsSetCmd(fields)
+}
+
+// ToggleGroup collapses or expands the group with the given key, the group's
+// value prefixed by the keys of its parent groups and "/" when nested, as in
+// "EU/Books". It emits GroupToggledMsg.
+func (t *Table) ToggleGroup(key string) tea.Cmd {
+	return core.GroupToggleCmd(key)
+}
+
+// IsGroupCollapsed returns whether the group with the given key is collapsed
+func (t *Table) IsGroupCollapsed(key string) bool {
+	return t.collapsedGroups[key]
+}
+
+// CycleSort advances the sort of a field to the next direction in its column's SortCycle
+func (t *Table) CycleSort(field string) tea.Cmd {
+	return core.CycleSortCmd(field)
+}
+
+// GetSort returns the current sort fields and their directions
+func (t *Table) GetSort() ([]string, []string) {
+	return append([]string{}, t.sortFields...), append([]string{}, t.sortDirs...)
+}
+
+// SetFuzzyFields makes the filters of the given fields match fuzzily, with
+// results ranked by score unless a sort is set. Passing no fields restores
+// plain substring filtering.
+func (t *Table) SetFuzzyFields(fields ...string) tea.Cmd {
+	return core.FuzzyFieldsSetCmd(fields)
+}
+
+// SetResponsiveCard enables or disables the card layout for narrow widths
+func (t *Table) SetResponsiveCard(enabled bool) tea.Cmd {
+	return core.ResponsiveCardSetCmd(enabled)
+}
+
+// CurrentRequest returns the request the table would issue for its visible
+// range, with its current sorting, filters and column types. It can be passed
+// back to SetRequest to restore the same view.
+func (t *Table) CurrentRequest() core.DataRequest {
+	count := t.config.ViewportConfig.Height
+	if remaining := t.totalItems - t.viewport.ViewportStartIndex; remaining < count {
+		count = remaining
+	}
+	if count < 0 {
+		count = 0
+	}
+
+	request := data.CreateDataRequest(
+		t.viewport.ViewportStartIndex,
+		count,
+		copyStrings(t.sortFields),
+		copyStrings(t.sortDirs),
+		copyFilters(t.filters),
+	)
+	request.FieldTypes = t.fieldTypes()
+	request.SortComparators = t.sortComparators()
+	request.FuzzyFields = copyStrings(t.fuzzyFields)
+	request.FilterFoldDiacritics = t.foldDiacritics
+	return request
+}
+
+// SetRequest applies a request wholesale, replacing sorting and filters, and
+// reloads the data with the cursor at the request's start
+func (t *Table) SetRequest(request core.DataRequest) tea.Cmd {
+	return core.DataRequestSetCmd(request)
+}
+
+// SetStatusLine sets the text of the managed status line rendered below the
+// table. An empty text hides the status line.
+func (t *Table) SetStatusLine(text string) tea.Cmd {
+	return core.StatusLineSetCmd(text)
+}
+
+// GetStatusLine returns the current status line text
+func (t *Table) GetStatusLine() string {
+	return t.statusLine
+}
+
 // SetTheme sets the table theme
 func (t *Table) SetTheme(theme core.Theme) tea.Cmd {
 	return core.TableThemeSetCmd(theme)
 }
 
+// SetThemeByName sets the table theme to the one registered under name in
+// core.Themes. It returns nil when no theme has that name.
+func (t *Table) SetThemeByName(name string) tea.Cmd {
+	theme, ok := core.Themes.Get(name)
+	if !ok {
+		return nil
+	}
+	return core.TableThemeSetCmd(theme)
+}
+
 // SetColumnFormatter sets a formatter for a specific column with automatic truncation
 func (t *Table) SetColumnFormatter(columnIndex int, formatter core.SimpleCellFormatter) tea.Cmd {
 	return t.SetCellFormatter(columnIndex, formatter)
@@ -2137,6 +3550,9 @@ func CreateSimpleHeaderFormatter(formatFunc func(columnTitle string) string) cor
 
 // calculateBoundingArea calculates the bounding area around the current viewport automatically
 func (t *Table) calculateBoundingArea() core.BoundingArea {
+	if area, ok := t.hintedBoundingArea(); ok {
+		return area
+	}
 	return viewport.CalculateBoundingArea(t.viewport, t.config.ViewportConfig, t.totalItems)
 }
 
@@ -2149,9 +3565,9 @@ func (t *Table) unloadChunksOutsideBoundingArea() tea.Cmd {
 	// Find and unload chunks outside the bounding area
 	chunksToUnload := data.FindChunksToUnload(t.chunks, boundingArea, chunkSize)
 	for _, chunkStart := range chunksToUnload {
-		delete(t.chunks, chunkStart)
-		delete(t.chunkAccessTime, chunkStart)
-		cmds = append(cmds, core.ChunkUnloadedCmd(chunkStart))
+		if !t.isPrefetchedChunk(chunkStart) {
+			cmds = append(cmds, t.unloadChunk(chunkStart))
+		}
 	}
 
 	return tea.Batch(cmds...)
@@ -2172,6 +3588,52 @@ func (t *Table) updateViewportBounds() {
 	t.viewport = viewport.UpdateViewportBounds(t.viewport, t.config.ViewportConfig, t.totalItems)
 }
 
+// applyAutoHeight fits the viewport to the terminal height when AutoHeight is
+// enabled and loads the chunks the resized viewport needs
+func (t *Table) applyAutoHeight(terminalHeight int) tea.Cmd {
+	if !t.config.ViewportConfig.AutoHeight || terminalHeight <= 0 {
+		return nil
+	}
+
+	height := viewport.AutoHeight(terminalHeight, t.chromeLines(), t.config.ViewportConfig)
+	if height == t.config.ViewportConfig.Height {
+		return nil
+	}
+	t.config.ViewportConfig.Height = height
+	t.viewport = viewport.Resize(t.viewport, t.config.ViewportConfig, t.totalItems)
+	return t.smartChunkManagement()
+}
+
+// chromeLines returns the number of lines the table draws around its rows
+func (t *Table) chromeLines() int {
+	lines := 0
+	if t.config.ShowTopBorder && !t.config.RemoveTopBorderSpace {
+		lines++
+	}
+	if t.config.ShowHeader || t.config.ReserveHeaderSpace {
+		lines++
+		if t.config.ShowHeaderSeparator {
+			lines++
+		}
+	}
+	if t.hasFooter() {
+		lines++
+		if t.config.ShowFooterSeparator {
+			lines++
+		}
+	}
+	if t.config.ShowBottomBorder && !t.config.RemoveBottomBorderSpace {
+		lines++
+	}
+	if t.config.ShowPageIndicator {
+		lines++
+	}
+	if t.config.ShowHorizontalScrollIndicator {
+		lines++
+	}
+	return lines
+}
+
 // smartChunkManagement provides intelligent chunk loading with user feedback
 func (t *Table) smartChunkManagement() tea.Cmd {
 	if t.dataSource == nil {
@@ -2184,8 +3646,10 @@ func (t *Table) smartChunkManagement() tea.Cmd {
 	var cmds []tea.Cmd
 	var newLoadingChunks []int
 
-	// Get chunks that need to be loaded
+	// Get chunks that need to be loaded, then those prefetched ahead
+	t.trackTravelDirection()
 	chunksToLoad := data.CalculateChunksInBoundingArea(boundingArea, chunkSize, t.totalItems)
+	chunksToLoad = append(chunksToLoad, t.prefetchChunkStarts()...)
 
 	// Load chunks that aren't already loaded or loading
 	for _, chunkStart := range chunksToLoad {
@@ -2202,10 +3666,20 @@ func (t *Table) smartChunkManagement() tea.Cmd {
 				t.sortDirs,
 				t.filters,
 			)
+			request.FieldTypes = t.fieldTypes()
+			request.SortComparators = t.sortComparators()
+			request.FuzzyFields = copyStrings(t.fuzzyFields)
+			request.FilterFoldDiacritics = t.foldDiacritics
 
 			// Emit chunk loading started message for observability
 			cmds = append(cmds, core.ChunkLoadingStartedCmd(chunkStart, request))
 			cmds = append(cmds, t.dataSource.LoadChunk(request))
+			t.logChunkEvent(core.ChunkEvent{
+				Type:       core.ChunkEventLoadStarted,
+				StartIndex: chunkStart,
+				Size:       request.Count,
+				Request:    request,
+			})
 		}
 	}
 
@@ -2214,19 +3688,26 @@ func (t *Table) smartChunkManagement() tea.Cmd {
 		t.hasLoadingChunks = true
 		// Block scrolling if we're loading chunks that affect current viewport
 		t.canScroll = !t.isLoadingCriticalChunks()
+		cmds = append(cmds, t.startLoadingAnimation())
 	}
 
 	// Unload chunks outside bounding area
 	chunksToUnload := data.FindChunksToUnload(t.chunks, boundingArea, chunkSize)
 	for _, chunkStart := range chunksToUnload {
-		delete(t.chunks, chunkStart)
-		delete(t.chunkAccessTime, chunkStart)
-		cmds = append(cmds, core.ChunkUnloadedCmd(chunkStart))
+		if !t.isPrefetchedChunk(chunkStart) {
+			cmds = append(cmds, t.unloadChunk(chunkStart))
+		}
 	}
+	cmds = append(cmds, t.evictChunks())
 
 	return tea.Batch(cmds...)
 }
 
+// logChunkEvent reports a chunk lifecycle event to the configured ChunkLogger
+func (t *Table) logChunkEvent(event core.ChunkEvent) {
+	data.ReportChunkEvent(t.config.ViewportConfig.ChunkLogger, t.chunkLoadStarted, event)
+}
+
 // isLoadingCriticalChunks checks if we're loading chunks that affect the current viewport
 func (t *Table) isLoadingCriticalChunks() bool {
 	return data.IsLoadingCriticalChunks(t.viewport, t.config.ViewportConfig, t.loadingChunks)
@@ -2234,9 +3715,15 @@ func (t *Table) isLoadingCriticalChunks() bool {
 
 // updateVisibleItems updates the slice of items currently visible in the viewport
 func (t *Table) updateVisibleItems() {
+	// Viewports scrolled by lines may start closer to the end than a height
+	// of rows
+	viewportConfig := t.config.ViewportConfig
+	if t.scrollsByLines() && t.totalItems > t.viewport.ViewportStartIndex {
+		viewportConfig.Height = min(viewportConfig.Height, t.totalItems-t.viewport.ViewportStartIndex)
+	}
 	result := viewport.CalculateVisibleItemsFromChunks(
 		t.viewport,
-		t.config.ViewportConfig,
+		viewportConfig,
 		t.totalItems,
 		t.chunks,
 		t.ensureChunkLoadedImmediate,
@@ -2244,6 +3731,7 @@ func (t *Table) updateVisibleItems() {
 
 	t.visibleItems = result.Items
 	t.viewport = result.AdjustedViewport
+	t.countChunkHits()
 }
 
 // ensureChunkLoadedImmediate loads the chunk containing the given index immediately
@@ -2292,12 +3780,50 @@ func (t *Table) toggleItemSelection(id string) tea.Cmd {
 
 	if itemIndex >= 0 {
 		// Delegate to DataSource
-		return t.dataSource.SetSelected(itemIndex, !currentlySelected)
+		cmd := t.dataSource.SetSelected(itemIndex, !currentlySelected)
+		if evict := t.recordSelection(id, !currentlySelected); evict != nil {
+			cmd = tea.Sequence(cmd, evict)
+		}
+		return cmd
 	}
 
 	return nil
 }
 
+// advanceAfterSelect moves the cursor down after a selection when
+// Selection.MoveCursorOnSelect is enabled
+func (t *Table) advanceAfterSelect(selectCmd tea.Cmd) tea.Cmd {
+	selection := t.config.Selection
+	if !selection.MoveCursorOnSelect || selection.SelectOnCursorMove || t.viewport.CursorIndex >= t.totalItems-1 {
+		return selectCmd
+	}
+	return tea.Batch(selectCmd, t.handleCursorDown())
+}
+
+// selectOnCursorMove moves the selection along with the cursor when
+// Selection.SelectOnCursorMove is enabled
+func (t *Table) selectOnCursorMove(previousCursor int) tea.Cmd {
+	if !t.config.Selection.SelectOnCursorMove || t.config.SelectionMode == core.SelectionNone ||
+		t.dataSource == nil || t.viewport.CursorIndex == previousCursor || t.totalItems == 0 {
+		return nil
+	}
+
+	var cmds []tea.Cmd
+	if previousCursor >= 0 && previousCursor < t.totalItems {
+		cmds = append(cmds, t.dataSource.SetSelected(previousCursor, false))
+	}
+
+	// Group headers and subtotals are not selectable
+	if item, exists := t.getItemAtIndex(t.viewport.CursorIndex); exists {
+		if row, ok := item.Item.(core.TableRow); ok && row.Kind != core.TableRowData {
+			return tea.Batch(cmds...)
+		}
+	}
+	cmds = append(cmds, t.dataSource.SetSelected(t.viewport.CursorIndex, true))
+
+	return tea.Batch(cmds...)
+}
+
 // clearSelection clears all selections via DataSource
 func (t *Table) clearSelection() {
 	if t.dataSource == nil {
@@ -2317,27 +3843,15 @@ func (t *Table) unloadOldChunks() tea.Cmd {
 	// Calculate the bounds of chunks that should be kept
 	keepLowerBound, keepUpperBound := data.CalculateUnloadBounds(t.viewport, t.config.ViewportConfig)
 
-	var unloadedChunks []int
-
-	// Unload chunks outside the bounds
+	// Unload chunks outside the bounds, except those prefetched ahead
+	var cmds []tea.Cmd
 	for startIndex := range t.chunks {
-		if data.ShouldUnloadChunk(startIndex, keepLowerBound, keepUpperBound) {
-			delete(t.chunks, startIndex)
-			delete(t.chunkAccessTime, startIndex)
-			unloadedChunks = append(unloadedChunks, startIndex)
+		if data.ShouldUnloadChunk(startIndex, keepLowerBound, keepUpperBound) && !t.isPrefetchedChunk(startIndex) {
+			cmds = append(cmds, t.unloadChunk(startIndex))
 		}
 	}
 
-	// Return commands for unloaded chunks (for UI feedback)
-	var cmds []tea.Cmd
-	for _, chunkStart := range unloadedChunks {
-		cmds = append(cmds, core.ChunkUnloadedCmd(chunkStart))
-	}
-
-	if len(cmds) > 0 {
-		return tea.Batch(cmds...)
-	}
-	return nil
+	return tea.Batch(cmds...)
 }
 
 // refreshChunks reloads existing chunks to get updated selection state
@@ -2346,6 +3860,10 @@ func (t *Table) refreshChunks() tea.Cmd {
 		return nil
 	}
 
+	if t.groupSource != nil {
+		t.groupSource.invalidate()
+	}
+
 	var cmds []tea.Cmd
 
 	// Reload all currently loaded chunks to get updated selection state
@@ -2358,6 +3876,10 @@ func (t *Table) refreshChunks() tea.Cmd {
 			t.sortDirs,
 			t.filters,
 		)
+		request.FieldTypes = t.fieldTypes()
+		request.SortComparators = t.sortComparators()
+		request.FuzzyFields = copyStrings(t.fuzzyFields)
+		request.FilterFoldDiacritics = t.foldDiacritics
 
 		// Reload this chunk to get updated selection state
 		cmds = append(cmds, t.dataSource.LoadChunk(request))
@@ -2382,12 +3904,19 @@ func (t *Table) constructBottomBorder() string {
 	parts = append(parts, borderStyle.Render(t.config.Theme.BorderChars.BottomT))
 
 	// Column borders
+	lastVisible := t.lastVisibleColumn()
 	for i, col := range t.columns {
+		if t.hiddenColumns[i] {
+			continue
+		}
+
 		// Horizontal line for column width
 		parts = append(parts, borderStyle.Render(strings.Repeat(t.config.Theme.BorderChars.Horizontal, col.Width)))
 
 		// Column separator or right corner
-		if i < len(t.columns)-1 {
+		if t.isPinnedEdge(i) {
+			parts = append(parts, borderStyle.Render(pinnedBottomT))
+		} else if i != lastVisible {
 			parts = append(parts, borderStyle.Render(t.config.Theme.BorderChars.BottomT))
 		} else {
 			parts = append(parts, borderStyle.Render(t.config.Theme.BorderChars.BottomRight))
@@ -2413,12 +3942,19 @@ func (t *Table) constructTopBorder() string {
 	parts = append(parts, borderStyle.Render(t.config.Theme.BorderChars.TopT))
 
 	// Column borders
+	lastVisible := t.lastVisibleColumn()
 	for i, col := range t.columns {
+		if t.hiddenColumns[i] {
+			continue
+		}
+
 		// Horizontal line for column width
 		parts = append(parts, borderStyle.Render(strings.Repeat(t.config.Theme.BorderChars.Horizontal, col.Width)))
 
 		// Column separator or right corner
-		if i < len(t.columns)-1 {
+		if t.isPinnedEdge(i) {
+			parts = append(parts, borderStyle.Render(pinnedTopT))
+		} else if i != lastVisible {
 			parts = append(parts, borderStyle.Render(t.config.Theme.BorderChars.TopT))
 		} else {
 			parts = append(parts, borderStyle.Render(t.config.Theme.BorderChars.TopRight))
@@ -2444,12 +3980,19 @@ func (t *Table) constructHeaderSeparator() string {
 	parts = append(parts, borderStyle.Render(t.config.Theme.BorderChars.Cross))
 
 	// Column borders
+	lastVisible := t.lastVisibleColumn()
 	for i, col := range t.columns {
+		if t.hiddenColumns[i] {
+			continue
+		}
+
 		// Horizontal line for column width
 		parts = append(parts, borderStyle.Render(strings.Repeat(t.config.Theme.BorderChars.Horizontal, col.Width)))
 
 		// Column separator or right T-junction
-		if i < len(t.columns)-1 {
+		if t.isPinnedEdge(i) {
+			parts = append(parts, borderStyle.Render(pinnedCross))
+		} else if i != lastVisible {
 			parts = append(parts, borderStyle.Render(t.config.Theme.BorderChars.Cross))
 		} else {
 			parts = append(parts, borderStyle.Render(t.config.Theme.BorderChars.RightT))
@@ -2481,6 +4024,7 @@ func (t *Table) constructHeaderSeparator() string {
 // UpdateComponentConfig updates the component renderer configuration
 func (t *Table) UpdateComponentConfig(config ComponentTableRenderConfig) tea.Cmd {
 	t.componentRenderer.UpdateConfig(config)
+	t.InvalidateRenderCache()
 	return nil
 }
 
@@ -2577,32 +4121,7 @@ func (t *Table) applySimpleANSIAwareScroll(text string, scrollOffset int) string
 		}
 		return strings.Join(words[scrollOffset:], " ")
 	case "smart":
-		runes := []rune(plainText)
-		boundaries := []int{0}
-
-		for i, r := range runes {
-			if i > 0 {
-				prev := runes[i-1]
-				if strings.ContainsRune(".,;:!?-", prev) && r == ' ' {
-					boundaries = append(boundaries, i+1)
-				}
-				if r >= 'A' && r <= 'Z' && prev >= 'a' && prev <= 'z' {
-					boundaries = append(boundaries, i)
-				}
-				if prev == ' ' && r != ' ' {
-					boundaries = append(boundaries, i)
-				}
-			}
-		}
-
-		if scrollOffset >= len(boundaries) {
-			return ""
-		}
-		boundaryPos := boundaries[scrollOffset]
-		if boundaryPos >= len(runes) {
-			return ""
-		}
-		return string(runes[boundaryPos:])
+		return scrollToSmartBoundary(plainText, scrollOffset)
 	default: // "character"
 		runes := []rune(plainText)
 		if scrollOffset >= len(runes) {
@@ -2674,50 +4193,6 @@ func (t *Table) applyWordScrollWithANSI(text string, offset int) string {
 	return t.scrollToVisiblePosition(segments, targetPosition)
 }
 
-// applySmartScrollWithANSI scrolls to meaningful boundaries while preserving ANSI styling
-func (t *Table) applySmartScrollWithANSI(text string, offset int) string {
-	if offset <= 0 {
-		return text
-	}
-
-	// Parse text into segments
-	segments := t.parseTextWithANSI(text)
-
-	// Find smart boundaries in visible text only
-	smartBoundaries := t.findSmartBoundariesInSegments(segments)
-
-	if offset >= len(smartBoundaries) {
-		return "" // Scrolled past end
-	}
-
-	// Find the segment and position for the target boundary
-	targetPosition := smartBoundaries[offset]
-	return t.scrollToVisiblePosition(segments, targetPosition)
-}
-
-// findSmartBoundariesInSegments finds meaningful scroll positions in text
-func (t *Table) findSmartBoundariesInSegments(segments []TextSegment) []int {
-	var boundaries []int
-	boundaries = append(boundaries, 0) // Always start at beginning
-
-	for _, segment := range segments {
-		// Add boundary after punctuation
-		if strings.ContainsRune(".,;:!?-", segment.Rune) && segment.Next != nil && segment.Next.IsSpace {
-			boundaries = append(boundaries, segment.Next.Index)
-		}
-		// Add boundary at word starts after spaces
-		if segment.IsSpace && segment.Next != nil && !segment.Next.IsSpace {
-			boundaries = append(boundaries, segment.Next.Index)
-		}
-		// Add boundary at uppercase letters (camelCase support)
-		if segment.Rune >= 'A' && segment.Rune <= 'Z' && segment.Prev != nil && segment.Prev.IsLower {
-			boundaries = append(boundaries, segment.Index)
-		}
-	}
-
-	return boundaries
-}
-
 // scrollToVisiblePosition scrolls to a specific position in the text
 func (t *Table) scrollToVisiblePosition(segments []TextSegment, targetPosition int) string {
 	var result strings.Builder
@@ -3111,21 +4586,9 @@ func (t *Table) handleHorizontalScrollSmartLeft() tea.Cmd {
 	}
 	cleanText = strings.TrimSpace(cleanText)
 
-	// Find smart boundaries
-	segments := t.parseTextWithANSI(cleanText)
-	boundaries := t.findSmartBoundariesInSegments(segments)
-
-	// Find the previous boundary before current position
-	prevBoundary := 0 // Default to beginning
-	for _, boundary := range boundaries {
-		if boundary < currentOffset {
-			prevBoundary = boundary
-		} else {
-			break // We've passed the current position
-		}
-	}
-
-	t.horizontalScrollOffsets[t.currentColumn] = prevBoundary
+	// Smart offsets count boundaries, step back to the previous one
+	boundaries := smartScrollBoundaries(stripANSI(cleanText))
+	t.horizontalScrollOffsets[t.currentColumn] = min(currentOffset, len(boundaries)) - 1
 	return nil
 }
 
@@ -3160,38 +4623,49 @@ func (t *Table) handleHorizontalScrollSmartRight() tea.Cmd {
 	}
 	cleanText = strings.TrimSpace(cleanText)
 
-	// Get current character offset
+	// Smart offsets count boundaries, step on to the next one
 	currentOffset := t.horizontalScrollOffsets[t.currentColumn]
+	boundaries := smartScrollBoundaries(stripANSI(cleanText))
+	if currentOffset < len(boundaries)-1 {
+		t.horizontalScrollOffsets[t.currentColumn] = currentOffset + 1
+	}
 
-	// Find smart boundaries
-	segments := t.parseTextWithANSI(cleanText)
-	boundaries := t.findSmartBoundariesInSegments(segments)
+	return nil
+}
 
-	// Find the next boundary after current position
-	nextBoundary := -1
-	for _, boundary := range boundaries {
-		if boundary > currentOffset {
-			nextBoundary = boundary
-			break
+// handleTab moves forward or backward according to the configured TabBehavior
+func (t *Table) handleTab(forward bool) tea.Cmd {
+	switch t.config.KeyMap.TabBehavior {
+	case core.TabMoveRow:
+		if forward {
+			return t.handleCursorDown()
 		}
+		return t.handleCursorUp()
+	case core.TabMoveFocus:
+		if forward {
+			return core.FocusNextCmd()
+		}
+		return core.FocusPrevCmd()
+	default:
+		if len(t.columns) == 0 {
+			return nil
+		}
+		if forward {
+			return t.handleNextColumn()
+		}
+		return t.handlePrevColumn()
 	}
-
-	if nextBoundary > currentOffset {
-		t.horizontalScrollOffsets[t.currentColumn] = nextBoundary
-	}
-
-	return nil
 }
 
 // handleNextColumn switches to next column for scrolling
 func (t *Table) handleNextColumn() tea.Cmd {
-	t.currentColumn = (t.currentColumn + 1) % len(t.columns)
+	t.currentColumn = t.stepVisibleColumn(t.currentColumn, 1)
 	return nil
 }
 
 // handlePrevColumn switches to previous column for scrolling
 func (t *Table) handlePrevColumn() tea.Cmd {
-	t.currentColumn = (t.currentColumn - 1 + len(t.columns)) % len(t.columns)
+	t.currentColumn = t.stepVisibleColumn(t.currentColumn, -1)
 	return nil
 }
 
@@ -3216,7 +4690,7 @@ func (t *Table) handleResetScrolling() tea.Cmd {
 
 // getMaxScrollForColumn calculates the maximum scroll offset for a column
 func (t *Table) getMaxScrollForColumn(columnIndex int) int {
-	if columnIndex < 0 || columnIndex >= len(t.columns) {
+	if columnIndex < 0 || columnIndex >= len(t.columns) || t.columns[columnIndex].Pinned {
 		return 0
 	}
 
@@ -3267,7 +4741,7 @@ func (t *Table) getMaxScrollForColumn(columnIndex int) int {
 					}
 				}
 			case "smart":
-				boundaries := t.findSmartBoundariesInSegments(t.parseTextWithANSI(cleanText))
+				boundaries := smartScrollBoundaries(stripANSI(cleanText))
 				if len(boundaries) > 1 { // Only allow scrolling if there are multiple boundaries
 					smartScroll := len(boundaries) - 1
 					if smartScroll > maxScroll {
@@ -3340,12 +4814,14 @@ func (t *Table) applyHorizontalScrollWithScope(text string, columnIndex int, isC
 
 // TestHorizontalScrollRight is a temporary public method for testing horizontal scrolling
 func (t *Table) TestHorizontalScrollRight() tea.Cmd {
+	t.InvalidateRenderCache()
 	return t.handleHorizontalScrollRight()
 }
 
 // TestResetHorizontalScroll is a temporary public method for testing
 func (t *Table) TestResetHorizontalScroll() {
 	t.horizontalScrollOffsets = make(map[int]int)
+	t.InvalidateRenderCache()
 }
 
 // TestGetScrollState is a temporary public method for debugging
@@ -3356,6 +4832,7 @@ func (t *Table) TestGetScrollState() (map[int]int, string, bool, int) {
 // TestSetScrollMode is a temporary public method for testing
 func (t *Table) TestSetScrollMode(mode string) {
 	t.horizontalScrollMode = mode
+	t.InvalidateRenderCache()
 }
 
 // GetHorizontalScrollState returns the current horizontal scrolling state
@@ -3400,6 +4877,47 @@ func (t *Table) isActiveCell(columnIndex int, isCurrentRow bool) bool {
 	}
 }
 
+// isActiveCellOutlined reports whether the active cell at the given column should be
+// drawn with an outline instead of a background fill
+func (t *Table) isActiveCellOutlined(columnIndex int, isCurrentRow bool, width int) bool {
+	if !isCurrentRow || !t.config.ActiveCellIndicationEnabled {
+		return false
+	}
+	if t.config.ActiveCellBorderStyle == core.ActiveCellBorderNone {
+		return false
+	}
+	// The outline needs at least one column of content between its edges
+	if width < 3 {
+		return false
+	}
+	return t.isActiveCell(columnIndex, isCurrentRow)
+}
+
+// activeCellOutlineChars returns the left and right edge characters for the configured outline style
+func (t *Table) activeCellOutlineChars() (string, string) {
+	switch t.config.ActiveCellBorderStyle {
+	case core.ActiveCellBorderHeavy:
+		return "┃", "┃"
+	case core.ActiveCellBorderDouble:
+		return "║", "║"
+	default: // core.ActiveCellBorderLight
+		return "│", "│"
+	}
+}
+
+// applyActiveCellOutline wraps already styled content with the active cell outline edges.
+// The content must be two columns narrower than the cell so the outline stays within its bounds.
+func (t *Table) applyActiveCellOutline(content string, rowStyle lipgloss.Style) string {
+	left, right := t.activeCellOutlineChars()
+
+	// Edges keep the row background so the outline reads as part of the row, not a fill
+	edgeStyle := lipgloss.NewStyle().
+		Foreground(lipgloss.Color(t.config.ActiveCellBackgroundColor)).
+		Background(rowStyle.GetBackground())
+
+	return edgeStyle.Render(left) + content + edgeStyle.Render(right)
+}
+
 // applyActiveCellIndication applies the configured active cell indication to content
 func (t *Table) applyActiveCellIndication(content string, isActiveCell bool) string {
 	if !isActiveCell || !t.config.ActiveCellIndicationEnabled {
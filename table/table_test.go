@@ -1,15 +1,32 @@
 package table
 
 import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
 	"fmt"
+	"io"
+	"maps"
+	"os"
+	"path/filepath"
+	"reflect"
+	"regexp"
+	"slices"
+	"sort"
+	"strconv"
 	"strings"
 	"testing"
 	"time"
+	"unicode/utf8"
 
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
 	"github.com/davidroman0O/vtable/config"
 	"github.com/davidroman0O/vtable/core"
+	"github.com/davidroman0O/vtable/data"
+	"github.com/davidroman0O/vtable/render"
+	"github.com/davidroman0O/vtable/testutil"
+	"github.com/muesli/termenv"
 )
 
 // ================================
@@ -19,6 +36,8 @@ import (
 type TestDataSource struct {
 	data          []core.TableRow
 	selectedItems map[string]bool
+	disabledItems map[string]bool
+	erroredItems  map[string]error
 	totalItems    int
 }
 
@@ -49,6 +68,8 @@ func (ds *TestDataSource) LoadChunk(request core.DataRequest) tea.Cmd {
 				ID:       ds.data[i].ID,
 				Item:     ds.data[i],
 				Selected: ds.selectedItems[ds.data[i].ID],
+				Disabled: ds.disabledItems[ds.data[i].ID],
+				Error:    ds.erroredItems[ds.data[i].ID],
 				Metadata: core.NewTypedMetadata(),
 			})
 		}
@@ -126,6 +147,17 @@ func (ds *TestDataSource) SetSelectedByID(id string, selected bool) tea.Cmd {
 	}
 }
 
+// GetSelectedIDs returns the selected IDs in data order
+func (ds *TestDataSource) GetSelectedIDs() []string {
+	var ids []string
+	for _, row := range ds.data {
+		if ds.selectedItems[row.ID] {
+			ids = append(ids, row.ID)
+		}
+	}
+	return ids
+}
+
 // ================================
 // TEST HELPERS
 // ================================
@@ -147,6 +179,8 @@ func createTestTable(rows []core.TableRow) *Table {
 			ChunkSize: 10,
 		},
 		Theme: config.DefaultTheme(),
+		// Keep the theme as written whatever terminal runs the tests
+		ForceColorProfile: core.ColorProfileTrueColor,
 		KeyMap: core.NavigationKeyMap{
 			Up:        []string{"up", "k"},
 			Down:      []string{"down", "j"},
@@ -346,6 +380,35 @@ func TestTable_Selection(t *testing.T) {
 // CURSOR TESTS
 // ================================
 
+func TestTable_SelectAllToggle(t *testing.T) {
+	rows := createTestRows(3)
+	table := createTestTable(rows)
+	dataSource := table.dataSource.(*TestDataSource)
+
+	reload := func(cmd tea.Cmd) {
+		for _, msg := range collectMsgs(cmd) {
+			_, refresh := table.Update(msg)
+			for _, chunkMsg := range collectMsgs(refresh) {
+				table.Update(chunkMsg)
+			}
+		}
+	}
+
+	// First toggle selects everything
+	_, cmd := table.Update(core.SelectAllToggleCmd()())
+	reload(cmd)
+	if len(dataSource.selectedItems) != 3 {
+		t.Fatalf("Expected all 3 items selected, got %d", len(dataSource.selectedItems))
+	}
+
+	// Second toggle clears since all items are selected
+	_, cmd = table.Update(core.SelectAllToggleCmd()())
+	reload(cmd)
+	if len(dataSource.selectedItems) != 0 {
+		t.Errorf("Expected selection to be cleared, got %d", len(dataSource.selectedItems))
+	}
+}
+
 func TestTable_CursorPosition(t *testing.T) {
 	rows := createTestRows(5)
 	table := createTestTable(rows)
@@ -498,6 +561,190 @@ func TestTable_HeaderFormatters(t *testing.T) {
 // EDGE CASES TESTS
 // ================================
 
+func TestTable_ActiveCellOutline(t *testing.T) {
+	rows := createTestRows(2)
+	table := createTestTable(rows)
+	table.config.ActiveCellIndicationEnabled = true
+
+	before := strings.Split(table.View(), "\n")
+
+	table.Update(core.ActiveCellBorderStyleSetCmd(core.ActiveCellBorderLight)())
+	after := strings.Split(table.View(), "\n")
+
+	cursorRow := stripANSI(after[1])
+	if !strings.Contains(cursorRow, "│Item 1  │") {
+		t.Errorf("Expected outlined active cell in cursor row, got: %q", cursorRow)
+	}
+
+	// The outline must stay within the cell so the row keeps its width
+	if lipgloss.Width(after[1]) != lipgloss.Width(before[1]) {
+		t.Errorf("Outline changed row width: before %d, after %d", lipgloss.Width(before[1]), lipgloss.Width(after[1]))
+	}
+
+	// Rows without the cursor are not outlined
+	if stripANSI(after[2]) != stripANSI(before[2]) {
+		t.Errorf("Non-cursor row changed:\nbefore: %q\nafter:  %q", stripANSI(before[2]), stripANSI(after[2]))
+	}
+}
+
+func TestTable_StatusLine(t *testing.T) {
+	rows := createTestRows(2)
+	table := createTestTable(rows)
+
+	rowWidth := lipgloss.Width(strings.Split(table.View(), "\n")[1])
+
+	table.Update(table.SetStatusLine("2 items")())
+	lines := strings.Split(table.View(), "\n")
+	status := lines[len(lines)-1]
+	if !strings.HasPrefix(stripANSI(status), "2 items") {
+		t.Errorf("Expected status line at the bottom, got: %q", stripANSI(status))
+	}
+	if lipgloss.Width(status) != rowWidth {
+		t.Errorf("Status line width %d does not match row width %d", lipgloss.Width(status), rowWidth)
+	}
+
+	// Long text is truncated to the frame width by default
+	long := strings.Repeat("status ", 20)
+	table.Update(table.SetStatusLine(long)())
+	lines = strings.Split(table.View(), "\n")
+	if w := lipgloss.Width(lines[len(lines)-1]); w != rowWidth {
+		t.Errorf("Truncated status line width %d does not match row width %d", w, rowWidth)
+	}
+
+	// With wrapping enabled it spans several lines of the same width
+	table.config.StatusLineWrap = true
+	wrapped := strings.Split(table.View(), "\n")
+	if len(wrapped) <= len(lines) {
+		t.Errorf("Expected wrapped status line to add lines, got %d vs %d", len(wrapped), len(lines))
+	}
+	for _, line := range wrapped[len(lines)-1:] {
+		if lipgloss.Width(line) != rowWidth {
+			t.Errorf("Wrapped status line width %d does not match row width %d", lipgloss.Width(line), rowWidth)
+		}
+	}
+
+	// Clearing the text hides the status line
+	table.Update(table.SetStatusLine("")())
+	if got := strings.Split(table.View(), "\n"); len(got) != len(lines)-1 {
+		t.Errorf("Expected status line to be hidden, got %d lines", len(got))
+	}
+}
+
+func TestTable_ChunkLogger(t *testing.T) {
+	rows := createTestRows(25)
+
+	var events []core.ChunkEvent
+	cfg := config.DefaultTableConfig()
+	cfg.Columns = []core.TableColumn{{Title: "Name", Field: "name", Width: 10}}
+	cfg.ViewportConfig.ChunkSize = 10
+	cfg.ViewportConfig.ChunkLogger = func(event core.ChunkEvent) {
+		events = append(events, event)
+	}
+
+	dataSource := NewTestDataSource(rows)
+	table := NewTable(cfg, dataSource)
+
+	// Loading the total triggers chunk requests for the bounding area
+	_, cmd := table.Update(dataSource.GetTotal()())
+	if len(events) == 0 || events[0].Type != core.ChunkEventLoadStarted {
+		t.Fatalf("Expected load start events after total, got %v", events)
+	}
+	started := len(events)
+
+	// Deliver the requested chunks
+	for _, msg := range collectMsgs(cmd) {
+		if chunkMsg, ok := msg.(core.DataChunkLoadedMsg); ok {
+			table.Update(chunkMsg)
+		}
+	}
+
+	completed := 0
+	for _, event := range events[started:] {
+		if event.Type == core.ChunkEventLoadCompleted {
+			completed++
+			if event.Size == 0 {
+				t.Errorf("Expected completed event to report loaded item count")
+			}
+		}
+	}
+	if completed != started {
+		t.Errorf("Expected %d completed events, got %d", started, completed)
+	}
+
+	table.Update(core.DataChunkErrorMsg{StartIndex: 20, Error: fmt.Errorf("boom"), Request: core.DataRequest{Start: 20, Count: 10}})
+	last := events[len(events)-1]
+	if last.Type != core.ChunkEventLoadFailed || last.Error == nil {
+		t.Errorf("Expected load failed event with error, got %+v", last)
+	}
+}
+
+// collectMsgs runs a (possibly batched) command and returns the resulting messages
+func collectMsgs(cmd tea.Cmd) []tea.Msg {
+	if cmd == nil {
+		return nil
+	}
+	msg := cmd()
+	if batch, ok := msg.(tea.BatchMsg); ok {
+		var msgs []tea.Msg
+		for _, c := range batch {
+			msgs = append(msgs, collectMsgs(c)...)
+		}
+		return msgs
+	}
+	// tea.Sequence wraps its commands in an unexported slice type
+	if value := reflect.ValueOf(msg); value.Kind() == reflect.Slice && value.Type().Elem() == reflect.TypeOf(tea.Cmd(nil)) {
+		var msgs []tea.Msg
+		for i := 0; i < value.Len(); i++ {
+			msgs = append(msgs, collectMsgs(value.Index(i).Interface().(tea.Cmd))...)
+		}
+		return msgs
+	}
+	return []tea.Msg{msg}
+}
+
+func TestTable_DetectColumnTypes(t *testing.T) {
+	rows := createTestRows(5)
+	rows[2].Cells[2] = "2024-01-15"
+	table := createTestTable(rows)
+
+	_, cmd := table.Update(core.ColumnTypesDetectCmd(0)())
+	msgs := collectMsgs(cmd)
+	if len(msgs) != 1 {
+		t.Fatalf("Expected a single detection message, got %v", msgs)
+	}
+	if _, ok := msgs[0].(core.ColumnTypesDetectedMsg); !ok {
+		t.Fatalf("Expected ColumnTypesDetectedMsg, got %T", msgs[0])
+	}
+
+	// Names are text, values are ints, and one date among statuses demotes to string
+	expected := []core.ColumnType{core.ColumnString, core.ColumnInt, core.ColumnString}
+	types := table.GetColumnTypes()
+	for i, want := range expected {
+		if types[i] != want {
+			t.Errorf("Column %d: expected %s, got %s", i, want, types[i])
+		}
+	}
+
+	if fieldTypes := table.fieldTypes(); fieldTypes["value"] != core.ColumnInt || len(fieldTypes) != 1 {
+		t.Errorf("Expected only the value field in request field types, got %v", fieldTypes)
+	}
+
+	if core.CompareCells("9", "10", core.ColumnInt) >= 0 {
+		t.Errorf("Expected numeric comparison for int columns")
+	}
+
+	// A column configured with a type keeps it
+	pumpMsgs(table, core.ColumnSetCmd([]core.TableColumn{
+		{Title: "Name", Field: "name", Width: 10},
+		{Title: "Value", Field: "value", Width: 8, Type: core.ColumnPercent},
+		{Title: "Status", Field: "status", Width: 10},
+	}))
+	table.Update(core.ColumnTypesDetectCmd(0)())
+	if types := table.GetColumnTypes(); types[1] != core.ColumnPercent {
+		t.Errorf("Expected the configured percent type kept, got %s", types[1])
+	}
+}
+
 func TestTable_EmptyData(t *testing.T) {
 	table := createTestTable([]core.TableRow{})
 
@@ -775,3 +1022,3945 @@ func BenchmarkTable_ComponentRenderer(b *testing.B) {
 		table.View()
 	}
 }
+
+// BenchmarkTable_RenderCache calls View repeatedly on an idle table, where
+// the render cache spares the formatting of every row
+func BenchmarkTable_RenderCache(b *testing.B) {
+	for _, enabled := range []bool{false, true} {
+		b.Run(fmt.Sprintf("enabled=%v", enabled), func(b *testing.B) {
+			table := createTestTable(createTestRows(100))
+			table.config.RenderCacheEnabled = enabled
+			table.Update(table.SetCellFormatter(0, CreateSimpleCellFormatter(func(cellValue string) string {
+				return "• " + cellValue
+			}))())
+
+			b.ReportAllocs()
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				table.View()
+			}
+		})
+	}
+}
+
+// GroupingTestDataSource groups its rows in memory with data.GroupRows
+type GroupingTestDataSource struct {
+	*TestDataSource
+	rows    []core.TableRow
+	columns []core.TableColumn
+}
+
+func (ds *GroupingTestDataSource) SetGrouping(groupBy []string, collapsed map[string]bool) {
+	ds.data = data.GroupRows(ds.rows, ds.columns, groupBy, collapsed)
+	ds.totalItems = len(ds.data)
+}
+
+// pumpMsgs feeds the messages produced by cmd back into the table until it settles
+func pumpMsgs(table *Table, cmd tea.Cmd) {
+	for i := 0; i < 10 && cmd != nil; i++ {
+		var cmds []tea.Cmd
+		for _, msg := range collectMsgs(cmd) {
+			_, next := table.Update(msg)
+			cmds = append(cmds, next)
+		}
+		cmd = tea.Batch(cmds...)
+	}
+}
+
+func TestTable_GroupBySubtotals(t *testing.T) {
+	sum := core.AggregateUsing(func() core.Aggregator { return &core.SumAggregator{} })
+
+	columns := []core.TableColumn{
+		{Title: "Region", Field: "region", Width: 14},
+		{Title: "Amount", Field: "amount", Width: 8, Alignment: core.AlignRight, Aggregate: sum},
+	}
+	rows := []core.TableRow{
+		{ID: "1", Cells: []string{"US", "5"}},
+		{ID: "2", Cells: []string{"EU", "10"}},
+		{ID: "3", Cells: []string{"US", "1"}},
+		{ID: "4", Cells: []string{"EU", "20"}},
+	}
+
+	cfg := config.DefaultTableConfig()
+	cfg.Columns = columns
+	cfg.GroupBy = []string{"region"}
+	cfg.ViewportConfig.Height = 10
+	cfg.ViewportConfig.ChunkSize = 20
+
+	dataSource := &GroupingTestDataSource{TestDataSource: NewTestDataSource(nil), rows: rows, columns: columns}
+	table := NewTable(cfg, dataSource)
+	pumpMsgs(table, table.Init())
+
+	// 2 groups x (header + 2 rows + subtotal) + grand total
+	if table.GetTotalItems() != 9 {
+		t.Fatalf("Expected 9 rows with groups and totals, got %d", table.GetTotalItems())
+	}
+
+	view := stripANSI(table.View())
+	for _, want := range []string{"▼ EU (2)", "▼ US (2)", "Subtotal", "30", "6", "Total", "36"} {
+		if !strings.Contains(view, want) {
+			t.Errorf("Expected %q in grouped view:\n%s", want, view)
+		}
+	}
+
+	// Enter on the EU header collapses it, leaving its header and subtotal
+	pumpMsgs(table, core.SelectCurrentCmd())
+	if !table.IsGroupCollapsed("EU") {
+		t.Fatal("Expected EU group to be collapsed")
+	}
+	if table.GetTotalItems() != 7 {
+		t.Errorf("Expected 7 rows after collapsing EU, got %d", table.GetTotalItems())
+	}
+	if view := stripANSI(table.View()); !strings.Contains(view, "▶ EU (2)") || !strings.Contains(view, "30") {
+		t.Errorf("Expected collapsed EU header with subtotal:\n%s", view)
+	}
+	if table.GetSelectionCount() != 0 {
+		t.Errorf("Group header should not be selectable, got %d selected", table.GetSelectionCount())
+	}
+}
+
+func TestTable_GroupByFieldsNested(t *testing.T) {
+	columns := []core.TableColumn{
+		{Title: "Region", Field: "region", Width: 16},
+		{Title: "Category", Field: "category", Width: 10},
+		{Title: "Item", Field: "item", Width: 8},
+	}
+	rows := []core.TableRow{
+		{ID: "1", Cells: []string{"US", "Books", "b1"}},
+		{ID: "2", Cells: []string{"EU", "Games", "g1"}},
+		{ID: "3", Cells: []string{"EU", "Books", "b2"}},
+		{ID: "4", Cells: []string{"US", "Books", "b3"}},
+		{ID: "5", Cells: []string{"EU", "Books", "b4"}},
+	}
+
+	cfg := config.DefaultTableConfig()
+	cfg.Columns = columns
+	cfg.GroupByFields = []string{"region", "category"}
+	cfg.SelectionMode = core.SelectionMultiple
+	cfg.ViewportConfig.Height = 12
+	cfg.ViewportConfig.ChunkSize = 20
+
+	// A plain data source: the table groups the rows itself
+	table := NewTable(cfg, NewTestDataSource(rows))
+	pumpMsgs(table, table.Init())
+
+	// EU, EU/Books, 2 rows, EU/Games, 1 row, US, US/Books, 2 rows
+	if table.GetTotalItems() != 10 {
+		t.Fatalf("Expected 10 grouped rows, got %d", table.GetTotalItems())
+	}
+	view := stripANSI(table.View())
+	for _, want := range []string{"▼ EU (3)", "▼ Books (2)", "▼ Games (1)", "▼ US (2)"} {
+		if !strings.Contains(view, want) {
+			t.Errorf("Expected %q in grouped view:\n%s", want, view)
+		}
+	}
+
+	// Selecting a data row selects it by ID in the data source
+	pumpMsgs(table, core.CursorDownCmd())
+	pumpMsgs(table, core.CursorDownCmd())
+	pumpMsgs(table, core.SelectCurrentCmd())
+	if ids := table.GetSelectedIDs(); len(ids) != 1 || ids[0] != "3" {
+		t.Errorf("Expected row 3 selected, got %v", ids)
+	}
+
+	// Enter on the nested EU/Books header collapses only that group
+	pumpMsgs(table, core.CursorUpCmd())
+	var toggled []core.GroupToggledMsg
+	cmd := core.SelectCurrentCmd()
+	for i := 0; i < 10 && cmd != nil; i++ {
+		var cmds []tea.Cmd
+		for _, msg := range collectMsgs(cmd) {
+			if msg, ok := msg.(core.GroupToggledMsg); ok {
+				toggled = append(toggled, msg)
+			}
+			_, next := table.Update(msg)
+			cmds = append(cmds, next)
+		}
+		cmd = tea.Batch(cmds...)
+	}
+
+	if len(toggled) != 1 || toggled[0] != (core.GroupToggledMsg{Key: "EU/Books", Collapsed: true}) {
+		t.Fatalf("Expected one GroupToggledMsg for EU/Books, got %+v", toggled)
+	}
+	if table.GetTotalItems() != 8 {
+		t.Errorf("Expected collapsed rows to leave the data, got %d rows", table.GetTotalItems())
+	}
+	view = stripANSI(table.View())
+	if !strings.Contains(view, "▶ Books (2)") || strings.Contains(view, "b2") || !strings.Contains(view, "g1") {
+		t.Errorf("Expected EU/Books collapsed and EU/Games open:\n%s", view)
+	}
+
+	// The rows after the collapsed group are the next stops for the cursor
+	pumpMsgs(table, core.CursorDownCmd())
+	pumpMsgs(table, core.CursorDownCmd())
+	if item, ok := table.getItemAtIndex(table.GetState().CursorIndex); !ok || item.ID != "2" {
+		t.Errorf("Expected the cursor on g1 after the collapsed group, got %+v", item)
+	}
+
+	// Removing the grouping restores the plain rows
+	pumpMsgs(table, table.SetGroupByFields(nil))
+	if table.GetTotalItems() != len(rows) {
+		t.Errorf("Expected %d rows without grouping, got %d", len(rows), table.GetTotalItems())
+	}
+}
+
+func TestTable_CursorFallbackReverse(t *testing.T) {
+	table := createTestTable(createTestRows(3))
+
+	// Themed cursor styles are left untouched
+	table.config.CursorFallbackReverse = true
+	if table.cursorStyle().GetReverse() {
+		t.Error("Expected colored cursor style to keep its own colors")
+	}
+
+	// A cursor style without colors falls back to reverse video
+	table.config.Theme.CursorStyle = lipgloss.NewStyle().Bold(true)
+	table.config.Theme.FullRowCursorStyle = lipgloss.NewStyle()
+	if !table.cursorStyle().GetReverse() || !table.fullRowCursorStyle().GetReverse() {
+		t.Error("Expected reverse video fallback for uncolored cursor styles")
+	}
+	if !table.cursorStyle().GetBold() {
+		t.Error("Fallback should preserve the existing cursor attributes")
+	}
+
+	// The fallback can be disabled
+	table.config.CursorFallbackReverse = false
+	if table.cursorStyle().GetReverse() {
+		t.Error("Expected no fallback when CursorFallbackReverse is disabled")
+	}
+}
+
+func TestController_DrivesTableSynchronously(t *testing.T) {
+	cfg := config.DefaultTableConfig()
+	cfg.Columns = []core.TableColumn{{Title: "Name", Field: "name", Width: 10}}
+	cfg.SelectionMode = core.SelectionMultiple
+	cfg.ViewportConfig.Height = 5
+	cfg.ViewportConfig.ChunkSize = 10
+
+	ctrl := NewController(NewTable(cfg, NewTestDataSource(createTestRows(30))))
+
+	if ctrl.Table().GetTotalItems() != 30 {
+		t.Fatalf("Expected total to be loaded, got %d", ctrl.Table().GetTotalItems())
+	}
+
+	ctrl.MoveDown()
+	ctrl.MoveDown()
+	ctrl.MoveUp()
+	if idx := ctrl.Table().GetState().CursorIndex; idx != 1 {
+		t.Errorf("Expected cursor at 1, got %d", idx)
+	}
+
+	ctrl.ToggleSelect()
+	if ctrl.Table().GetSelectionCount() != 1 {
+		t.Errorf("Expected 1 selected row, got %d", ctrl.Table().GetSelectionCount())
+	}
+
+	// Jumping past the loaded chunk loads it before returning
+	ctrl.JumpTo(25)
+	if !ctrl.WaitForIdle() {
+		t.Fatal("Expected controller to be idle")
+	}
+	if !strings.Contains(stripANSI(ctrl.Render()), "Item 26") {
+		t.Errorf("Expected row 26 to be rendered after jump:\n%s", stripANSI(ctrl.Render()))
+	}
+}
+
+func TestController_SkipsTimerCommands(t *testing.T) {
+	ctrl := NewController(createTestTable(createTestRows(10)))
+
+	start := time.Now()
+	ctrl.Do(tea.Batch(
+		core.DelayCmd(time.Hour, core.CursorDownMsg{}),
+		core.LoadingTickCmd(time.Hour),
+		core.CursorDownCmd(),
+	))
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Fatalf("Expected timer commands to be skipped, waited %v", elapsed)
+	}
+	if idx := ctrl.Table().GetState().CursorIndex; idx != 1 {
+		t.Errorf("Expected only the immediate command to move the cursor, got %d", idx)
+	}
+}
+
+func TestController_RunsSequencesInOrder(t *testing.T) {
+	table := createTestTable(createTestRows(10))
+	table.config.MaxSelections = 2
+	ctrl := NewController(table)
+
+	// The eviction follows each selection in a sequence, so the oldest goes
+	for i := 0; i < 3; i++ {
+		ctrl.JumpTo(i)
+		ctrl.ToggleSelect()
+	}
+	source := table.dataSource.(*TestDataSource)
+	if got := strings.Join(source.GetSelectedIDs(), ","); got != "row-1,row-2" {
+		t.Errorf("Expected the two newest selections kept, got %q", got)
+	}
+}
+
+func TestTable_TabBehavior(t *testing.T) {
+	table := createTestTable(createTestRows(5))
+	table.Focus()
+	table.config.KeyMap.Tab = []string{"tab"}
+	table.config.KeyMap.ShiftTab = []string{"shift+tab"}
+
+	tab := tea.KeyMsg{Type: tea.KeyTab}
+	shiftTab := tea.KeyMsg{Type: tea.KeyShiftTab}
+
+	// Default behavior moves the active column, wrapping around
+	table.Update(tab)
+	if _, _, col, _ := table.GetHorizontalScrollState(); col != 1 {
+		t.Errorf("Expected active column 1 after Tab, got %d", col)
+	}
+	table.Update(shiftTab)
+	table.Update(shiftTab)
+	if _, _, col, _ := table.GetHorizontalScrollState(); col != 2 {
+		t.Errorf("Expected active column to wrap to 2, got %d", col)
+	}
+
+	// Row behavior moves the cursor
+	table.config.KeyMap.TabBehavior = core.TabMoveRow
+	table.Update(tab)
+	if idx := table.GetState().CursorIndex; idx != 1 {
+		t.Errorf("Expected cursor at 1 after Tab, got %d", idx)
+	}
+
+	// Focus behavior asks the application to move focus
+	table.config.KeyMap.TabBehavior = core.TabMoveFocus
+	_, cmd := table.Update(tab)
+	if cmd == nil {
+		t.Fatal("Expected a command for Tab with TabMoveFocus")
+	}
+	if _, ok := cmd().(core.FocusNextMsg); !ok {
+		t.Error("Expected FocusNextMsg for Tab")
+	}
+	_, cmd = table.Update(shiftTab)
+	if _, ok := cmd().(core.FocusPrevMsg); !ok {
+		t.Error("Expected FocusPrevMsg for ShiftTab")
+	}
+}
+
+func TestTable_EmptyAndSingleRowNavigation(t *testing.T) {
+	// Empty dataset: nothing rendered, navigation reports boundaries
+	empty := createTestTable(nil)
+	if view := empty.View(); view != "No data available" {
+		t.Errorf("Expected empty placeholder, got %q", view)
+	}
+	for _, key := range []tea.KeyType{tea.KeyUp, tea.KeyDown, tea.KeyPgUp, tea.KeyPgDown} {
+		empty.Update(tea.KeyMsg{Type: key})
+	}
+	if state := empty.GetState(); state.CursorIndex != 0 || state.ViewportStartIndex != 0 {
+		t.Errorf("Expected cursor state to stay at 0 when empty, got %+v", state)
+	}
+
+	// Single row: cursor pinned to index 0 even with an out-of-range initial index
+	rows := createTestRows(1)
+	cfg := config.DefaultTableConfig()
+	cfg.Columns = []core.TableColumn{{Title: "Name", Field: "name", Width: 10}}
+	cfg.ViewportConfig.InitialIndex = 5
+	single := NewController(NewTable(cfg, NewTestDataSource(rows)))
+	single.Table().Focus()
+
+	if state := single.Table().GetState(); state.CursorIndex != 0 || state.CursorViewportIndex != 0 {
+		t.Fatalf("Expected cursor pinned to 0, got %+v", state)
+	}
+	if state := single.Table().GetState(); !state.AtDatasetStart || !state.AtDatasetEnd {
+		t.Errorf("Expected both dataset boundary flags for a single row, got %+v", state)
+	}
+
+	_, cmd := single.Table().Update(tea.KeyMsg{Type: tea.KeyDown})
+	if msg, ok := cmd().(core.BoundaryReachedMsg); !ok || msg.AtStart {
+		t.Errorf("Expected end boundary feedback, got %#v", msg)
+	}
+	_, cmd = single.Table().Update(tea.KeyMsg{Type: tea.KeyUp})
+	if msg, ok := cmd().(core.BoundaryReachedMsg); !ok || !msg.AtStart {
+		t.Errorf("Expected start boundary feedback, got %#v", msg)
+	}
+	if !strings.Contains(stripANSI(single.Render()), "►") {
+		t.Error("Expected cursor to be rendered on the single row")
+	}
+
+	// Shrinking to zero rows resets the cursor
+	single.Send(core.DataTotalUpdateMsg{Total: 0})
+	if state := single.Table().GetState(); state.CursorIndex != 0 || state.CursorViewportIndex != 0 {
+		t.Errorf("Expected cursor reset after dataset emptied, got %+v", state)
+	}
+}
+
+func TestTable_CycleSort(t *testing.T) {
+	table := createTestTable(createTestRows(3))
+	table.columns[1].SortCycle = []string{"desc", "asc"}
+
+	expectSort := func(step string, fields, dirs []string) {
+		t.Helper()
+		gotFields, gotDirs := table.GetSort()
+		if strings.Join(gotFields, ",") != strings.Join(fields, ",") || strings.Join(gotDirs, ",") != strings.Join(dirs, ",") {
+			t.Errorf("%s: expected %v %v, got %v %v", step, fields, dirs, gotFields, gotDirs)
+		}
+	}
+
+	// Default cycle: asc -> desc -> off
+	_, cmd := table.Update(core.CycleSortCmd("name")())
+	expectSort("first cycle", []string{"name"}, []string{"asc"})
+
+	var changed *core.SortChangedMsg
+	for _, msg := range collectMsgs(cmd) {
+		if m, ok := msg.(core.SortChangedMsg); ok {
+			changed = &m
+		}
+	}
+	if changed == nil || len(changed.Fields) != 1 || changed.Directions[0] != "asc" {
+		t.Errorf("Expected SortChangedMsg with name asc, got %+v", changed)
+	}
+
+	table.Update(core.CycleSortCmd("name")())
+	expectSort("second cycle", []string{"name"}, []string{"desc"})
+	table.Update(core.CycleSortCmd("name")())
+	expectSort("third cycle", nil, nil)
+
+	// Custom cycle starts descending and never turns off
+	table.Update(core.CycleSortCmd("value")())
+	expectSort("custom first", []string{"value"}, []string{"desc"})
+	table.Update(core.CycleSortCmd("value")())
+	table.Update(core.CycleSortCmd("value")())
+	expectSort("custom wrap", []string{"value"}, []string{"desc"})
+}
+
+func TestTable_ColumnFilterInput(t *testing.T) {
+	table := createTestTable(createTestRows(3))
+	table.Focus()
+	table.columns[1].Type = core.ColumnInt
+
+	typeText := func(text string) {
+		for _, r := range text {
+			table.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{r}})
+		}
+	}
+
+	// Invalid range input keeps the editor open with an error
+	table.Update(table.EditColumnFilter(1)())
+	typeText(">abc")
+	table.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	if !table.IsEditingFilter() || table.GetFilterError() == nil {
+		t.Fatal("Expected invalid input to keep the editor open with an error")
+	}
+	if header := stripANSI(strings.Split(table.View(), "\n")[0]); !strings.Contains(header, ">abc") {
+		t.Errorf("Expected filter input inline in the header, got %q", header)
+	}
+
+	// Navigation keys are captured while editing
+	table.Update(tea.KeyMsg{Type: tea.KeyDown})
+	if table.GetState().CursorIndex != 0 {
+		t.Error("Expected navigation to be captured by the filter input")
+	}
+
+	for i := 0; i < 3; i++ {
+		table.Update(tea.KeyMsg{Type: tea.KeyBackspace})
+	}
+	typeText("10")
+	table.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	if table.IsEditingFilter() {
+		t.Fatal("Expected valid input to close the editor")
+	}
+	rangeFilter, ok := table.filters["value"].(core.RangeFilter)
+	if !ok || !rangeFilter.Matches("20") || rangeFilter.Matches("10") {
+		t.Errorf("Expected >10 range filter on value, got %#v", table.filters["value"])
+	}
+
+	// String columns filter by plain text
+	table.Update(table.EditColumnFilter(0)())
+	typeText("Item")
+	table.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	if table.filters["name"] != "Item" {
+		t.Errorf("Expected text filter on name, got %#v", table.filters["name"])
+	}
+}
+
+func TestTable_OverflowStrategy(t *testing.T) {
+	table := createTestTable(createTestRows(3))
+	table.config.Columns[0].Priority = 3
+	table.config.Columns[1].Priority = 1
+	table.config.Columns[2].Priority = 2
+
+	rowWidth := func() int {
+		return lipgloss.Width(strings.Split(table.View(), "\n")[1])
+	}
+	fullWidth := rowWidth()
+
+	// Scroll keeps declared widths
+	table.Update(tea.WindowSizeMsg{Width: 30, Height: 20})
+	if rowWidth() != fullWidth {
+		t.Errorf("Expected scroll strategy to keep width %d, got %d", fullWidth, rowWidth())
+	}
+
+	// Shrink narrows columns to the available width
+	table.Update(table.SetOverflowStrategy(core.OverflowShrink)())
+	if rowWidth() != 30 {
+		t.Errorf("Expected shrunk rows of width 30, got %d", rowWidth())
+	}
+	if table.config.Columns[0].Width != 10 {
+		t.Error("Shrinking must not change the configured column widths")
+	}
+
+	// HidePriority drops the lowest priority column and reports it in the header
+	table.Update(table.SetOverflowStrategy(core.OverflowHidePriority)())
+	if hidden := table.GetHiddenColumns(); len(hidden) != 1 || hidden[0] != 1 {
+		t.Errorf("Expected the Value column to be hidden, got %v", hidden)
+	}
+	view := stripANSI(table.View())
+	if strings.Contains(view, "Value") || !strings.Contains(view, "+1") {
+		t.Errorf("Expected Value hidden with a +1 indicator:\n%s", view)
+	}
+	if rowWidth() > 30 {
+		t.Errorf("Expected rows to fit 30 columns, got %d", rowWidth())
+	}
+
+	// Growing the terminal brings the column back
+	table.Update(tea.WindowSizeMsg{Width: 100, Height: 20})
+	if len(table.GetHiddenColumns()) != 0 || rowWidth() != fullWidth {
+		t.Errorf("Expected all columns restored, hidden %v width %d", table.GetHiddenColumns(), rowWidth())
+	}
+}
+
+func TestTable_ResponsiveCard(t *testing.T) {
+	table := createTestTable(createTestRows(2))
+	table.config.Columns[0].Priority = 3
+	table.config.Columns[1].Priority = 1
+	table.config.Columns[2].Priority = 2
+
+	table.Update(table.SetResponsiveCard(true)())
+	table.Update(tea.WindowSizeMsg{Width: 30, Height: 20})
+
+	lines := strings.Split(stripANSI(table.View()), "\n")
+	// Header plus two lines per row
+	if len(lines) != 5 {
+		t.Fatalf("Expected 5 lines in card layout, got %d:\n%s", len(lines), strings.Join(lines, "\n"))
+	}
+	if !strings.Contains(lines[1], "Item 1") || !strings.Contains(lines[2], "Value: 0") {
+		t.Errorf("Expected primary and detail lines for the first row, got:\n%s\n%s", lines[1], lines[2])
+	}
+	if lipgloss.Width(lines[1]) != lipgloss.Width(lines[2]) {
+		t.Errorf("Detail line width %d does not match row width %d", lipgloss.Width(lines[2]), lipgloss.Width(lines[1]))
+	}
+
+	// Wide terminals use the regular layout
+	table.Update(tea.WindowSizeMsg{Width: 100, Height: 20})
+	if lines := strings.Split(table.View(), "\n"); len(lines) != 3 {
+		t.Errorf("Expected regular layout on wide terminals, got %d lines", len(lines))
+	}
+}
+
+func TestTable_ResponsiveCardFitsHeight(t *testing.T) {
+	table := createTestTable(createTestRows(10))
+	table.config.Columns[1].Priority = 1
+	table.Update(table.SetResponsiveCard(true)())
+	table.Update(tea.WindowSizeMsg{Width: 30, Height: 20})
+
+	// Card rows take two lines each, so the 5 line viewport holds two and a half
+	lines := strings.Split(stripANSI(table.View()), "\n")
+	if len(lines) != 6 {
+		t.Fatalf("Expected the header and 5 row lines, got %d:\n%s", len(lines), strings.Join(lines, "\n"))
+	}
+	if visible := table.VisibleRows(); len(visible) != 3 {
+		t.Errorf("Expected 3 visible card rows, got %d", len(visible))
+	}
+
+	// Moving down keeps the cursor row and its detail line in view
+	for i := 0; i < 4; i++ {
+		pumpMsgs(table, core.CursorDownCmd())
+	}
+	lines = strings.Split(stripANSI(table.View()), "\n")
+	if len(lines) != 6 || !strings.Contains(lines[3], "Item 5") || !strings.Contains(lines[4], "Status: Status1") {
+		t.Errorf("Expected the cursor row and its detail on the second card, got:\n%s", strings.Join(lines, "\n"))
+	}
+	if state := table.GetState(); state.ViewportStartIndex != 3 || state.CursorViewportIndex != 1 {
+		t.Errorf("Expected the viewport to scroll by lines, got %+v", state)
+	}
+}
+
+func TestTable_WrappedRowsScrollByLines(t *testing.T) {
+	rows := createTestRows(20)
+	for i := range rows {
+		rows[i].Cells[0] = fmt.Sprintf("Row %02d aaaaaaa bbbbbbb", i+1)
+	}
+	table := createTestTable(rows)
+	table.config.ViewportConfig.Height = 7
+	table.config.ViewportConfig.BottomThreshold = 1
+	table.config.Columns[0].WrapText = true
+	table.Update(core.ColumnSetCmd(table.config.Columns)())
+
+	// Each row wraps to 3 lines, so 7 lines hold two rows and a line of a third
+	for i := 0; i < 5; i++ {
+		pumpMsgs(table, core.CursorDownCmd())
+
+		state := table.GetState()
+		if state.CursorIndex != i+1 {
+			t.Fatalf("Expected the cursor on row %d, got %+v", i+1, state)
+		}
+		// The cursor row and the threshold row after it fit the 7 lines
+		if state.CursorViewportIndex > 1 {
+			t.Fatalf("Expected at most one row above the cursor, got %+v", state)
+		}
+		view := stripANSI(table.View())
+		if !strings.Contains(view, fmt.Sprintf("Row %02d", i+2)) || !strings.Contains(view, fmt.Sprintf("Row %02d", i+3)) {
+			t.Fatalf("Expected the cursor row and the next one in view:\n%s", view)
+		}
+		if got := len(strings.Split(view, "\n")); got != 1+7 {
+			t.Fatalf("Expected the header and 7 row lines, got %d:\n%s", got, view)
+		}
+	}
+
+	// Moving up past the top scrolls up by one row
+	start := table.GetState().ViewportStartIndex
+	pumpMsgs(table, core.CursorUpCmd())
+	if state := table.GetState(); state.ViewportStartIndex != start-1 || state.CursorViewportIndex != 0 {
+		t.Errorf("Expected the viewport to follow the cursor up, got %+v", state)
+	}
+
+	// A page is the two rows fully shown
+	pumpMsgs(table, core.PageDownCmd())
+	if state := table.GetState(); state.CursorIndex != 6 {
+		t.Errorf("Expected page down to move two rows, got %+v", state)
+	}
+
+	// The last rows fill the viewport without scrolling past the end
+	pumpMsgs(table, core.JumpToEndCmd())
+	if state := table.GetState(); state.CursorIndex != 19 || state.ViewportStartIndex != 18 || !state.AtDatasetEnd {
+		t.Errorf("Expected the last two rows in view, got %+v", state)
+	}
+}
+
+func TestTable_SearchAllAsync(t *testing.T) {
+	rows := createTestRows(50)
+	table := createTestTable(rows)
+
+	cmd, cancel := table.SearchAllAsync("status1", []string{"status"})
+	defer cancel()
+
+	var progress []core.SearchProgressMsg
+	var complete *core.SearchCompleteMsg
+	for cmd != nil {
+		msg := cmd()
+		switch m := msg.(type) {
+		case core.SearchProgressMsg:
+			progress = append(progress, m)
+		case core.SearchCompleteMsg:
+			complete = &m
+		}
+		_, cmd = table.Update(msg)
+	}
+
+	// Chunk size is 10, so four progress updates precede completion
+	if len(progress) != 4 || progress[0].Scanned != 10 || progress[0].Total != 50 {
+		t.Errorf("Unexpected progress messages: %+v", progress)
+	}
+	if complete == nil || complete.Cancelled {
+		t.Fatalf("Expected search to complete, got %+v", complete)
+	}
+
+	// Rows 1, 4, 7, ... have Status1
+	if len(complete.Indices) != 17 || complete.Indices[0] != 1 || complete.Indices[16] != 49 {
+		t.Errorf("Unexpected matches: %v", complete.Indices)
+	}
+	if got := table.GetSearchResults(); len(got) != 17 {
+		t.Errorf("Expected results stored on the table, got %v", got)
+	}
+
+	// Cancelling stops the scan at the next step
+	cmd, cancel = table.SearchAllAsync("item", nil)
+	msg := cmd()
+	if _, ok := msg.(core.SearchProgressMsg); !ok {
+		t.Fatalf("Expected progress after first chunk, got %T", msg)
+	}
+	cancel()
+	_, cmd = table.Update(msg)
+	done, ok := cmd().(core.SearchCompleteMsg)
+	if !ok || !done.Cancelled || len(done.Indices) != 10 {
+		t.Errorf("Expected cancelled search with 10 partial matches, got %+v", done)
+	}
+
+	// The partial matches do not replace the results of the completed search
+	table.Update(done)
+	if got := table.GetSearchResults(); len(got) != 17 {
+		t.Errorf("Expected the cancelled matches discarded, got %v", got)
+	}
+}
+
+func TestTable_MinRefreshInterval(t *testing.T) {
+	table := createTestTable(createTestRows(30))
+	table.config.ViewportConfig.MinRefreshInterval = time.Hour
+
+	// The first update is applied and starts the interval
+	table.Update(core.DataTotalUpdateMsg{Total: 31})
+	if table.GetTotalItems() != 31 {
+		t.Fatalf("Expected first update to apply, got %d", table.GetTotalItems())
+	}
+
+	// Updates within the interval are coalesced behind a single flush
+	_, flush := table.Update(core.DataTotalUpdateMsg{Total: 32})
+	if flush == nil {
+		t.Fatal("Expected a scheduled flush for a throttled update")
+	}
+	if _, cmd := table.Update(core.DataTotalUpdateMsg{Total: 33}); cmd != nil {
+		t.Error("Expected no second flush while one is scheduled")
+	}
+	if table.GetTotalItems() != 31 {
+		t.Errorf("Expected throttled updates to be deferred, got %d", table.GetTotalItems())
+	}
+
+	table.Update(core.RefreshFlushMsg{})
+	if table.GetTotalItems() != 33 {
+		t.Errorf("Expected flush to apply the latest total, got %d", table.GetTotalItems())
+	}
+
+	// Shrinking past the cursor bypasses the throttle
+	table.viewport.CursorIndex = 20
+	table.Update(core.DataTotalUpdateMsg{Total: 10})
+	if table.GetTotalItems() != 10 || table.GetState().CursorIndex != 9 {
+		t.Errorf("Expected critical update to apply immediately, total %d cursor %d", table.GetTotalItems(), table.GetState().CursorIndex)
+	}
+}
+
+func TestTable_ShrinkDropsDeferredTotal(t *testing.T) {
+	table := createTestTable(createTestRows(30))
+	table.config.ViewportConfig.MinRefreshInterval = time.Hour
+	table.Update(core.DataTotalUpdateMsg{Total: 30})
+
+	// A larger total is deferred, then the data shrinks past the cursor
+	table.Update(core.DataTotalUpdateMsg{Total: 40})
+	pumpMsgs(table, core.JumpToCmd(20))
+	table.Update(core.DataTotalUpdateMsg{Total: 10})
+	if table.GetTotalItems() != 10 {
+		t.Fatalf("Expected the shrink applied immediately, got %d", table.GetTotalItems())
+	}
+
+	// The scheduled flush must not bring the stale total back
+	table.Update(core.RefreshFlushMsg{})
+	if table.GetTotalItems() != 10 {
+		t.Errorf("Expected the deferred total dropped by the shrink, got %d", table.GetTotalItems())
+	}
+}
+
+func TestTable_CurrentRequestRoundTrip(t *testing.T) {
+	source := NewController(createTestTable(createTestRows(40)))
+	source.Do(core.SortSetCmd("value", "desc"))
+	source.Do(core.FilterSetCmd("status", "Status1"))
+	source.JumpTo(25)
+
+	request := source.Table().CurrentRequest()
+	if request.Start != source.Table().GetState().ViewportStartIndex || request.Count != 5 {
+		t.Errorf("Unexpected request range: start %d count %d", request.Start, request.Count)
+	}
+	if len(request.SortFields) != 1 || request.SortDirections[0] != "desc" || request.Filters["status"] != "Status1" {
+		t.Errorf("Unexpected request sort/filters: %+v", request)
+	}
+
+	// Mutating the returned request must not leak into the table
+	request.Filters["status"] = "changed"
+	if source.Table().CurrentRequest().Filters["status"] != "Status1" {
+		t.Error("CurrentRequest should return a copy of the filters")
+	}
+	request.Filters["status"] = "Status1"
+
+	target := NewController(createTestTable(createTestRows(40)))
+	target.Do(target.Table().SetRequest(request))
+
+	restored := target.Table().CurrentRequest()
+	if restored.Start != request.Start || restored.SortFields[0] != "value" || restored.Filters["status"] != "Status1" {
+		t.Errorf("Request did not round-trip: %+v", restored)
+	}
+	if target.Table().GetState().CursorIndex != request.Start {
+		t.Errorf("Expected cursor restored to %d, got %d", request.Start, target.Table().GetState().CursorIndex)
+	}
+}
+
+func TestTable_SelectionCursorCoupling(t *testing.T) {
+	// Default: selecting and moving are independent
+	independent := NewController(createTestTable(createTestRows(10)))
+	independent.ToggleSelect()
+	independent.MoveDown()
+	if cursor := independent.Table().GetState().CursorIndex; cursor != 1 {
+		t.Errorf("Expected cursor 1, got %d", cursor)
+	}
+	if got := independent.Table().GetSelectedIndices(); len(got) != 1 || got[0] != 0 {
+		t.Errorf("Expected only item 0 selected, got %v", got)
+	}
+
+	// MoveCursorOnSelect advances after each selection
+	advancing := createTestTable(createTestRows(10))
+	advancing.config.Selection.MoveCursorOnSelect = true
+	advance := NewController(advancing)
+	advance.ToggleSelect()
+	advance.ToggleSelect()
+	if cursor := advancing.GetState().CursorIndex; cursor != 2 {
+		t.Errorf("Expected cursor 2 after two selections, got %d", cursor)
+	}
+	if got := advancing.GetSelectedIndices(); len(got) != 2 {
+		t.Errorf("Expected two selected items, got %v", got)
+	}
+
+	// SelectOnCursorMove carries the selection with the cursor
+	following := createTestTable(createTestRows(10))
+	following.config.Selection.SelectOnCursorMove = true
+	follow := NewController(following)
+	follow.MoveDown()
+	follow.MoveDown()
+	if got := following.GetSelectedIndices(); len(got) != 1 || got[0] != 2 {
+		t.Errorf("Expected only item 2 selected, got %v", got)
+	}
+	follow.JumpTo(7)
+	if got := following.GetSelectedIndices(); len(got) != 1 || got[0] != 7 {
+		t.Errorf("Expected only item 7 selected after jump, got %v", got)
+	}
+}
+
+// unfilledCells counts the visible cells of line that are not covered by the
+// background sequence fill, ignoring the first and last (outer border) cells
+func unfilledCells(line, fill string) int {
+	var cells []bool
+	filled := false
+	for i := 0; i < len(line); {
+		if line[i] == '\x1b' {
+			end := strings.IndexByte(line[i:], 'm')
+			if end < 0 {
+				break
+			}
+			seq := line[i : i+end+1]
+			if strings.Contains(seq, fill) {
+				filled = true
+			} else if seq == "\x1b[0m" || seq == "\x1b[m" || strings.Contains(seq, "[49") {
+				filled = false
+			}
+			i += end + 1
+			continue
+		}
+		_, size := utf8.DecodeRuneInString(line[i:])
+		cells = append(cells, filled)
+		i += size
+	}
+
+	unfilled := 0
+	for i := 1; i < len(cells)-1; i++ {
+		if !cells[i] {
+			unfilled++
+		}
+	}
+	return unfilled
+}
+
+func TestTable_FullRowBackgroundHasNoGaps(t *testing.T) {
+	previousProfile := lipgloss.ColorProfile()
+	lipgloss.SetColorProfile(termenv.TrueColor)
+	defer lipgloss.SetColorProfile(previousProfile)
+
+	rows := []core.TableRow{
+		{ID: "row-0", Cells: []string{"A long name that scrolls", "1", "x"}},
+		{ID: "row-1", Cells: []string{"Short", "2", "y"}},
+	}
+	table := createTestTable(rows)
+	table.config.FullRowHighlighting = true
+	table.config.Theme.FullRowCursorStyle = lipgloss.NewStyle().Background(lipgloss.Color("#112233"))
+	table.config.Theme.SelectedStyle = lipgloss.NewStyle().Background(lipgloss.Color("#445566"))
+	ctrl := NewController(table)
+	ctrl.Do(core.SelectToggleCmd(1))
+
+	// Scroll the first column so the cursor row renders a scrolled cell
+	table.handleHorizontalScrollRight()
+	table.handleHorizontalScrollRight()
+
+	var cursorLine, selectedLine string
+	for _, line := range strings.Split(ctrl.Render(), "\n") {
+		plain := stripANSI(line)
+		if strings.Contains(plain, "►") {
+			cursorLine = line
+		} else if strings.Contains(plain, "✓") {
+			selectedLine = line
+		}
+	}
+	if cursorLine == "" || selectedLine == "" {
+		t.Fatalf("Expected cursor and selected rows in view:\n%s", ctrl.Render())
+	}
+
+	if n := unfilledCells(cursorLine, "48;2;17;34;51"); n != 0 {
+		t.Errorf("Expected cursor row fully filled, found %d unstyled cells in %q", n, cursorLine)
+	}
+	if n := unfilledCells(selectedLine, "48;2;68;85;102"); n != 0 {
+		t.Errorf("Expected selected row fully filled, found %d unstyled cells in %q", n, selectedLine)
+	}
+}
+
+func TestTable_ColumnResizeMode(t *testing.T) {
+	table := createTestTable(createTestRows(5))
+	table.config.Columns[0].MinWidth = 8
+	table.config.Columns[0].MaxWidth = 11
+	table.Focus()
+	ctrl := NewController(table)
+
+	// Arrow keys do nothing to widths outside of resize mode
+	ctrl.Send(tea.KeyMsg{Type: tea.KeyRight})
+	if table.config.Columns[0].Width != 10 {
+		t.Fatal("Width should not change outside of resize mode")
+	}
+
+	ctrl.Do(table.SetResizeMode(true))
+	if !table.IsResizeMode() {
+		t.Fatal("Expected resize mode to be active")
+	}
+	if !strings.Contains(stripANSI(ctrl.Render()), "↔") {
+		t.Error("Expected the active column header to show the resize marker")
+	}
+
+	// Growing emits ColumnResizedMsg and stops at MaxWidth
+	var resized []core.ColumnResizedMsg
+	for i := 0; i < 3; i++ {
+		for _, msg := range collectMsgs(table.handleKeyPress(tea.KeyMsg{Type: tea.KeyRight})) {
+			if r, ok := msg.(core.ColumnResizedMsg); ok {
+				resized = append(resized, r)
+			}
+		}
+	}
+	if len(resized) != 1 || resized[0].Index != 0 || resized[0].Width != 11 {
+		t.Errorf("Expected a single resize to width 11, got %+v", resized)
+	}
+
+	// Shrinking stops at MinWidth
+	for i := 0; i < 5; i++ {
+		ctrl.Send(tea.KeyMsg{Type: tea.KeyLeft})
+	}
+	if got := table.config.Columns[0].Width; got != 8 {
+		t.Errorf("Expected width clamped to MinWidth 8, got %d", got)
+	}
+
+	// Tab moves to the next column, which has no bounds
+	ctrl.Send(tea.KeyMsg{Type: tea.KeyTab})
+	ctrl.Send(tea.KeyMsg{Type: tea.KeyRight})
+	if got := table.config.Columns[1].Width; got != 9 {
+		t.Errorf("Expected second column widened to 9, got %d", got)
+	}
+
+	// Escape leaves the mode
+	ctrl.Send(tea.KeyMsg{Type: tea.KeyEsc})
+	if table.IsResizeMode() {
+		t.Error("Expected escape to leave resize mode")
+	}
+}
+
+func TestTable_ExportCSV(t *testing.T) {
+	rows := make([]core.TableRow, 25)
+	for i := range rows {
+		rows[i] = core.TableRow{ID: fmt.Sprintf("row-%d", i), Cells: []string{fmt.Sprintf("Item %d", i), fmt.Sprintf("%d", i), "ok"}}
+	}
+	rows[3].Cells = []string{`Comma, "quoted"`, "two\nlines", "ok"}
+
+	table := createTestTable(rows)
+	ctrl := NewController(table)
+	ctrl.Do(core.CellFormatterSetCmd(2, func(value string, rowIndex int, column core.TableColumn, ctx core.RenderContext, isCursor, isSelected, isActiveCell bool) string {
+		return lipgloss.NewStyle().Bold(true).Render(value)
+	}))
+	ctrl.Do(core.SortSetCmd("value", "desc"))
+
+	var buf bytes.Buffer
+	count, err := table.ExportCSV(&buf)
+	if err != nil || count != 25 {
+		t.Fatalf("Expected 25 rows exported without error, got %d, %v", count, err)
+	}
+	if strings.Contains(buf.String(), "\x1b") {
+		t.Error("Exported CSV should not contain ANSI escape codes")
+	}
+
+	records, err := csv.NewReader(&buf).ReadAll()
+	if err != nil {
+		t.Fatalf("Exported CSV is not valid: %v", err)
+	}
+	if len(records) != 26 || records[0][0] != "Name" {
+		t.Fatalf("Expected header plus 25 records, got %d: %v", len(records), records[0])
+	}
+
+	found := false
+	for _, record := range records[1:] {
+		if record[0] == `Comma, "quoted"` {
+			found = true
+			if record[1] != "two\nlines" || record[2] != "ok" {
+				t.Errorf("Special characters did not round-trip: %q", record)
+			}
+		}
+	}
+	if !found {
+		t.Error("Row with special characters missing from export")
+	}
+
+	// The command form writes a file and reports completion
+	path := filepath.Join(t.TempDir(), "export.csv")
+	_, cmd := table.Update(core.ExportCSVMsg{Path: path})
+	completed, ok := cmd().(core.ExportCompletedMsg)
+	if !ok || completed.Err != nil || completed.RowCount != 25 || completed.Path != path {
+		t.Fatalf("Unexpected completion message: %+v", completed)
+	}
+	content, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("Export file not written: %v", err)
+	}
+	if strings.HasPrefix(string(content), "Name,") {
+		t.Error("Headers should be omitted when IncludeHeaders is false")
+	}
+}
+
+// wrappingSource hands the LoadChunk commands of a TestDataSource through wrap
+type wrappingSource struct {
+	*TestDataSource
+	wrap func(cmd tea.Cmd) tea.Cmd
+}
+
+func (s *wrappingSource) LoadChunk(request core.DataRequest) tea.Cmd {
+	return s.wrap(s.TestDataSource.LoadChunk(request))
+}
+
+func TestTable_ExportCSVLoadResults(t *testing.T) {
+	type wrappedMsg struct{ tea.Msg }
+	tests := []struct {
+		name    string
+		wrap    func(cmd tea.Cmd) tea.Cmd
+		count   int
+		wantErr bool
+	}{
+		{"batched", func(cmd tea.Cmd) tea.Cmd { return tea.Batch(nil, cmd) }, 25, false},
+		{"sequenced", func(cmd tea.Cmd) tea.Cmd { return tea.Sequence(cmd) }, 25, false},
+		{"unrecognised", func(cmd tea.Cmd) tea.Cmd {
+			return func() tea.Msg { return wrappedMsg{cmd()} }
+		}, 0, true},
+		{"no command", func(cmd tea.Cmd) tea.Cmd { return nil }, 0, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			table := createTestTable(createTestRows(25))
+			table.dataSource = &wrappingSource{TestDataSource: table.dataSource.(*TestDataSource), wrap: tt.wrap}
+
+			count, err := table.ExportCSV(io.Discard)
+			if (err != nil) != tt.wantErr || count != tt.count {
+				t.Errorf("Expected %d rows and error %v, got %d rows and %v", tt.count, tt.wantErr, count, err)
+			}
+		})
+	}
+
+	// A full-source search reports the unrecognised result instead of
+	// finishing with the rows scanned so far
+	table := createTestTable(createTestRows(25))
+	table.dataSource = &wrappingSource{TestDataSource: table.dataSource.(*TestDataSource), wrap: tests[2].wrap}
+	cmd, _ := table.SearchAllAsync("item", nil)
+	if complete, ok := cmd().(core.SearchCompleteMsg); !ok || complete.Error == nil {
+		t.Errorf("Expected the search to fail on the unrecognised result, got %+v", complete)
+	}
+
+	// The rows are walked to the data source's total, not the table's
+	table = createTestTable(createTestRows(25))
+	source := table.dataSource.(*TestDataSource)
+	source.data = createTestRows(40)
+	source.totalItems = 40
+	if count, err := table.ExportCSV(io.Discard); err != nil || count != 40 {
+		t.Errorf("Expected the 40 rows of the data source exported, got %d, %v", count, err)
+	}
+}
+
+func TestTable_SortComparatorsPassedThrough(t *testing.T) {
+	table := createTestTable(createTestRows(3))
+	table.config.Columns[0].SortComparator = core.NaturalCompare
+	table.config.Columns[1].Type = core.ColumnInt
+	table.applyOverflowStrategy()
+	ctrl := NewController(table)
+	ctrl.Do(core.SortSetCmd("name", "desc"))
+
+	request := table.CurrentRequest()
+	if request.SortComparators["name"] == nil || len(request.SortComparators) != 1 {
+		t.Fatalf("Expected the name comparator in the request, got %v", request.SortComparators)
+	}
+
+	// A data source sorting with CompareSortField uses the comparator, then the direction
+	names := []string{"Item 2", "Item 10", "Item 1"}
+	sort.SliceStable(names, func(i, j int) bool {
+		cmp := core.CompareSortField(request, "name", names[i], names[j])
+		if request.SortDirections[0] == "desc" {
+			return cmp > 0
+		}
+		return cmp < 0
+	})
+	if strings.Join(names, ",") != "Item 10,Item 2,Item 1" {
+		t.Errorf("Unexpected order: %v", names)
+	}
+
+	// Without a comparator the field type decides
+	if core.CompareSortField(request, "value", "9", "10") >= 0 {
+		t.Error("Expected the column type to order numeric fields")
+	}
+}
+
+func TestTable_DefaultSearchAndFilterKeys(t *testing.T) {
+	table := createTestTable(createTestRows(5))
+	table.config.KeyMap = core.DefaultNavigationKeyMap()
+	table.Focus()
+
+	// "/" keeps filtering, as in lists
+	pumpMsgs(table, func() tea.Msg { return tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("/")} })
+	if table.IsSearching() || !table.IsEditingFilter() {
+		t.Fatalf("Expected / to edit the column filter, searching %v", table.IsSearching())
+	}
+	pumpMsgs(table, func() tea.Msg { return tea.KeyMsg{Type: tea.KeyEsc} })
+
+	pumpMsgs(table, func() tea.Msg { return tea.KeyMsg{Type: tea.KeyCtrlF} })
+	if !table.IsSearching() {
+		t.Error("Expected ctrl+f to open the search prompt")
+	}
+}
+
+func TestTable_IncrementalSearch(t *testing.T) {
+	previousProfile := lipgloss.ColorProfile()
+	lipgloss.SetColorProfile(termenv.TrueColor)
+	defer lipgloss.SetColorProfile(previousProfile)
+
+	table := createTestTable(createTestRows(20))
+	table.config.KeyMap.Search = []string{"?"}
+	table.Focus()
+	ctrl := NewController(table)
+	ctrl.JumpTo(5)
+
+	typeKey := func(key tea.KeyMsg) {
+		ctrl.Send(key)
+	}
+	state := func() core.SearchStateMsg {
+		return table.searchStateCmd()().(core.SearchStateMsg)
+	}
+
+	typeKey(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("?")})
+	if !table.IsSearching() {
+		t.Fatal("Expected the search key to open the prompt")
+	}
+
+	// "item 1" matches Item 1 and Item 10..19; the first match after the origin wins
+	typeKey(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("item")})
+	typeKey(tea.KeyMsg{Type: tea.KeySpace, Runes: []rune(" ")})
+	typeKey(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("1")})
+	if got := table.GetState().CursorIndex; got != 9 {
+		t.Errorf("Expected the cursor on Item 10, got %d", got)
+	}
+	if s := state(); s.MatchCount != 11 || s.CurrentMatch != 2 {
+		t.Errorf("Unexpected search state: %+v", s)
+	}
+	if !strings.Contains(ctrl.Render(), table.config.Theme.SearchMatchStyle.Render("Item 1")) {
+		t.Error("Expected the match to be highlighted")
+	}
+
+	ctrl.Do(table.SearchPrev())
+	ctrl.Do(table.SearchPrev())
+	if got := table.GetState().CursorIndex; got != 18 {
+		t.Errorf("Expected SearchPrev to wrap to Item 19, got %d", got)
+	}
+	ctrl.Do(table.SearchNext())
+	if got := table.GetState().CursorIndex; got != 0 {
+		t.Errorf("Expected SearchNext to wrap to Item 1, got %d", got)
+	}
+
+	// No match leaves the cursor in place
+	typeKey(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("x")})
+	if got := table.GetState().CursorIndex; got != 0 {
+		t.Errorf("Expected the cursor to stay put, got %d", got)
+	}
+	if s := state(); s.MatchCount != 0 || s.CurrentMatch != 0 {
+		t.Errorf("Unexpected search state: %+v", s)
+	}
+
+	// An empty query clears the highlights
+	for range "item 1x" {
+		typeKey(tea.KeyMsg{Type: tea.KeyBackspace})
+	}
+	if strings.Contains(ctrl.Render(), table.config.Theme.SearchMatchStyle.Render("Item")) {
+		t.Error("Expected no highlights for an empty query")
+	}
+
+	typeKey(tea.KeyMsg{Type: tea.KeyEsc})
+	if table.IsSearching() || table.SearchQuery() != "" {
+		t.Error("Expected escape to clear the search")
+	}
+}
+
+func TestTable_ColumnResizeCmd(t *testing.T) {
+	rows := []core.TableRow{
+		{ID: "1", Cells: []string{"東京都", "10", "生きている"}},
+		{ID: "2", Cells: []string{"Paris", "20", "ok"}},
+	}
+	table := createTestTable(rows)
+	table.config.Columns[0].MinWidth = 6
+	table.config.Columns[0].MaxWidth = 14
+	table.applyOverflowStrategy()
+	ctrl := NewController(table)
+
+	// Shrinking below MinWidth clamps, and a clamped no-op reports nothing
+	ctrl.Do(table.SetColumnWidth(0, 6))
+	if msgs := collectMsgs(table.ResizeColumn(0, -2)); len(msgs) != 1 {
+		t.Fatalf("Expected a single resize message, got %v", msgs)
+	} else if _, cmd := table.Update(msgs[0]); cmd != nil {
+		t.Error("Expected resizing below MinWidth to be a no-op")
+	}
+	if got := table.config.Columns[0].Width; got != 6 {
+		t.Errorf("Expected width 6, got %d", got)
+	}
+
+	ctrl.Do(core.ColumnResizeCmd(0, 20))
+	if got := table.config.Columns[0].Width; got != 14 {
+		t.Errorf("Expected width clamped to MaxWidth 14, got %d", got)
+	}
+
+	// Wide characters still line up with the header, separator and borders
+	lines := strings.Split(ctrl.Render(), "\n")
+	width := lipgloss.Width(lines[0])
+	for i, line := range lines {
+		if lipgloss.Width(line) != width {
+			t.Errorf("Line %d has width %d, expected %d: %q", i, lipgloss.Width(line), width, line)
+		}
+	}
+}
+
+func TestTable_PinnedColumns(t *testing.T) {
+	previousProfile := lipgloss.ColorProfile()
+	lipgloss.SetColorProfile(termenv.TrueColor)
+	defer lipgloss.SetColorProfile(previousProfile)
+
+	rows := []core.TableRow{
+		{ID: "row-0", Cells: []string{"Pinned name", "1", "A status that scrolls"}},
+		{ID: "row-1", Cells: []string{"Other", "2", "y"}},
+	}
+	table := createTestTable(rows)
+	table.config.FullRowHighlighting = true
+	table.config.ShowTopBorder = true
+	table.config.ShowBottomBorder = true
+	table.config.ShowHeaderSeparator = true
+	table.config.Theme.FullRowCursorStyle = lipgloss.NewStyle().Background(lipgloss.Color("#112233"))
+	ctrl := NewController(table)
+	ctrl.Do(table.PinColumn(0, true))
+
+	// Scrolling the pinned column does nothing, other columns still scroll
+	table.handleHorizontalScrollRight()
+	if _, _, _, offsets := table.GetHorizontalScrollState(); offsets[0] != 0 {
+		t.Errorf("Expected the pinned column not to scroll, got offset %d", offsets[0])
+	}
+	table.currentColumn = 2
+	table.handleHorizontalScrollRight()
+	if _, _, _, offsets := table.GetHorizontalScrollState(); offsets[2] != 1 {
+		t.Errorf("Expected the unpinned column to scroll, got offset %d", offsets[2])
+	}
+
+	lines := strings.Split(ctrl.Render(), "\n")
+	plain := stripANSI(strings.Join(lines, "\n"))
+	for _, junction := range []string{"┰", "╂", "┸"} {
+		if strings.Count(plain, junction) != 1 {
+			t.Errorf("Expected one %q junction after the pinned block:\n%s", junction, plain)
+		}
+	}
+	if !strings.Contains(plain, "│Pinned") {
+		t.Errorf("Expected the pinned cell unscrolled:\n%s", plain)
+	}
+
+	width := lipgloss.Width(lines[0])
+	for i, line := range lines {
+		if lipgloss.Width(line) != width {
+			t.Errorf("Line %d has width %d, expected %d", i, lipgloss.Width(line), width)
+		}
+		if strings.Contains(stripANSI(line), "►") {
+			if n := unfilledCells(line, "48;2;17;34;51"); n != 0 {
+				t.Errorf("Expected the cursor row filled across the pinned edge, found %d unstyled cells", n)
+			}
+		} else if strings.Contains(stripANSI(line), "│") && !strings.Contains(stripANSI(line), pinnedVertical) {
+			t.Errorf("Expected a pinned separator on line %d: %q", i, stripANSI(line))
+		}
+	}
+}
+
+func TestTable_ZebraStriping(t *testing.T) {
+	previousProfile := lipgloss.ColorProfile()
+	lipgloss.SetColorProfile(termenv.TrueColor)
+	defer lipgloss.SetColorProfile(previousProfile)
+
+	table := createTestTable(createTestRows(20))
+	table.config.Theme.EvenRowStyle = lipgloss.NewStyle().Background(lipgloss.Color("#101010"))
+	table.config.Theme.OddRowStyle = lipgloss.NewStyle().Background(lipgloss.Color("#202020"))
+	table.config.Theme.SelectedStyle = lipgloss.NewStyle().Background(lipgloss.Color("#445566"))
+	ctrl := NewController(table)
+	ctrl.Do(core.ZebraStripingEnableCmd(true))
+	ctrl.Do(core.SelectToggleCmd(3))
+
+	even, odd, selected := "48;2;16;16;16", "48;2;32;32;32", "48;2;68;85;102"
+	rowLines := func() map[string]string {
+		lines := make(map[string]string)
+		for _, line := range strings.Split(ctrl.Render(), "\n") {
+			plain := stripANSI(line)
+			for i := 0; i < 20; i++ {
+				name := fmt.Sprintf("Item %d ", i+1)
+				if strings.Contains(plain, name) {
+					lines[name] = line
+				}
+			}
+		}
+		return lines
+	}
+
+	lines := rowLines()
+	if n := unfilledCells(lines["Item 3 "], even); n != 0 {
+		t.Errorf("Expected row 2 fully striped with the even style, found %d unstyled cells", n)
+	}
+	if n := unfilledCells(lines["Item 3 "], odd); n == 0 {
+		t.Error("Expected row 2 not to use the odd style")
+	}
+	if n := unfilledCells(lines["Item 4 "], selected); n != 0 {
+		t.Errorf("Expected the selection to take precedence, found %d cells without it", n)
+	}
+
+	// Stripes follow the absolute index, not the viewport position
+	ctrl.JumpTo(15)
+	lines = rowLines()
+	if line, ok := lines["Item 18 "]; !ok {
+		t.Fatalf("Expected row 17 in view:\n%s", stripANSI(ctrl.Render()))
+	} else if n := unfilledCells(line, odd); n != 0 {
+		t.Errorf("Expected row 17 striped with the odd style, found %d unstyled cells", n)
+	}
+
+	ctrl.Do(core.ZebraStripingEnableCmd(false))
+	if strings.Contains(ctrl.Render(), odd) {
+		t.Error("Expected no stripes once disabled")
+	}
+}
+
+func TestTable_WrappedCells(t *testing.T) {
+	var rows []core.TableRow
+	for i := 0; i < 10; i++ {
+		rows = append(rows, core.TableRow{
+			ID:    fmt.Sprintf("row-%d", i),
+			Cells: []string{fmt.Sprintf("Row %d has a long wrapped name", i), fmt.Sprint(i), "ok"},
+		})
+	}
+	table := createTestTable(rows)
+	table.config.Columns[0].WrapText = true
+	table.config.Columns[2].VerticalAlignment = core.AlignBottom
+	table.applyOverflowStrategy()
+	ctrl := NewController(table)
+
+	lines := strings.Split(stripANSI(ctrl.Render()), "\n")
+	width := lipgloss.Width(lines[0])
+	for i, line := range lines {
+		if lipgloss.Width(line) != width {
+			t.Errorf("Line %d has width %d, expected %d: %q", i, lipgloss.Width(line), width, line)
+		}
+	}
+
+	// The first row spans three lines, with the status cell at the bottom
+	expected := []string{
+		"│ ►  │Row 0 has │       0│          │",
+		"│    │a long    │        │          │",
+		"│    │wrapped   │        │          │",
+		"│    │name      │        │    ok    │",
+	}
+	for i, want := range expected {
+		if i+1 >= len(lines) || lines[i+1] != want {
+			t.Fatalf("Unexpected wrapped row:\n%s", strings.Join(lines, "\n"))
+		}
+	}
+
+	// The cursor index stays logical and the cursor row is kept in view
+	ctrl.JumpTo(4)
+	if got := table.GetState().CursorIndex; got != 4 {
+		t.Errorf("Expected cursor index 4, got %d", got)
+	}
+	lines = strings.Split(stripANSI(ctrl.Render()), "\n")
+	if len(lines)-1 > table.config.ViewportConfig.Height {
+		t.Errorf("Expected at most %d body lines, got %d", table.config.ViewportConfig.Height, len(lines)-1)
+	}
+	if !strings.Contains(strings.Join(lines, "\n"), "► ") || !strings.Contains(strings.Join(lines, "\n"), "Row 4") {
+		t.Errorf("Expected the cursor row in view:\n%s", strings.Join(lines, "\n"))
+	}
+}
+
+func TestTable_MouseClicks(t *testing.T) {
+	rows := createTestRows(10)
+	rows[1].Cells[0] = "Item 2 spans two lines"
+	table := createTestTable(rows)
+	table.config.MouseEnabled = true
+	table.config.Columns[0].WrapText = true
+	table.applyOverflowStrategy()
+	table.Focus()
+	ctrl := NewController(table)
+
+	lines := strings.Split(stripANSI(ctrl.Render()), "\n")
+	lineOf := func(text string) int {
+		for i, line := range lines {
+			if strings.Contains(line, text) {
+				return i
+			}
+		}
+		t.Fatalf("%q not rendered:\n%s", text, strings.Join(lines, "\n"))
+		return -1
+	}
+	click := func(y int) []tea.Msg {
+		_, cmd := table.Update(tea.MouseMsg{Y: y, Button: tea.MouseButtonLeft, Action: tea.MouseActionPress})
+		return collectMsgs(cmd)
+	}
+
+	// Clicking the header does nothing; the row below a wrapped row is found
+	click(0)
+	if got := table.GetState().CursorIndex; got != 0 {
+		t.Errorf("Expected a header click to be ignored, got cursor %d", got)
+	}
+	click(lineOf("Item 3"))
+	if got := table.GetState().CursorIndex; got != 2 {
+		t.Errorf("Expected the cursor on row 2, got %d", got)
+	}
+
+	// All lines of the wrapped row map to it, and a double click activates it
+	click(lineOf("Item 2"))
+	msgs := click(lineOf("lines  "))
+	if got := table.GetState().CursorIndex; got != 1 {
+		t.Errorf("Expected the cursor on row 1, got %d", got)
+	}
+	if len(msgs) != 1 || msgs[0] != (core.ItemActivatedMsg{Index: 1, ID: "row-1"}) {
+		t.Errorf("Expected ItemActivatedMsg for row 1, got %v", msgs)
+	}
+
+	ctrl.Send(tea.MouseMsg{Button: tea.MouseButtonWheelDown, Action: tea.MouseActionPress})
+	if got := table.GetState().CursorIndex; got != 2 {
+		t.Errorf("Expected the wheel to move the cursor down, got %d", got)
+	}
+}
+
+func TestTable_FuzzyFilter(t *testing.T) {
+	// The table sends its fuzzy fields with every request
+	table := createTestTable(createTestRows(5))
+	pumpMsgs(table, table.SetFuzzyFields("name"))
+	if got := table.CurrentRequest().FuzzyFields; len(got) != 1 || got[0] != "name" {
+		t.Errorf("Expected FuzzyFields [name], got %v", got)
+	}
+}
+
+func TestTable_Scrollbar(t *testing.T) {
+	table := createTestTable(createTestRows(20))
+	table.config.ViewportConfig.ShowScrollbar = true
+	ctrl := NewController(table)
+
+	// The thumb reaches the last line only once the last row is visible
+	for index := 0; index < 20; index++ {
+		ctrl.JumpTo(index)
+		lines := strings.Split(stripANSI(ctrl.Render()), "\n")
+		if len(lines) != 6 {
+			t.Fatalf("Expected a header and 5 rows, got:\n%s", strings.Join(lines, "\n"))
+		}
+		if lipgloss.Width(lines[0])+1 != lipgloss.Width(lines[1]) {
+			t.Errorf("Expected the scrollbar beside the rows only, got:\n%s", strings.Join(lines, "\n"))
+		}
+
+		state := table.GetState()
+		lastVisible := state.ViewportStartIndex+5 >= 20
+		atBottom := strings.HasSuffix(lines[5], render.ScrollbarThumbChar)
+		if atBottom != lastVisible {
+			t.Errorf("Cursor %d (viewport start %d): thumb at bottom %v, last row visible %v",
+				index, state.ViewportStartIndex, atBottom, lastVisible)
+		}
+		if index == 0 && !strings.HasSuffix(lines[1], render.ScrollbarThumbChar) {
+			t.Errorf("Expected the thumb at the top, got %q", lines[1])
+		}
+	}
+}
+
+func TestTable_ScrollbarCountsInFrameWidth(t *testing.T) {
+	table := createTestTable(createTestRows(20))
+	table.config.ViewportConfig.ShowScrollbar = true
+	table.Update(table.SetStatusLine("20 items")())
+
+	// The status line spans the rows and the scrollbar beside them
+	lines := strings.Split(table.View(), "\n")
+	if status, row := lipgloss.Width(lines[len(lines)-1]), lipgloss.Width(lines[1]); status != row {
+		t.Errorf("Expected the status line as wide as a row and its scrollbar (%d), got %d", row, status)
+	}
+
+	// Shrinking leaves room for the scrollbar
+	table.Update(tea.WindowSizeMsg{Width: 30, Height: 20})
+	table.Update(table.SetOverflowStrategy(core.OverflowShrink)())
+	if width := lipgloss.Width(strings.Split(table.View(), "\n")[1]); width != 30 {
+		t.Errorf("Expected rows and scrollbar to fit 30 columns, got %d", width)
+	}
+}
+
+func TestTable_FilterDebounce(t *testing.T) {
+	table := createTestTable(createTestRows(10))
+	table.SetFilterDebounce(50 * time.Millisecond)
+
+	// Rapid changes stay pending and each schedules a tick
+	_, first := table.Update(core.FilterSetMsg{Field: "name", Value: "Item"})
+	_, second := table.Update(core.FilterSetMsg{Field: "status", Value: "Status1"})
+	if first == nil || second == nil {
+		t.Fatal("Expected debounced filter changes to schedule ticks")
+	}
+	if len(table.CurrentRequest().Filters) != 0 || !table.HasPendingFilters() {
+		t.Fatalf("Expected the filters to wait for the quiet period, got %v", table.CurrentRequest().Filters)
+	}
+
+	// The tick of the superseded change is stale
+	if _, cmd := table.Update(filterDebounceMsg{seq: table.filterSeq - 1}); cmd != nil || !table.HasPendingFilters() {
+		t.Error("Expected a stale tick to be ignored")
+	}
+
+	// The latest tick applies both changes at once
+	if _, cmd := table.Update(filterDebounceMsg{seq: table.filterSeq}); cmd == nil {
+		t.Error("Expected the latest tick to refresh the data")
+	}
+	filters := table.CurrentRequest().Filters
+	if filters["name"] != "Item" || filters["status"] != "Status1" || table.HasPendingFilters() {
+		t.Errorf("Expected both filters applied, got %v", filters)
+	}
+
+	// Flushing applies a pending change without waiting, and its tick is then stale
+	table.Update(core.FilterClearMsg{Field: "status"})
+	seq := table.filterSeq
+	pumpMsgs(table, table.FlushFilters())
+	if _, ok := table.CurrentRequest().Filters["status"]; ok || table.HasPendingFilters() {
+		t.Errorf("Expected the flush to clear the status filter, got %v", table.CurrentRequest().Filters)
+	}
+	if _, cmd := table.Update(filterDebounceMsg{seq: seq}); cmd != nil {
+		t.Error("Expected the tick of a flushed change to be ignored")
+	}
+}
+
+type recordingClipboard struct {
+	text   string
+	writes int
+}
+
+func (c *recordingClipboard) WriteClipboard(text string) error {
+	c.text = text
+	c.writes++
+	return nil
+}
+
+func TestTable_CopySelection(t *testing.T) {
+	table := createTestTable(createTestRows(25))
+	clipboard := &recordingClipboard{}
+	table.SetClipboardWriter(clipboard)
+
+	copySelection := func(format core.CopyFormat) core.CopyCompletedMsg {
+		_, cmd := table.Update(core.CopySelectionMsg{Format: format})
+		msgs := collectMsgs(cmd)
+		if len(msgs) != 1 {
+			t.Fatalf("Expected one CopyCompletedMsg, got %v", msgs)
+		}
+		return msgs[0].(core.CopyCompletedMsg)
+	}
+
+	// Nothing selected leaves the clipboard alone
+	if msg := copySelection(core.CopyTSV); msg.Count != 0 || msg.Err != nil || clipboard.writes != 0 {
+		t.Errorf("Expected an empty copy, got %+v with %d writes", msg, clipboard.writes)
+	}
+
+	// Rows beyond the loaded chunk are copied too
+	dataSource := table.dataSource.(*TestDataSource)
+	dataSource.selectedItems["row-1"] = true
+	dataSource.selectedItems["row-21"] = true
+
+	if msg := copySelection(core.CopyTSV); msg.Count != 2 || msg.Err != nil {
+		t.Fatalf("Expected two rows copied, got %+v", msg)
+	}
+	expected := "Name\tValue\tStatus\nItem 2\t10\tStatus1\nItem 22\t210\tStatus0"
+	if clipboard.text != expected {
+		t.Errorf("Expected TSV %q, got %q", expected, clipboard.text)
+	}
+
+	copySelection(core.CopyJSON)
+	expected = `[{"name":"Item 2","value":"10","status":"Status1"},{"name":"Item 22","value":"210","status":"Status0"}]`
+	if clipboard.text != expected {
+		t.Errorf("Expected JSON %q, got %q", expected, clipboard.text)
+	}
+}
+
+type failingClipboard struct{}
+
+func (failingClipboard) WriteClipboard(text string) error {
+	return core.ErrNoClipboard
+}
+
+func TestTable_CopySelectionOSC52Fallback(t *testing.T) {
+	table := createTestTable(createTestRows(5))
+	table.SetClipboardWriter(failingClipboard{})
+	table.dataSource.(*TestDataSource).selectedItems["row-1"] = true
+
+	// Without the fallback, the clipboard error is reported
+	_, cmd := table.Update(core.CopySelectionMsg{Format: core.CopyTSV})
+	msgs := core.RunCmd(cmd)
+	if len(msgs) != 1 || msgs[0].(core.CopyCompletedMsg).Err != core.ErrNoClipboard {
+		t.Fatalf("Expected the clipboard error, got %v", msgs)
+	}
+
+	// With it, the sequence is rendered with the table until the copy is sent
+	table.SetOSC52Fallback(true)
+	var completed []tea.Msg
+	_, cmd = table.Update(core.CopySelectionMsg{Format: core.CopyTSV})
+	for _, msg := range core.RunCmd(cmd) {
+		_, next := table.Update(msg)
+		completed = append(completed, core.RunCmd(next)...)
+	}
+	expected := core.CopyCompletedMsg{Count: 1, OSC52: true}
+	if len(completed) != 1 || completed[0] != expected {
+		t.Fatalf("Expected %+v, got %v", expected, completed)
+	}
+	sequence := core.OSC52Sequence("Name\tValue\tStatus\nItem 2\t10\tStatus1")
+	if !strings.HasPrefix(table.View(), sequence) {
+		t.Errorf("Expected the view to start with the OSC 52 sequence, got %q", table.View())
+	}
+
+	table.Update(osc52SentMsg{seq: table.clipboardSeq})
+	if strings.Contains(table.View(), "\x1b]52;") {
+		t.Error("Expected the sequence to be dropped once sent")
+	}
+}
+
+func TestTable_CellEdit(t *testing.T) {
+	table := createTestTable(createTestRows(10))
+	table.Focus()
+	before := strings.Split(stripANSI(table.View()), "\n")
+
+	pumpMsgs(table, table.EditCell())
+	if !table.IsEditingCell() {
+		t.Fatal("Expected the cell editor to open")
+	}
+
+	press := func(msg tea.KeyMsg) []tea.Msg {
+		_, cmd := table.Update(msg)
+		return collectMsgs(cmd)
+	}
+	press(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("x")})
+	press(tea.KeyMsg{Type: tea.KeyLeft})
+	press(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("!")})
+	press(tea.KeyMsg{Type: tea.KeyTab})
+	press(tea.KeyMsg{Type: tea.KeyDown})
+
+	// The editor replaces the cell without changing the row layout
+	lines := strings.Split(stripANSI(table.View()), "\n")
+	if !strings.Contains(lines[1], "Item 1!▏x") {
+		t.Errorf("Expected the editor in the first row, got %q", lines[1])
+	}
+	for i := range lines {
+		if lipgloss.Width(lines[i]) != lipgloss.Width(before[i]) {
+			t.Errorf("Line %d changed width while editing: %q", i, lines[i])
+		}
+	}
+	if table.GetState().CursorIndex != 0 {
+		t.Error("Expected keys to stay in the editor")
+	}
+
+	msgs := press(tea.KeyMsg{Type: tea.KeyEnter})
+	expected := core.CellEditCommitMsg{RowID: "row-0", Field: "name", OldValue: "Item 1", NewValue: "Item 1!x"}
+	if len(msgs) != 1 || msgs[0] != expected || table.IsEditingCell() {
+		t.Errorf("Expected %+v, got %v", expected, msgs)
+	}
+
+	// Escape discards the edit
+	pumpMsgs(table, table.EditCell())
+	press(tea.KeyMsg{Type: tea.KeyBackspace})
+	if msgs := press(tea.KeyMsg{Type: tea.KeyEsc}); len(msgs) != 0 || table.IsEditingCell() {
+		t.Errorf("Expected escape to discard the edit, got %v", msgs)
+	}
+}
+
+func TestTable_ProgressBarFormatter(t *testing.T) {
+	format := func(opts core.ProgressBarOptions, value string, width int) string {
+		formatter := core.ProgressBarFormatter(opts)
+		return formatter(value, 0, core.TableColumn{Width: width}, core.RenderContext{}, false, false, false)
+	}
+
+	tests := []struct {
+		name     string
+		opts     core.ProgressBarOptions
+		value    string
+		width    int
+		expected string
+	}{
+		{"half", core.ProgressBarOptions{}, "50", 12, "████░░░░ 50%"},
+		{"uneven width", core.ProgressBarOptions{}, "50", 11, "████░░░ 50%"},
+		{"rounded", core.ProgressBarOptions{}, "33", 13, "███░░░░░░ 33%"},
+		{"clamped", core.ProgressBarOptions{}, "150%", 9, "████ 100%"},
+		{"no label", core.ProgressBarOptions{HideLabel: true}, "25", 7, "██░░░░░"},
+		{"right to left", core.ProgressBarOptions{HideLabel: true, RightToLeft: true}, "40", 5, "░░░██"},
+		{"custom runes", core.ProgressBarOptions{Filled: '=', Empty: '-'}, "75", 8, "===- 75%"},
+		{"wide runes", core.ProgressBarOptions{Filled: '🟩', Empty: '⬜', HideLabel: true}, "50", 7, "🟩🟩⬜ "},
+		{"no room for label", core.ProgressBarOptions{}, "50", 4, "██░░"},
+		{"not a number", core.ProgressBarOptions{}, "n/a", 10, "n/a"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := format(tt.opts, tt.value, tt.width)
+			if got != tt.expected {
+				t.Errorf("Expected %q, got %q", tt.expected, got)
+			}
+			if tt.value != "n/a" && lipgloss.Width(got) != tt.width {
+				t.Errorf("Expected width %d, got %d", tt.width, lipgloss.Width(got))
+			}
+		})
+	}
+
+	// Thresholds color the filled part only
+	previousProfile := lipgloss.ColorProfile()
+	lipgloss.SetColorProfile(termenv.ANSI256)
+	defer lipgloss.SetColorProfile(previousProfile)
+	opts := core.ProgressBarOptions{HideLabel: true, Thresholds: []core.ProgressThreshold{
+		{From: 80, Color: lipgloss.Color("2")},
+		{From: 0, Color: lipgloss.Color("1")},
+	}}
+	if got := format(opts, "90", 10); !strings.Contains(got, "\x1b[32m") || stripANSI(got) != "█████████░" {
+		t.Errorf("Expected a green bar, got %q", got)
+	}
+	if got := format(opts, "10", 10); !strings.Contains(got, "\x1b[31m") {
+		t.Errorf("Expected a red bar, got %q", got)
+	}
+}
+
+func TestTable_ColorProfileDegrade(t *testing.T) {
+	degradations := []struct {
+		color    string
+		profile  core.ColorProfile
+		expected string
+	}{
+		{"226", core.ColorProfileTrueColor, "226"},
+		{"226", core.ColorProfileANSI256, "226"},
+		{"226", core.ColorProfileANSI, "11"},
+		{"196", core.ColorProfileANSI, "9"},
+		{"57", core.ColorProfileANSI, "12"},
+		{"245", core.ColorProfileANSI, "8"},
+		{"235", core.ColorProfileANSI, "0"},
+		{"12", core.ColorProfileANSI, "12"},
+		{"#ff8700", core.ColorProfileANSI256, "208"},
+		{"#ff0000", core.ColorProfileANSI256, "196"},
+		{"#ff0000", core.ColorProfileANSI, "9"},
+		{"#eee", core.ColorProfileANSI256, "255"},
+		{"226", core.ColorProfileMono, ""},
+		{"#ff0000", core.ColorProfileMono, ""},
+		{"not-a-color", core.ColorProfileANSI, "not-a-color"},
+	}
+	for _, d := range degradations {
+		if got := core.DegradeColorString(d.color, d.profile); got != d.expected {
+			t.Errorf("%s on %s: expected %q, got %q", d.color, d.profile, d.expected, got)
+		}
+	}
+
+	// Mono keeps states visible with attributes
+	mono := config.DefaultTheme().Degrade(core.ColorProfileMono)
+	if _, ok := mono.CursorStyle.GetForeground().(lipgloss.NoColor); !ok || !mono.CursorStyle.GetReverse() {
+		t.Error("Expected a colorless reverse cursor in mono")
+	}
+	if _, ok := mono.SelectedStyle.GetBackground().(lipgloss.NoColor); !ok || !mono.SelectedStyle.GetBold() {
+		t.Error("Expected colorless bold selected rows in mono")
+	}
+	if mono.BorderColor != "" {
+		t.Errorf("Expected no border color in mono, got %q", mono.BorderColor)
+	}
+
+	// The table degrades its theme at construction and when it is replaced
+	cfg := config.DefaultTableConfig()
+	cfg.Columns = []core.TableColumn{{Title: "Name", Field: "name", Width: 10}}
+	cfg.ForceColorProfile = core.ColorProfileANSI
+	table := NewTable(cfg, NewTestDataSource(createTestRows(3)))
+	if table.config.Theme.BorderColor != "8" || table.config.ActiveCellBackgroundColor != "11" {
+		t.Errorf("Expected a 16-color theme, got border %q and active cell %q",
+			table.config.Theme.BorderColor, table.config.ActiveCellBackgroundColor)
+	}
+	pumpMsgs(table, table.SetTheme(config.DefaultTheme()))
+	if color := table.config.Theme.CursorStyle.GetForeground(); color != lipgloss.Color("13") {
+		t.Errorf("Expected the new theme degraded to 16 colors, got cursor %v", color)
+	}
+}
+
+func TestTable_RowStyleFunc(t *testing.T) {
+	previousProfile := lipgloss.ColorProfile()
+	lipgloss.SetColorProfile(termenv.TrueColor)
+	defer lipgloss.SetColorProfile(previousProfile)
+
+	const red = "38;2;255;0;0"
+	const stripe = "48;5;235"
+	errorStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("#ff0000"))
+
+	table := createTestTable(createTestRows(4))
+	pumpMsgs(table, core.RowStyleFuncSetCmd(func(row core.TableRow, index int, isCursor, isSelected bool) *lipgloss.Style {
+		if row.Cells[2] == "Status1" {
+			return &errorStyle
+		}
+		return nil
+	}))
+
+	lines := strings.Split(table.View(), "\n")
+	for i, line := range lines[1:] {
+		if styled := strings.Contains(line, red); styled != (i == 1) {
+			t.Errorf("Row %d: expected red styling %v, got %q", i, i == 1, line)
+		}
+	}
+
+	// With zebra striping, the stripe fills the background under the row style
+	pumpMsgs(table, core.ZebraStripingEnableCmd(true))
+	row := strings.Split(table.View(), "\n")[2]
+	if !strings.Contains(row, red) || !strings.Contains(row, stripe) {
+		t.Errorf("Expected the row style over the odd stripe, got %q", row)
+	}
+	if next := strings.Split(table.View(), "\n")[4]; strings.Contains(next, red) || !strings.Contains(next, stripe) {
+		t.Errorf("Expected the next odd row striped only, got %q", next)
+	}
+
+	// Cell formatters render on top of the row style
+	pumpMsgs(table, table.SetCellFormatter(0, func(value string, _ int, _ core.TableColumn, _ core.RenderContext, _, _, _ bool) string {
+		return lipgloss.NewStyle().Foreground(lipgloss.Color("#0000ff")).Render(value)
+	}))
+	row = strings.Split(table.View(), "\n")[2]
+	if blue := strings.Index(row, "38;2;0;0;255"); blue < 0 || blue < strings.Index(row, red) {
+		t.Errorf("Expected the formatter color inside the row style, got %q", row)
+	}
+
+	// Selection replaces the row style
+	pumpMsgs(table, core.SelectToggleCmd(1))
+	if row := strings.Split(table.View(), "\n")[2]; strings.Contains(row, red) {
+		t.Errorf("Expected the selection to replace the row style, got %q", row)
+	}
+}
+
+func TestTable_Marks(t *testing.T) {
+	rows := createTestRows(30)
+	dataSource := NewTestDataSource(rows)
+	table := createTestTable(rows)
+	table.dataSource = dataSource
+
+	pumpMsgs(table, core.JumpToCmd(25))
+	pumpMsgs(table, table.SetMark('a'))
+	if marks := table.GetMarks(); len(marks) != 1 || marks['a'] != "row-25" {
+		t.Fatalf("Expected mark a on row-25, got %v", marks)
+	}
+
+	// Marks follow the row, not the index, across refreshes
+	reversed := make([]core.TableRow, len(rows))
+	for i, row := range rows {
+		reversed[len(rows)-1-i] = row
+	}
+	dataSource.data = reversed
+	pumpMsgs(table, core.DataRefreshCmd())
+	pumpMsgs(table, core.JumpToCmd(0))
+
+	pumpMsgs(table, table.JumpToMark('a'))
+	if cursor := table.GetState().CursorIndex; cursor != 4 {
+		t.Errorf("Expected the cursor on row-25 at index 4, got %d", cursor)
+	}
+
+	// A mark whose row is gone, or that was never set, is reported
+	dataSource.data = append(append([]core.TableRow{}, reversed[:4]...), reversed[5:]...)
+	dataSource.totalItems = len(dataSource.data)
+	pumpMsgs(table, core.DataRefreshCmd())
+
+	for _, name := range []rune{'a', 'z'} {
+		var unavailable bool
+		cmd := table.JumpToMark(name)
+		for i := 0; i < 3 && cmd != nil; i++ {
+			var next []tea.Cmd
+			for _, msg := range collectMsgs(cmd) {
+				if msg == (core.MarkUnavailableMsg{Name: name}) {
+					unavailable = true
+				}
+				_, c := table.Update(msg)
+				next = append(next, c)
+			}
+			cmd = tea.Batch(next...)
+		}
+		if !unavailable {
+			t.Errorf("Expected mark %q to be reported unavailable", name)
+		}
+	}
+}
+
+func TestTable_AutoHeight(t *testing.T) {
+	table := createTestTable(createTestRows(30))
+	table.config.ViewportConfig.AutoHeight = true
+	table.config.ViewportConfig.ReservedLines = 2
+
+	resize := func(height int) []string {
+		_, cmd := table.Update(tea.WindowSizeMsg{Width: 80, Height: height})
+		pumpMsgs(table, cmd)
+		return strings.Split(table.View(), "\n")
+	}
+
+	// The header takes one line and two are reserved
+	if lines := resize(10); len(lines) != 8 {
+		t.Errorf("Expected a header and 7 rows, got %d lines", len(lines))
+	}
+
+	// Shrinking keeps the cursor in view
+	pumpMsgs(table, core.JumpToCmd(25))
+	lines := resize(5)
+	if len(lines) != 3 || !strings.Contains(strings.Join(lines, "\n"), "Item 26") {
+		t.Errorf("Expected 2 rows including the cursor, got %q", lines)
+	}
+
+	// Tiny terminals keep a single row
+	if lines := resize(1); len(lines) != 2 || !strings.Contains(lines[1], "Item 26") {
+		t.Errorf("Expected the cursor row alone, got %q", lines)
+	}
+
+	// Growing past the dataset shows every row
+	lines = resize(40)
+	if len(lines) != 31 || table.GetState().CursorIndex != 25 {
+		t.Errorf("Expected all 30 rows with the cursor kept, got %d lines and cursor %d", len(lines), table.GetState().CursorIndex)
+	}
+}
+
+func TestTable_ChangeHooks(t *testing.T) {
+	table := createTestTable(createTestRows(20))
+
+	var moves []string
+	var counts []int
+	table.config.OnCursorChange = func(oldIndex, newIndex int, row core.TableRow) {
+		moves = append(moves, fmt.Sprintf("%d->%d %s", oldIndex, newIndex, row.ID))
+	}
+	table.config.OnSelectionChange = func(selectedCount int) {
+		counts = append(counts, selectedCount)
+	}
+
+	// Moves fire once each; moves blocked at the start of the data do not
+	pumpMsgs(table, core.CursorUpCmd())
+	pumpMsgs(table, core.CursorDownCmd())
+	pumpMsgs(table, core.JumpToCmd(8))
+	pumpMsgs(table, core.JumpToCmd(8))
+	pumpMsgs(table, core.JumpToStartCmd())
+	pumpMsgs(table, core.CursorUpCmd())
+	expectedMoves := []string{"0->1 row-1", "1->8 row-8", "8->0 row-0"}
+	if fmt.Sprint(moves) != fmt.Sprint(expectedMoves) {
+		t.Errorf("Expected moves %v, got %v", expectedMoves, moves)
+	}
+
+	// Each selection change is reported once, after the chunks are reloaded
+	pumpMsgs(table, core.SelectToggleCmd(0))
+	pumpMsgs(table, core.SelectToggleCmd(3))
+	pumpMsgs(table, core.SelectClearCmd())
+	pumpMsgs(table, core.SelectClearCmd())
+	if fmt.Sprint(counts) != fmt.Sprint([]int{1, 2, 0}) {
+		t.Errorf("Expected selection counts [1 2 0], got %v", counts)
+	}
+}
+
+func TestTable_WrapNavigation(t *testing.T) {
+	table := createTestTable(createTestRows(25))
+
+	// Without the option the boundaries stop the cursor
+	pumpMsgs(table, core.CursorUpCmd())
+	if table.GetState().CursorIndex != 0 {
+		t.Fatal("Expected the cursor to stay at the start")
+	}
+
+	table.config.ViewportConfig.WrapNavigation = true
+	wrapped := func(cmd tea.Cmd) string {
+		var direction string
+		for i := 0; i < 10 && cmd != nil; i++ {
+			var next []tea.Cmd
+			for _, msg := range collectMsgs(cmd) {
+				if wrap, ok := msg.(core.NavigationWrappedMsg); ok {
+					direction = wrap.Direction
+				}
+				_, c := table.Update(msg)
+				next = append(next, c)
+			}
+			cmd = tea.Batch(next...)
+		}
+		return direction
+	}
+
+	if direction := wrapped(core.CursorUpCmd()); direction != "up" || table.GetState().CursorIndex != 24 {
+		t.Errorf("Expected to wrap up to the last row, got %q at %d", direction, table.GetState().CursorIndex)
+	}
+	if !strings.Contains(table.View(), "Item 25") {
+		t.Error("Expected the last row to be loaded and visible")
+	}
+
+	// Page navigation only wraps when configured separately
+	if direction := wrapped(core.PageDownCmd()); direction != "" || table.GetState().CursorIndex != 24 {
+		t.Errorf("Expected page down to stop at the end, got %q at %d", direction, table.GetState().CursorIndex)
+	}
+	if direction := wrapped(core.CursorDownCmd()); direction != "down" || table.GetState().CursorIndex != 0 {
+		t.Errorf("Expected to wrap down to the first row, got %q at %d", direction, table.GetState().CursorIndex)
+	}
+
+	table.config.ViewportConfig.WrapPageNavigation = true
+	if direction := wrapped(core.PageUpCmd()); direction != "up" || table.GetState().CursorIndex != 24 {
+		t.Errorf("Expected page up to wrap, got %q at %d", direction, table.GetState().CursorIndex)
+	}
+}
+
+func TestTable_InvalidRegexFilter(t *testing.T) {
+	// The table reports an invalid regex instead of applying it
+	table := createTestTable(createTestRows(5))
+	_, cmd := table.Update(core.FilterSetCmd("name", core.RegexFilter{Pattern: "["})())
+	if cmd == nil {
+		t.Fatal("Expected an error command for an invalid regex")
+	}
+	if _, ok := cmd().(core.DataLoadErrorMsg); !ok {
+		t.Error("Expected a DataLoadErrorMsg for an invalid regex")
+	}
+	if _, ok := table.filters["name"]; ok {
+		t.Error("Expected the invalid regex not to be applied")
+	}
+}
+
+func TestTable_FoldDiacriticsSearch(t *testing.T) {
+	// Searches follow the table's request
+	rows := createTestRows(5)
+	rows[3].Cells[0] = "Crème brûlée"
+	table := createTestTable(rows)
+	pumpMsgs(table, core.DataRequestSetCmd(core.DataRequest{FilterFoldDiacritics: true}))
+	if request := table.CurrentRequest(); !request.FilterFoldDiacritics {
+		t.Error("Expected the table's requests to carry FilterFoldDiacritics")
+	}
+	cmd, cancel := table.SearchAllAsync("CREME BRULEE", nil)
+	defer cancel()
+	var complete core.SearchCompleteMsg
+	for cmd != nil {
+		msg := cmd()
+		if m, ok := msg.(core.SearchCompleteMsg); ok {
+			complete = m
+		}
+		_, cmd = table.Update(msg)
+	}
+	if !slices.Equal(complete.Indices, []int{3}) {
+		t.Errorf("Expected the accent-insensitive search to find row 3, got %v", complete.Indices)
+	}
+}
+
+func TestTable_SortIndicators(t *testing.T) {
+	table := createTestTable(createTestRows(5))
+	table.config.ShowSortIndicators = true
+	plainWidth := lipgloss.Width(stripANSI(table.renderHeader()))
+
+	// The decoration is applied after header cell formatters
+	pumpMsgs(table, table.SetHeaderFormatter(0, func(column core.TableColumn, ctx core.RenderContext) string {
+		return strings.ToUpper(column.Title) + "-LONG-TITLE"
+	}))
+
+	pumpMsgs(table, core.SortSetCmd("name", "asc"))
+	header := stripANSI(table.renderHeader())
+	if !strings.Contains(header, "NAME-... ↑") || strings.Contains(header, "↑1") {
+		t.Errorf("Expected a single sort to show an arrow without a priority, got %q", header)
+	}
+
+	pumpMsgs(table, core.SortAddCmd("value", "desc"))
+	pumpMsgs(table, core.SortAddCmd("status", "asc"))
+	header = stripANSI(table.renderHeader())
+	for _, want := range []string{"NAME... ↑1", "Value ↓2", "Status  ↑3"} {
+		if !strings.Contains(header, want) {
+			t.Errorf("Expected header to contain %q, got %q", want, header)
+		}
+	}
+
+	// The decoration stays within the column widths
+	if lipgloss.Width(header) != plainWidth {
+		t.Errorf("Expected decorated header width %d to match %d", lipgloss.Width(header), plainWidth)
+	}
+}
+
+func TestTable_FooterRow(t *testing.T) {
+	table := createTestTable(createTestRows(10))
+	table.config.ShowBorders = true
+	table.config.ShowTopBorder = true
+	table.config.ShowBottomBorder = true
+	table.config.ShowHeaderSeparator = true
+	table.config.ShowFooterSeparator = true
+	pumpMsgs(table, table.Init())
+
+	renders := 0
+	pumpMsgs(table, core.FooterRowSetCmd(func(ctx core.RenderContext) core.TableRow {
+		renders++
+		return core.TableRow{Cells: []string{"Total", "450", "10 rows"}}
+	}))
+
+	lines := strings.Split(stripANSI(table.View()), "\n")
+	if renders != 1 {
+		t.Errorf("Expected the footer to be evaluated once per render, got %d", renders)
+	}
+	// Top border, header, separator, 5 rows, footer separator, footer, bottom border
+	if len(lines) != 11 {
+		t.Fatalf("Expected the footer on top of the viewport height, got %d lines:\n%s", len(lines), strings.Join(lines, "\n"))
+	}
+	footer := lines[9]
+	if !strings.Contains(footer, "Total") || !strings.Contains(footer, "450") || !strings.Contains(footer, "10 rows") {
+		t.Errorf("Expected the footer row, got %q", footer)
+	}
+	if lines[8] != lines[2] || !strings.HasPrefix(lines[8], "├") || !strings.HasSuffix(lines[8], "┤") {
+		t.Errorf("Expected the footer separator to join the borders like the header separator, got %q", lines[8])
+	}
+	if !strings.HasPrefix(lines[10], "└") || lipgloss.Width(footer) != lipgloss.Width(lines[10]) {
+		t.Errorf("Expected the bottom border below the footer, got %q", lines[10])
+	}
+
+	// The footer takes no part in navigation
+	pumpMsgs(table, core.JumpToEndCmd())
+	if table.GetState().CursorIndex != 9 {
+		t.Errorf("Expected the cursor to stop at the last data row, got %d", table.GetState().CursorIndex)
+	}
+
+	pumpMsgs(table, core.FooterRowSetCmd(nil))
+	if strings.Contains(stripANSI(table.View()), "Total") {
+		t.Error("Expected a nil footer function to remove the footer")
+	}
+}
+
+func TestTable_PersistStateRoundTrip(t *testing.T) {
+	source := createTestTable(createTestRows(40))
+	pumpMsgs(source, source.Init())
+	pumpMsgs(source, core.SortSetCmd("value", "desc"))
+	pumpMsgs(source, core.FilterSetCmd("name", core.RegexFilter{Pattern: `^Item \d+$`}))
+	pumpMsgs(source, source.dataSource.SetSelectedByID("row-3", true))
+	pumpMsgs(source, source.dataSource.SetSelectedByID("row-30", true))
+	pumpMsgs(source, core.JumpToCmd(25))
+	pumpMsgs(source, source.SetColumnWidth(0, 14))
+	source.currentColumn = 2
+	source.horizontalScrollOffsets[2] = 3
+
+	saved := source.SaveState()
+	encoded, err := json.Marshal(saved)
+	if err != nil {
+		t.Fatalf("Expected the state to marshal, got %v", err)
+	}
+	var decoded core.PersistedState
+	if err := json.Unmarshal(encoded, &decoded); err != nil {
+		t.Fatalf("Expected the state to unmarshal, got %v", err)
+	}
+	if decoded.CursorID != "row-25" || len(decoded.SelectedIDs) != 2 {
+		t.Errorf("Unexpected saved state %+v", decoded)
+	}
+
+	target := createTestTable(createTestRows(40))
+	pumpMsgs(target, target.Init())
+	pumpMsgs(target, target.RestoreState(decoded))
+
+	if target.GetState().CursorIndex != 25 {
+		t.Errorf("Expected the cursor restored to 25, got %d", target.GetState().CursorIndex)
+	}
+	if fields, dirs := target.GetSort(); len(fields) != 1 || fields[0] != "value" || dirs[0] != "desc" {
+		t.Errorf("Expected the sort restored, got %v %v", fields, dirs)
+	}
+	if filter, ok := target.filters["name"].(core.RegexFilter); !ok || filter.Pattern != `^Item \d+$` {
+		t.Errorf("Expected the regex filter restored, got %#v", target.filters["name"])
+	}
+	if target.config.Columns[0].Width != 14 || target.currentColumn != 2 || target.horizontalScrollOffsets[2] != 3 {
+		t.Errorf("Expected columns restored, got width %d column %d offsets %v",
+			target.config.Columns[0].Width, target.currentColumn, target.horizontalScrollOffsets)
+	}
+	selected := target.dataSource.(*TestDataSource).selectedItems
+	if len(selected) != 2 || !selected["row-3"] || !selected["row-30"] {
+		t.Errorf("Expected the selection restored, got %v", selected)
+	}
+
+	// A row that is gone leaves the cursor at the nearest valid index
+	shorter := createTestTable(createTestRows(20))
+	pumpMsgs(shorter, shorter.Init())
+	pumpMsgs(shorter, shorter.RestoreState(decoded))
+	if shorter.GetState().CursorIndex != 19 {
+		t.Errorf("Expected the cursor clamped to 19, got %d", shorter.GetState().CursorIndex)
+	}
+
+	// The row is found by ID when it moved
+	moved := createTestRows(40)
+	moved[25], moved[5] = moved[5], moved[25]
+	relocated := createTestTable(moved)
+	pumpMsgs(relocated, relocated.Init())
+	pumpMsgs(relocated, relocated.RestoreState(decoded))
+	if relocated.GetState().CursorIndex != 5 {
+		t.Errorf("Expected the cursor to follow the row to 5, got %d", relocated.GetState().CursorIndex)
+	}
+}
+
+func TestTable_MoveColumn(t *testing.T) {
+	table := createTestTable(createTestRows(25))
+	pumpMsgs(table, table.Init())
+	pumpMsgs(table, table.SetCellFormatter(1, func(cellValue string, rowIndex int, column core.TableColumn, ctx core.RenderContext, isCursor, isSelected, isActiveCell bool) string {
+		return "$" + cellValue
+	}))
+	table.currentColumn = 1
+
+	_, cmd := table.Update(core.MoveColumnRightCmd()())
+	if moved, ok := cmd().(core.ColumnMovedMsg); !ok || moved.From != 1 || moved.To != 2 {
+		t.Errorf("Expected a ColumnMovedMsg from 1 to 2, got %#v", moved)
+	}
+	if table.currentColumn != 2 || table.config.Columns[2].Field != "value" {
+		t.Errorf("Expected the value column to move right and stay active, got column %d", table.currentColumn)
+	}
+
+	// Cells and the formatter follow their column
+	view := stripANSI(table.View())
+	if !strings.Contains(view, "Status0") || !regexp.MustCompile(`Status0\s*│\s*\$0`).MatchString(view) {
+		t.Errorf("Expected the value column after the status column, got:\n%s", view)
+	}
+
+	// Rows loaded after the move are arranged too
+	pumpMsgs(table, core.JumpToEndCmd())
+	if !regexp.MustCompile(`Item 25\s*│\s*Status0\s*│\s*\$240`).MatchString(stripANSI(table.View())) {
+		t.Errorf("Expected newly loaded rows in column order, got:\n%s", stripANSI(table.View()))
+	}
+
+	var buf bytes.Buffer
+	if _, err := table.ExportCSV(&buf); err != nil {
+		t.Fatal(err)
+	}
+	if !strings.HasPrefix(buf.String(), "Name,Status,Value\r\nItem 1,Status0,$0\r\n") {
+		t.Errorf("Expected the export in column order, got %q", buf.String())
+	}
+
+	// Moving past the edge does nothing
+	pumpMsgs(table, core.MoveColumnRightCmd())
+	if table.currentColumn != 2 {
+		t.Errorf("Expected the last column to stay put, got %d", table.currentColumn)
+	}
+	pumpMsgs(table, core.MoveColumnLeftCmd())
+	pumpMsgs(table, core.MoveColumnLeftCmd())
+	if table.currentColumn != 0 || table.config.Columns[0].Field != "value" || table.columnCells[0] != 1 {
+		t.Errorf("Expected the value column first, got %+v", table.config.Columns)
+	}
+}
+
+func TestTable_FormattersByField(t *testing.T) {
+	table := createTestTable(createTestRows(5))
+	pumpMsgs(table, table.Init())
+	prefix := func(p string) core.SimpleCellFormatter {
+		return func(cellValue string, rowIndex int, column core.TableColumn, ctx core.RenderContext, isCursor, isSelected, isActiveCell bool) string {
+			return p + cellValue
+		}
+	}
+	pumpMsgs(table, tea.Batch(
+		core.CellFormatterSetCmd(0, prefix("#")),
+		core.CellFormatterSetByFieldCmd("status", prefix("~")),
+		core.CellFormatterSetCmd(2, prefix("!")),
+		core.HeaderFormatterSetByFieldCmd("value", func(column core.TableColumn, ctx core.RenderContext) string {
+			return "AMOUNT"
+		}),
+	))
+
+	view := stripANSI(table.View())
+	if !regexp.MustCompile(`#Item 1\s*│\s*0\s*│\s*~Status0`).MatchString(view) || !strings.Contains(view, "AMOUNT") {
+		t.Errorf("Expected the field formatter to win over the index formatter, got:\n%s", view)
+	}
+
+	// Formatters stay with their field when the status column moves to the front
+	table.currentColumn = 2
+	pumpMsgs(table, tea.Sequence(core.MoveColumnLeftCmd(), core.MoveColumnLeftCmd()))
+	view = stripANSI(table.View())
+	if !regexp.MustCompile(`~Status0\s*│\s*#Item 1\s*│\s*0`).MatchString(view) || !regexp.MustCompile(`Name\s*│\s*AMOUNT`).MatchString(view) {
+		t.Errorf("Expected formatters to follow their field, got:\n%s", view)
+	}
+
+	pumpMsgs(table, core.CellFormatterSetByFieldCmd("status", nil))
+	if !regexp.MustCompile(`!Status0\s*│\s*#Item 1`).MatchString(stripANSI(table.View())) {
+		t.Errorf("Expected the index formatter once the field formatter is removed, got:\n%s", stripANSI(table.View()))
+	}
+}
+
+func TestTable_ColumnVisibility(t *testing.T) {
+	table := createTestTable(createTestRows(5))
+	pumpMsgs(table, table.Init())
+	table.currentColumn = 1
+
+	pumpMsgs(table, core.ColumnVisibilityCmd("value", false))
+	if table.currentColumn != 2 {
+		t.Errorf("Expected the active column to move to the next visible column, got %d", table.currentColumn)
+	}
+
+	lines := strings.Split(stripANSI(table.View()), "\n")
+	if strings.Contains(lines[0], "Value") || strings.Contains(lines[2], "10") {
+		t.Errorf("Expected the value column to be hidden, got:\n%s", strings.Join(lines, "\n"))
+	}
+	separators := func(line string) []int {
+		var at []int
+		for i, r := range []rune(line) {
+			if r == '│' {
+				at = append(at, i)
+			}
+		}
+		return at
+	}
+	for _, line := range lines[1:] {
+		if line != "" && fmt.Sprint(separators(line)) != fmt.Sprint(separators(lines[0])) {
+			t.Errorf("Expected the remaining columns to line up with the header, got:\n%s", strings.Join(lines, "\n"))
+			break
+		}
+	}
+
+	// Navigation skips the hidden column
+	pumpMsgs(table, core.PrevColumnCmd())
+	if table.currentColumn != 0 {
+		t.Errorf("Expected to skip the hidden column, got %d", table.currentColumn)
+	}
+
+	pumpMsgs(table, core.ColumnVisibilityCmd("value", true))
+	if !strings.Contains(stripANSI(table.View()), "Value") || len(table.GetHiddenColumns()) != 0 {
+		t.Errorf("Expected the value column back, got:\n%s", stripANSI(table.View()))
+	}
+}
+
+func TestTable_LoadingPlaceholder(t *testing.T) {
+	table := createTestTable(createTestRows(40))
+	table.config.ViewportConfig.LoadingPlaceholder = core.SpinnerLoadingPlaceholder("-", "+")
+	table.config.ViewportConfig.LoadingAnimationInterval = time.Millisecond
+	pumpMsgs(table, table.Init())
+
+	_, cmd := table.Update(core.JumpToCmd(25)())
+	if !table.IsIndexLoading(25) || table.IsIndexLoading(5) {
+		t.Fatalf("Expected only the chunk of row 25 to be in flight")
+	}
+	if view := stripANSI(table.View()); !strings.Contains(view, "- Loading…") {
+		t.Errorf("Expected the spinner placeholder while loading, got:\n%s", view)
+	}
+	table.Update(core.LoadingTickMsg{})
+	if view := stripANSI(table.View()); !strings.Contains(view, "+ Loading…") {
+		t.Errorf("Expected the spinner to advance on tick, got:\n%s", view)
+	}
+
+	for _, msg := range collectMsgs(cmd) {
+		loaded, ok := msg.(core.DataChunkLoadedMsg)
+		if !ok {
+			continue
+		}
+		if !strings.Contains(stripANSI(table.View()), "Loading…") {
+			t.Errorf("Expected the placeholder until the chunk arrives")
+		}
+		table.Update(loaded)
+	}
+
+	view := stripANSI(table.View())
+	if strings.Contains(view, "Loading…") || !strings.Contains(view, "Item 26") || table.IsIndexLoading(25) {
+		t.Errorf("Expected the rows once the chunk completed, got:\n%s", view)
+	}
+	if _, cmd := table.Update(core.LoadingTickMsg{}); cmd != nil {
+		t.Errorf("Expected the animation to stop once nothing is loading")
+	}
+}
+
+func TestTable_MaxSelections(t *testing.T) {
+	table := createTestTable(createTestRows(10))
+	table.config.MaxSelections = 2
+	pumpMsgs(table, table.Init())
+	source := table.dataSource.(*TestDataSource)
+
+	var evicted []string
+	selectAt := func(index int) {
+		pumpMsgs(table, core.JumpToCmd(index))
+		for _, msg := range collectMsgs(core.SelectCurrentCmd()) {
+			_, cmd := table.Update(msg)
+			for _, msg := range collectMsgs(cmd) {
+				if e, ok := msg.(core.SelectionEvictedMsg); ok {
+					evicted = append(evicted, e.ID)
+				}
+				pumpMsgs(table, func() tea.Msg { return msg })
+			}
+		}
+	}
+
+	selectAt(0)
+	selectAt(1)
+	selectAt(0) // deselect row 0, leaving row 1 as the oldest
+	selectAt(2)
+	selectAt(3)
+	selectAt(4)
+
+	if got := strings.Join(evicted, ","); got != "row-1,row-2" {
+		t.Errorf("Expected the least recently selected rows to be evicted in order, got %q", got)
+	}
+	if got := strings.Join(source.GetSelectedIDs(), ","); got != "row-3,row-4" {
+		t.Errorf("Expected the data source to keep the two newest selections, got %q", got)
+	}
+
+	pumpMsgs(table, core.SelectAllCmd())
+	if len(source.GetSelectedIDs()) != 2 {
+		t.Errorf("Expected SelectAll to be ignored with a cap, got %v", source.GetSelectedIDs())
+	}
+}
+
+func TestTable_JumpToMatch(t *testing.T) {
+	table := createTestTable(createTestRows(30))
+	pumpMsgs(table, table.Init())
+
+	pred := func(row core.TableRow) bool {
+		value, _ := strconv.Atoi(row.Cells[1])
+		return row.Cells[2] == "Status2" && value >= 100
+	}
+
+	var progress []core.MatchSearchProgressMsg
+	run := func(cmd tea.Cmd) {
+		for cmd != nil {
+			msgs := collectMsgs(cmd)
+			cmd = nil
+			for _, msg := range msgs {
+				if p, ok := msg.(core.MatchSearchProgressMsg); ok {
+					progress = append(progress, p)
+				}
+				var next tea.Cmd
+				_, next = table.Update(msg)
+				cmd = tea.Batch(cmd, next)
+			}
+		}
+	}
+
+	run(table.JumpToMatch(pred))
+	if table.viewport.CursorIndex != 11 {
+		t.Errorf("Expected the first match at 11, got %d", table.viewport.CursorIndex)
+	}
+	if len(progress) != 1 || progress[0].Scanned != 10 || progress[0].Total != 30 {
+		t.Errorf("Expected one progress message after the first chunk, got %+v", progress)
+	}
+
+	run(table.JumpToNextMatch(pred))
+	if table.viewport.CursorIndex != 14 {
+		t.Errorf("Expected the next match at 14, got %d", table.viewport.CursorIndex)
+	}
+	run(table.JumpToPrevMatch(pred))
+	if table.viewport.CursorIndex != 11 {
+		t.Errorf("Expected the previous match at 11, got %d", table.viewport.CursorIndex)
+	}
+
+	pumpMsgs(table, core.JumpToCmd(29))
+	run(table.JumpToNextMatch(pred))
+	if table.viewport.CursorIndex != 29 {
+		t.Errorf("Expected the cursor to stay without a later match, got %d", table.viewport.CursorIndex)
+	}
+}
+
+func TestTable_PagedMode(t *testing.T) {
+	table := createTestTable(createTestRows(23))
+	table.config.ViewportConfig.PagedMode = true
+	table.config.ShowPageIndicator = true
+	pumpMsgs(table, table.Init())
+
+	for range 4 {
+		pumpMsgs(table, core.PageDownCmd())
+	}
+	if page, pages, size := table.GetPageInfo(); page != 5 || pages != 5 || size != 5 {
+		t.Errorf("Expected page 5/5 of 5 rows, got %d/%d of %d", page, pages, size)
+	}
+	if table.viewport.CursorIndex != 20 || table.viewport.ViewportStartIndex != 20 {
+		t.Errorf("Expected the cursor at the top of the last page, got %+v", table.viewport)
+	}
+	view := stripANSI(table.View())
+	if strings.Contains(view, "Item 20 ") || !strings.Contains(view, "Item 23") || !strings.Contains(view, "Page 5/5") {
+		t.Errorf("Expected the partial last page alone, got:\n%s", view)
+	}
+
+	// The partial last page stops at its last row
+	for range 3 {
+		pumpMsgs(table, core.CursorDownCmd())
+	}
+	pumpMsgs(table, core.PageDownCmd())
+	if table.viewport.CursorIndex != 22 || table.viewport.ViewportStartIndex != 20 {
+		t.Errorf("Expected the cursor to stay on the last row, got %+v", table.viewport)
+	}
+
+	// Moving past the page top turns the page
+	for range 3 {
+		pumpMsgs(table, core.CursorUpCmd())
+	}
+	if table.viewport.CursorIndex != 19 || table.viewport.ViewportStartIndex != 15 || table.viewport.CursorViewportIndex != 4 {
+		t.Errorf("Expected the previous page with the cursor on its last row, got %+v", table.viewport)
+	}
+	pumpMsgs(table, core.PageUpCmd())
+	if table.viewport.CursorIndex != 10 || table.viewport.ViewportStartIndex != 10 {
+		t.Errorf("Expected the top of page 3, got %+v", table.viewport)
+	}
+
+	// A smaller total shrinks the page count
+	source := table.dataSource.(*TestDataSource)
+	source.data = source.data[:8]
+	source.totalItems = 8
+	pumpMsgs(table, core.DataRefreshCmd())
+	if _, pages, _ := table.GetPageInfo(); pages != 2 || !strings.Contains(stripANSI(table.View()), "/2") {
+		t.Errorf("Expected 2 pages after the total shrank, got %d", pages)
+	}
+}
+
+func TestNew_Options(t *testing.T) {
+	columns := []core.TableColumn{{Title: "Name", Field: "name", Width: 10}}
+	theme := config.DefaultTheme()
+	theme.BorderColor = "1"
+
+	tests := []struct {
+		name   string
+		option Option
+		set    func(cfg *core.TableConfig)
+	}{
+		{"columns", WithColumns(columns...), func(cfg *core.TableConfig) { cfg.Columns = columns }},
+		{"height", WithViewportHeight(7), func(cfg *core.TableConfig) { cfg.ViewportConfig.Height = 7 }},
+		{"thresholds", WithThresholds(-1, 1), func(cfg *core.TableConfig) {
+			cfg.ViewportConfig.TopThreshold, cfg.ViewportConfig.BottomThreshold = -1, 1
+		}},
+		{"chunk size", WithChunkSize(50), func(cfg *core.TableConfig) { cfg.ViewportConfig.ChunkSize = 50 }},
+		{"selection", WithSelectionMode(core.SelectionMultiple), func(cfg *core.TableConfig) { cfg.SelectionMode = core.SelectionMultiple }},
+		{"theme", WithTheme(theme), func(cfg *core.TableConfig) { cfg.Theme = theme }},
+	}
+	for _, tt := range tests {
+		got, want := config.DefaultTableConfig(), config.DefaultTableConfig()
+		if err := tt.option(&got); err != nil {
+			t.Fatalf("%s: unexpected error %v", tt.name, err)
+		}
+		tt.set(&want)
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("%s: expected the option to set only its field", tt.name)
+		}
+	}
+
+	source := NewTestDataSource(createTestRows(3))
+	if _, err := New(source, WithColumns(columns...), WithChunkSize(0)); err == nil {
+		t.Error("Expected an error for a non-positive chunk size")
+	}
+	if _, err := New(source); err == nil {
+		t.Error("Expected an error without columns")
+	}
+	table, err := New(source, WithColumns(columns...), WithViewportHeight(3), WithThresholds(0, 0))
+	if err != nil {
+		t.Fatal(err)
+	}
+	pumpMsgs(table, table.Init())
+	if !strings.Contains(stripANSI(table.View()), "Item 3") {
+		t.Errorf("Expected a working table, got:\n%s", stripANSI(table.View()))
+	}
+}
+
+func TestTable_HorizontalScrollIndicator(t *testing.T) {
+	rows := createTestRows(3)
+	rows[0].Cells[0] = "A long description of twenty eight chars"[:28]
+	rows[1].Cells[0] = "Longer text in the second row, forty two c"
+	table := createTestTable(rows)
+	table.config.ShowHorizontalScrollIndicator = true
+	pumpMsgs(table, table.Init())
+
+	indicator := func() string {
+		lines := strings.Split(stripANSI(table.View()), "\n")
+		return lines[len(lines)-1]
+	}
+
+	// At the start only the right arrow shows
+	if start, end, total := table.GetHorizontalScrollRange(); start != 0 || end != 10 || total != 28 {
+		t.Errorf("Expected range 0-10 of 28, got %d-%d of %d", start, end, total)
+	}
+	if line := indicator(); !strings.Contains(line, "chars 1–10 of 28 ▶") || strings.Contains(line, "◀") {
+		t.Errorf("Expected only a right arrow, got %q", line)
+	}
+
+	for i := 0; i < 5; i++ {
+		table.Update(core.HorizontalScrollRightMsg{})
+	}
+	if line := indicator(); !strings.Contains(line, "◀ chars 6–15 of 28 ▶") {
+		t.Errorf("Expected both arrows, got %q", line)
+	}
+
+	for i := 0; i < 13; i++ {
+		table.Update(core.HorizontalScrollRightMsg{})
+	}
+	if line := indicator(); !strings.Contains(line, "◀ chars 19–28 of 28") || strings.Contains(line, "▶") {
+		t.Errorf("Expected only a left arrow at the end, got %q", line)
+	}
+
+	// The all rows scope measures the longest cell sharing the offset
+	table.Update(core.HorizontalScrollScopeToggleMsg{})
+	if _, _, total := table.GetHorizontalScrollRange(); total != 42 {
+		t.Errorf("Expected the longest cell in the all rows scope, got %d chars", total)
+	}
+
+	// In word mode the range starts at the first word the cell shows
+	table.Update(core.HorizontalScrollResetMsg{})
+	table.Update(core.HorizontalScrollScopeToggleMsg{})
+	table.Update(core.HorizontalScrollModeToggleMsg{})
+	table.Update(core.HorizontalScrollWordRightMsg{})
+	start, end, _ := table.GetHorizontalScrollRange()
+	if shown := rows[0].Cells[0][start : start+5]; start == 0 || !strings.Contains(stripANSI(table.View()), shown) {
+		t.Errorf("Expected the range %d-%d to match the shown words", start, end)
+	}
+
+	lines := table.chromeLines()
+	table.config.ShowHorizontalScrollIndicator = false
+	if table.chromeLines() != lines-1 {
+		t.Error("Expected the indicator to count as a chrome line")
+	}
+}
+
+func TestTable_CheckboxColumn(t *testing.T) {
+	columns := []core.TableColumn{
+		{Title: "Region", Field: "region", Width: 14},
+		{Title: "Amount", Field: "amount", Width: 8, Alignment: core.AlignRight},
+	}
+	rows := []core.TableRow{
+		{ID: "1", Cells: []string{"US", "5"}},
+		{ID: "2", Cells: []string{"EU", "10"}},
+		{ID: "3", Cells: []string{"US", "1"}},
+		{ID: "4", Cells: []string{"EU", "20"}},
+	}
+
+	cfg := config.DefaultTableConfig()
+	cfg.Columns = columns
+	cfg.GroupBy = []string{"region"}
+	cfg.ShowCheckboxColumn = true
+	cfg.MouseEnabled = true
+	cfg.SelectionMode = core.SelectionMultiple
+	cfg.ViewportConfig.Height = 10
+	cfg.ViewportConfig.ChunkSize = 20
+
+	dataSource := &GroupingTestDataSource{TestDataSource: NewTestDataSource(nil), rows: rows, columns: columns}
+	table := NewTable(cfg, dataSource)
+	table.Focus()
+	pumpMsgs(table, table.Init())
+
+	lineOf := func(text string) string {
+		for _, line := range strings.Split(stripANSI(table.View()), "\n") {
+			if strings.Contains(line, text) {
+				return line
+			}
+		}
+		t.Fatalf("%q not rendered:\n%s", text, stripANSI(table.View()))
+		return ""
+	}
+
+	// Rows: EU header, 10, 20, US header, 5, 1
+	if line := lineOf("EU (2)"); !strings.Contains(line, "[ ]") {
+		t.Errorf("Expected an unchecked group header, got %q", line)
+	}
+
+	pumpMsgs(table, core.SelectToggleCmd(1))
+	if line := lineOf("EU (2)"); !strings.Contains(line, "[-]") {
+		t.Errorf("Expected an indeterminate group header, got %q", line)
+	}
+	if line := lineOf("10│"); !strings.Contains(line, "[x]") {
+		t.Errorf("Expected a checked row, got %q", line)
+	}
+
+	pumpMsgs(table, core.SelectToggleCmd(2))
+	if line := lineOf("EU (2)"); !strings.Contains(line, "[x]") {
+		t.Errorf("Expected a checked group header, got %q", line)
+	}
+
+	// Clicking the US header checkbox selects its rows
+	y := 0
+	for i, line := range strings.Split(stripANSI(table.View()), "\n") {
+		if strings.Contains(line, "US (2)") {
+			y = i
+		}
+	}
+	pumpMsgs(table, func() tea.Msg {
+		return tea.MouseMsg{X: 2, Y: y, Button: tea.MouseButtonLeft, Action: tea.MouseActionPress}
+	})
+	if line := lineOf("US (2)"); !strings.Contains(line, "[x]") {
+		t.Errorf("Expected a click to check the group, got %q", line)
+	}
+	if got := len(dataSource.GetSelectedIDs()); got != 4 {
+		t.Errorf("Expected 4 selected rows, got %d", got)
+	}
+}
+
+func TestTable_SkipDisabled(t *testing.T) {
+	previousProfile := lipgloss.ColorProfile()
+	lipgloss.SetColorProfile(termenv.TrueColor)
+	defer lipgloss.SetColorProfile(previousProfile)
+
+	table := createTestTable(createTestRows(8))
+	source := table.dataSource.(*TestDataSource)
+	source.disabledItems = map[string]bool{"row-2": true, "row-3": true, "row-4": true, "row-7": true}
+	table.config.ViewportConfig.SkipDisabled = true
+	pumpMsgs(table, core.DataRefreshCmd())
+
+	// Down from row 1 jumps over the block of disabled rows
+	pumpMsgs(table, core.CursorDownCmd())
+	pumpMsgs(table, core.CursorDownCmd())
+	if got := table.GetState().CursorIndex; got != 5 {
+		t.Fatalf("Expected the cursor to skip to row 5, got %d", got)
+	}
+	pumpMsgs(table, core.CursorUpCmd())
+	if got := table.GetState().CursorIndex; got != 1 {
+		t.Errorf("Expected the cursor back on row 1, got %d", got)
+	}
+
+	// With only disabled rows below, the cursor stays put
+	pumpMsgs(table, core.JumpToCmd(6))
+	_, cmd := table.Update(core.CursorDownMsg{})
+	if got := table.GetState().CursorIndex; got != 6 {
+		t.Errorf("Expected the cursor to stay on row 6, got %d", got)
+	}
+	if msgs := collectMsgs(cmd); len(msgs) != 1 || msgs[0] != (core.BoundaryReachedMsg{AtStart: false}) {
+		t.Errorf("Expected a boundary message, got %v", msgs)
+	}
+
+	// Disabled rows cannot be selected, even when the cursor lands on them
+	table.config.ViewportConfig.SkipDisabled = false
+	pumpMsgs(table, core.CursorDownCmd())
+	_, cmd = table.Update(core.SelectCurrentMsg{})
+	if msgs := collectMsgs(cmd); len(msgs) != 1 || msgs[0] != (core.SelectionRejectedMsg{Index: 7, Reason: "disabled"}) {
+		t.Errorf("Expected the selection to be rejected, got %v", msgs)
+	}
+	if len(source.GetSelectedIDs()) != 0 {
+		t.Error("Expected no selection on a disabled row")
+	}
+
+	// Disabled rows are dimmed with the DisabledStyle
+	table.config.Theme.DisabledStyle = lipgloss.NewStyle().Strikethrough(true)
+	pumpMsgs(table, core.JumpToCmd(0))
+	view := table.View()
+	if !strings.Contains(view, lipgloss.NewStyle().Strikethrough(true).Render("Item 3    ")) {
+		t.Errorf("Expected the disabled row to be styled:\n%q", view)
+	}
+}
+
+// retryTestDataSource is a TestDataSource whose errored items load fine once
+// retried
+type retryTestDataSource struct {
+	*TestDataSource
+}
+
+func (ds *retryTestDataSource) RetryItem(id string) tea.Cmd {
+	for _, row := range ds.data {
+		if row.ID == id {
+			delete(ds.erroredItems, id)
+			return core.ItemRetryResultCmd(id, core.Data[any]{ID: id, Item: row}, nil)
+		}
+	}
+	return core.ItemRetryResultCmd(id, core.Data[any]{}, fmt.Errorf("item not found: %s", id))
+}
+
+func TestTable_RetryErroredItems(t *testing.T) {
+	previousProfile := lipgloss.ColorProfile()
+	lipgloss.SetColorProfile(termenv.TrueColor)
+	defer lipgloss.SetColorProfile(previousProfile)
+
+	table := createTestTable(createTestRows(5))
+	source := &retryTestDataSource{table.dataSource.(*TestDataSource)}
+	source.erroredItems = map[string]error{"row-1": fmt.Errorf("timeout"), "row-3": fmt.Errorf("timeout")}
+	table.dataSource = source
+	table.config.Theme.ErrorRowStyle = lipgloss.NewStyle().Strikethrough(true)
+	pumpMsgs(table, core.DataRefreshCmd())
+
+	errored := func(name string) bool {
+		return strings.Contains(table.View(), lipgloss.NewStyle().Strikethrough(true).Render(name+"    "))
+	}
+	if !errored("Item 2") || !errored("Item 4") {
+		t.Fatalf("Expected errored rows to be styled:\n%q", table.View())
+	}
+	if !strings.Contains(stripANSI(table.View()), errorRowGlyph+" ") {
+		t.Errorf("Expected the error glyph in the indicator column:\n%s", stripANSI(table.View()))
+	}
+
+	// Retrying one item clears the error of that row only
+	pumpMsgs(table, core.RetryItemCmd("row-1"))
+	if errored("Item 2") {
+		t.Error("Expected the retried row to lose its error styling")
+	}
+	if !errored("Item 4") {
+		t.Error("Expected the other errored row to keep its error styling")
+	}
+
+	// Retrying all errored items clears the rest
+	pumpMsgs(table, core.RetryAllErroredCmd())
+	if strings.Contains(stripANSI(table.View()), errorRowGlyph) {
+		t.Errorf("Expected no errored rows left:\n%s", stripANSI(table.View()))
+	}
+
+	// A failed retry is reported and leaves the item alone
+	_, cmd := table.Update(core.RetryItemMsg{ID: "missing"})
+	if msgs := collectMsgs(cmd); len(msgs) != 1 || msgs[0].(core.ItemRetryResultMsg).Err == nil {
+		t.Errorf("Expected a failed retry result, got %v", msgs)
+	}
+}
+
+func TestTable_SmartScrollGraphemes(t *testing.T) {
+	text := "ok 東京タワー 👨‍👩‍👧 fooBar cafe\u0301!"
+	clusters := splitGraphemes(text)
+	if len(clusters) != 23 || clusters[9].text != "👨‍👩‍👧" || clusters[21].text != "e\u0301" {
+		t.Fatalf("Expected emoji and combining sequences to stay whole, got %d clusters", len(clusters))
+	}
+	// Every CJK character and the emoji sequence start a word; camelCase humps count too
+	if got, want := smartScrollBoundaries(text), []int{0, 3, 4, 5, 9, 11, 14, 18}; !slices.Equal(got, want) {
+		t.Errorf("Expected boundaries %v, got %v", want, got)
+	}
+
+	rows := createTestRows(1)
+	rows[0].Cells[0] = text
+	table := createTestTable(rows)
+	table.Update(core.HorizontalScrollModeToggleMsg{})
+	table.Update(core.HorizontalScrollModeToggleMsg{})
+
+	header := strings.Split(stripANSI(table.View()), "\n")[0]
+	for step := 0; step < 8; step++ {
+		row := strings.Split(stripANSI(table.View()), "\n")[1]
+		if lipgloss.Width(row) != lipgloss.Width(header) {
+			t.Errorf("Step %d: expected the row to keep the header width, got %q", step, row)
+		}
+		cell := strings.Split(row, "│")[2]
+		if lipgloss.Width(cell) != 10 {
+			t.Errorf("Step %d: expected a 10 cell window, got %q", step, cell)
+		}
+		if strings.Contains(cell, "👨") && !strings.Contains(cell, "👨‍👩‍👧") {
+			t.Errorf("Step %d: expected the emoji sequence not to be split, got %q", step, cell)
+		}
+		if strings.ContainsRune(cell, '\u0301') && !strings.Contains(cell, "e\u0301") {
+			t.Errorf("Step %d: expected the combining mark to stay on its letter, got %q", step, cell)
+		}
+		table.Update(core.HorizontalScrollSmartRightMsg{})
+	}
+	if got := table.horizontalScrollOffsets[0]; got != 7 {
+		t.Errorf("Expected smart scrolling to stop at the last boundary, got %d", got)
+	}
+
+	table.Update(core.HorizontalScrollSmartLeftMsg{})
+	if got := table.horizontalScrollOffsets[0]; got != 6 {
+		t.Errorf("Expected smart scrolling left to step back one boundary, got %d", got)
+	}
+}
+
+func TestTable_AnimateChanges(t *testing.T) {
+	// Rows shifted by insertions are not moved, reordered ones are
+	diff := diffRowIDs([]string{"a", "b", "c", "d"}, []string{"x", "a", "c", "b"})
+	if !slices.Equal(diff.Added, []string{"x"}) || !slices.Equal(diff.Removed, []string{"d"}) || !slices.Equal(diff.Moved, []string{"c", "b"}) {
+		t.Errorf("Unexpected diff %+v", diff)
+	}
+	if diff := diffRowIDs([]string{"a", "b"}, []string{"x", "a", "b"}); len(diff.Moved) != 0 {
+		t.Errorf("Expected shifted rows not to be moved, got %v", diff.Moved)
+	}
+
+	previousProfile := lipgloss.ColorProfile()
+	lipgloss.SetColorProfile(termenv.TrueColor)
+	defer lipgloss.SetColorProfile(previousProfile)
+
+	table := createTestTable(createTestRows(6))
+	source := table.dataSource.(*TestDataSource)
+	table.config.AnimateChanges = true
+	table.config.Theme.RowEnterStyle = lipgloss.NewStyle().Underline(true)
+	table.config.Theme.RowExitStyle = lipgloss.NewStyle().Strikethrough(true)
+	pumpMsgs(table, core.JumpToCmd(2))
+
+	// Refresh without delivering the transition ticks
+	refresh := func() {
+		cmd := core.DataRefreshCmd()
+		for i := 0; i < 10 && cmd != nil; i++ {
+			var cmds []tea.Cmd
+			for _, msg := range collectMsgs(cmd) {
+				if _, ok := msg.(core.RowTransitionTickMsg); ok {
+					continue
+				}
+				_, next := table.Update(msg)
+				cmds = append(cmds, next)
+			}
+			cmd = tea.Batch(cmds...)
+		}
+	}
+
+	// Drop "Item 2" and add a row on top
+	source.data = append([]core.TableRow{{ID: "new", Cells: []string{"Fresh", "1", "New"}}}, slices.Delete(source.data, 1, 2)...)
+	source.totalItems = len(source.data)
+	refresh()
+
+	if item, _ := table.getItemAtIndex(table.GetState().CursorIndex); item.ID != "row-2" {
+		t.Errorf("Expected the cursor to stay on row-2, got %q", item.ID)
+	}
+	view := table.View()
+	if !strings.Contains(view, lipgloss.NewStyle().Underline(true).Render("Fresh     ")) {
+		t.Errorf("Expected the new row to be styled as entering:\n%q", view)
+	}
+	if !strings.Contains(view, lipgloss.NewStyle().Strikethrough(true).Render("Item 2    ")) {
+		t.Errorf("Expected the removed row to stay styled as exiting:\n%q", view)
+	}
+	if got := len(strings.Split(view, "\n")); got != 6 {
+		t.Errorf("Expected the frame to keep its height, got %d lines", got)
+	}
+
+	// The transition ends after its frames
+	for i := 0; i < rowTransitionFrames; i++ {
+		table.Update(core.RowTransitionTickMsg{})
+	}
+	if view := stripANSI(table.View()); strings.Contains(view, "Item 2") || table.rowTransition != nil {
+		t.Errorf("Expected the removed row gone after the transition:\n%s", view)
+	}
+
+	// Without AnimateChanges a refresh changes nothing about the rows
+	table.config.AnimateChanges = false
+	source.data = source.data[1:]
+	source.totalItems = len(source.data)
+	refresh()
+	if table.rowTransition != nil {
+		t.Error("Expected no transition with AnimateChanges off")
+	}
+}
+
+func TestTable_FooterAggregates(t *testing.T) {
+	rows := createTestRows(4)
+	rows[1].Cells[1] = "1,000.5"
+	rows[2].Cells[1] = "n/a"
+	rows[3].Cells[1] = ""
+
+	table := createTestTable(rows)
+	table.columns[0].Aggregate = core.AggCount
+	table.columns[1].Aggregate = core.AggSum
+	table.columns[2].Aggregate = core.AggAvg
+	source := table.dataSource.(*TestDataSource)
+	for i := range source.data {
+		source.data[i].Cells[2] = strconv.Itoa(i * 3)
+	}
+
+	_, cmd := table.Update(core.DataTotalMsg{Total: len(rows)})
+	var computed core.AggregatesComputedMsg
+	for _, msg := range collectMsgs(cmd) {
+		if msg, ok := msg.(core.AggregatesComputedMsg); ok {
+			computed = msg
+		}
+	}
+	// Count includes unparsable cells, sum and average skip them
+	if want := []string{"4", "1000.5", "4.5"}; !slices.Equal(computed.Cells, want) {
+		t.Fatalf("Expected aggregates %v, got %v", want, computed.Cells)
+	}
+
+	table.Update(computed)
+	lines := strings.Split(stripANSI(table.View()), "\n")
+	if footer := lines[len(lines)-1]; !strings.Contains(footer, "│4         │  1000.5│   4.5    │") {
+		t.Errorf("Expected the aggregates under their columns, got %q", footer)
+	}
+
+	// A refresh recomputes them over the current data
+	source.data = source.data[:2]
+	source.totalItems = 2
+	pumpMsgs(table, core.DataRefreshCmd())
+	if !slices.Equal(table.aggregates, []string{"2", "1000.5", "1.5"}) {
+		t.Errorf("Expected the aggregates to be recomputed, got %v", table.aggregates)
+	}
+}
+
+func TestTable_SetThemeByName(t *testing.T) {
+	table := createTestTable(createTestRows(2))
+	pumpMsgs(table, table.SetThemeByName("Retro"))
+	if table.config.Theme.BorderChars != core.BorderASCII.Chars() {
+		t.Error("Expected SetThemeByName to apply the Retro borders")
+	}
+	if table.SetThemeByName("Unknown") != nil {
+		t.Error("Expected no command for an unknown theme")
+	}
+}
+
+func TestTable_AutoSizeColumns(t *testing.T) {
+	rows := createTestRows(5)
+	rows[2].Cells[0] = "日本語テスト"
+	table := createTestTable(rows)
+	table.config.Columns[0].MaxWidth = 11
+	table.config.Columns[1].MinWidth = 9
+	table.config.Columns[2].Title = "📊 Status"
+	table.applyOverflowStrategy()
+
+	widths := func() []int {
+		var widths []int
+		for _, col := range table.config.Columns {
+			widths = append(widths, col.Width)
+		}
+		return widths
+	}
+
+	_, cmd := table.Update(table.AutoSizeColumns()())
+	if sized, ok := cmd().(core.ColumnsAutoSizedMsg); !ok || !slices.Equal(sized.Widths, widths()) {
+		t.Errorf("Expected a ColumnsAutoSizedMsg with the new widths, got %#v", sized)
+	}
+
+	// Wide characters count double and clamp to MaxWidth, the short Value
+	// column grows to its MinWidth, and the emoji header keeps room for the
+	// sort arrow
+	if want := []int{11, 9, 11}; !slices.Equal(widths(), want) {
+		t.Fatalf("Expected auto-sized widths %v, got %v", want, widths())
+	}
+
+	// Resampling during a refresh waits for its rows, then shrinks the
+	// columns to the new data
+	source := table.dataSource.(*TestDataSource)
+	source.data[2].Cells[0] = "Short"
+	pumpMsgs(table, tea.Batch(core.DataRefreshCmd(), table.ResampleAutoSize()))
+	if want := []int{6, 9, 11}; !slices.Equal(widths(), want) {
+		t.Errorf("Expected resampled widths %v, got %v", want, widths())
+	}
+}
+
+func TestTable_RenderCache(t *testing.T) {
+	table := createTestTable(createTestRows(20))
+	table.config.RenderCacheEnabled = true
+
+	formatted := 0
+	table.Update(table.SetCellFormatter(0, func(cellValue string, rowIndex int, column core.TableColumn, ctx core.RenderContext, isCursor, isSelected, isActiveCell bool) string {
+		formatted++
+		return cellValue
+	})())
+
+	view := table.View()
+	if formatted != 5 {
+		t.Fatalf("Expected the five visible rows formatted, got %d", formatted)
+	}
+
+	// An idle table reuses every row
+	if table.View() != view || formatted != 5 {
+		t.Errorf("Expected an unchanged view without formatting, got %d formats", formatted)
+	}
+
+	// Moving the cursor renders only the two rows it left and entered
+	table.Update(core.CursorDownMsg{})
+	table.View()
+	if formatted != 7 {
+		t.Errorf("Expected two rows formatted after a cursor move, got %d", formatted-5)
+	}
+
+	// Other changes, and the escape hatch, render every row again
+	table.Update(core.TableThemeSetCmd(config.DefaultTheme())())
+	table.View()
+	if formatted != 12 {
+		t.Errorf("Expected every row formatted after a theme change, got %d", formatted-7)
+	}
+	table.InvalidateRenderCache()
+	table.View()
+	if formatted != 17 {
+		t.Errorf("Expected every row formatted after InvalidateRenderCache, got %d", formatted-12)
+	}
+
+	// Disabled, the cache is bypassed
+	table.config.RenderCacheEnabled = false
+	table.View()
+	if formatted != 22 {
+		t.Errorf("Expected every row formatted with the cache disabled, got %d", formatted-17)
+	}
+}
+
+func TestTable_ChunkPrefetchAndEviction(t *testing.T) {
+	table := createTestTable(createTestRows(100))
+	table.config.ViewportConfig.MaxLoadedChunks = 3
+	table.config.ViewportConfig.PrefetchChunks = 1
+	pumpMsgs(table, core.DataRefreshCmd())
+
+	// Scrolling down loads the chunk after the viewport's ahead of time, and
+	// never keeps more than three chunks
+	for i := 0; i < 60; i++ {
+		pumpMsgs(table, core.CursorDownCmd())
+		table.View()
+		if loaded := len(table.chunks); loaded > 3 {
+			t.Fatalf("Expected at most 3 loaded chunks at row %d, got %d", table.viewport.CursorIndex, loaded)
+		}
+		cursorChunk := data.CalculateChunkStartIndex(table.viewport.CursorIndex, 10)
+		if _, ok := table.chunks[cursorChunk]; !ok {
+			t.Fatalf("Expected the cursor's chunk %d loaded at row %d", cursorChunk, table.viewport.CursorIndex)
+		}
+	}
+	if _, ok := table.chunks[70]; !ok {
+		t.Errorf("Expected chunk 70 prefetched with the cursor at row %d, loaded %v", table.viewport.CursorIndex, slices.Sorted(maps.Keys(table.chunks)))
+	}
+
+	// Scrolling back up prefetches the chunk before the viewport's
+	for i := 0; i < 10; i++ {
+		pumpMsgs(table, core.CursorUpCmd())
+	}
+	if _, ok := table.chunks[40]; !ok {
+		t.Errorf("Expected chunk 40 prefetched with the cursor at row %d, loaded %v", table.viewport.CursorIndex, slices.Sorted(maps.Keys(table.chunks)))
+	}
+
+	stats := table.GetLoadedChunkStats()
+	if stats.Loaded != len(table.chunks) || stats.Evicted == 0 || stats.Hits == 0 {
+		t.Errorf("Unexpected chunk stats: %+v", stats)
+	}
+}
+
+func TestTable_ChunkEvictionOnLoad(t *testing.T) {
+	table := createTestTable(createTestRows(100))
+	table.config.ViewportConfig.MaxLoadedChunks = 1
+	table.config.ViewportConfig.PrefetchChunks = 2
+
+	// Chunks loaded ahead of the viewport are evicted as they arrive, keeping
+	// the viewport's own
+	pumpMsgs(table, core.DataRefreshCmd())
+	if loaded := slices.Sorted(maps.Keys(table.chunks)); len(loaded) != 1 || loaded[0] != 0 {
+		t.Errorf("Expected only the viewport's chunk loaded, got %v", loaded)
+	}
+	var items []core.Data[any]
+	for _, row := range createTestRows(20)[10:] {
+		items = append(items, core.Data[any]{ID: row.ID, Item: row})
+	}
+	table.Update(core.DataChunkLoadedMsg{StartIndex: 10, Items: items})
+	if loaded := slices.Sorted(maps.Keys(table.chunks)); len(loaded) != 1 || loaded[0] != 0 {
+		t.Errorf("Expected the chunk loaded ahead evicted on arrival, got %v", loaded)
+	}
+}
+
+func TestTable_ChunkHitsCountedPerViewport(t *testing.T) {
+	table := createTestTable(createTestRows(100))
+	pumpMsgs(table, core.DataRefreshCmd())
+	table.View()
+	stats := table.GetLoadedChunkStats()
+	if stats.Hits == 0 {
+		t.Fatalf("Expected the visible rows counted, got %+v", stats)
+	}
+
+	// Rendering in place counts nothing more
+	for i := 0; i < 5; i++ {
+		table.View()
+	}
+	if got := table.GetLoadedChunkStats(); got.Hits != stats.Hits || got.Misses != stats.Misses {
+		t.Errorf("Expected the stats unchanged by rendering, got %+v after %+v", got, stats)
+	}
+
+	// Moving the viewport counts its rows once
+	for i := 0; i < 5; i++ {
+		pumpMsgs(table, core.CursorDownCmd())
+	}
+	start := table.viewport.ViewportStartIndex
+	moved := table.GetLoadedChunkStats()
+	if start == 0 || moved.Hits+moved.Misses <= stats.Hits+stats.Misses {
+		t.Fatalf("Expected the moved viewport counted, got %+v at start %d", moved, start)
+	}
+	table.View()
+	table.View()
+	if got := table.GetLoadedChunkStats(); got.Hits != moved.Hits || got.Misses != moved.Misses {
+		t.Errorf("Expected the stats unchanged by rendering, got %+v after %+v", got, moved)
+	}
+}
+
+func TestTable_PinnedRows(t *testing.T) {
+	table := createTestTable(createTestRows(40))
+	height := strings.Count(table.View(), "\n")
+
+	// A pinned row in view shows once, above the separator, within the height
+	pumpMsgs(table, table.PinRow("row-1"))
+	view := stripANSI(table.View())
+	if strings.Count(view, "Item 2 ") != 1 {
+		t.Fatalf("Expected the pinned row once, got:\n%s", view)
+	}
+	if got := strings.Count(view, "\n"); got != height {
+		t.Errorf("Expected the pinned row to keep the height of %d lines, got %d:\n%s", height+1, got+1, view)
+	}
+	lines := strings.Split(view, "\n")
+	if !strings.Contains(lines[1], "Item 2") || !strings.HasPrefix(lines[2], "├") {
+		t.Errorf("Expected the pinned row under the header, then a separator, got:\n%s", view)
+	}
+
+	// The cursor skips the pinned row
+	pumpMsgs(table, core.CursorDownCmd())
+	if table.viewport.CursorIndex != 2 {
+		t.Errorf("Expected the cursor to skip the pinned row to 2, got %d", table.viewport.CursorIndex)
+	}
+
+	// A row far from the loaded chunks is found in the data source, and stays
+	// pinned through a sort
+	pumpMsgs(table, table.PinRow("row-35"))
+	pumpMsgs(table, core.SortToggleCmd("name"))
+	view = stripANSI(table.View())
+	if !strings.Contains(view, "Item 36") || strings.Count(view, "Item 2 ") != 1 {
+		t.Errorf("Expected both pinned rows after sorting, got:\n%s", view)
+	}
+	if want := []string{"row-1", "row-35"}; !slices.Equal(table.PinnedRowIDs(), want) {
+		t.Errorf("Expected pinned rows %v, got %v", want, table.PinnedRowIDs())
+	}
+	if got := strings.Count(view, "\n"); got != height {
+		t.Errorf("Expected the pinned rows to keep the height of %d lines, got %d:\n%s", height+1, got+1, view)
+	}
+
+	// The pinned rows are not looked up again until the filters or total change
+	if table.locatePinnedRows() != nil {
+		t.Error("Expected no scan with unchanged filters and total")
+	}
+	table.filters["status"] = "Status1"
+	if table.locatePinnedRows() == nil {
+		t.Error("Expected a scan once the filters changed")
+	}
+	delete(table.filters, "status")
+
+	pumpMsgs(table, table.UnpinRow("row-35"))
+	if strings.Contains(stripANSI(table.View()), "Item 36") {
+		t.Error("Expected the unpinned row gone from the top")
+	}
+}
+
+func TestTable_ColumnTypeDefaults(t *testing.T) {
+	rows := []core.TableRow{
+		{ID: "row-0", Cells: []string{"1234567", "12.5%", "yes", "2024-03-05", "1234567"}},
+		{ID: "row-1", Cells: []string{"n/a", "0.5", "false", "soon", "42"}},
+	}
+	table := createTestTable(rows)
+	pumpMsgs(table, core.ColumnSetCmd([]core.TableColumn{
+		{Title: "Number", Field: "number", Width: 11, Type: core.ColumnNumber},
+		{Title: "Percent", Field: "percent", Width: 8, Type: core.ColumnPercent},
+		{Title: "Bool", Field: "bool", Width: 5, Type: core.ColumnBool},
+		{Title: "Date", Field: "date", Width: 12, Type: core.ColumnDate, DateLayout: "02 Jan 2006"},
+		{Title: "Override", Field: "override", Width: 11, Type: core.ColumnNumber, Alignment: core.AlignCenter},
+	}))
+	pumpMsgs(table, core.CellFormatterSetCmd(4, func(cellValue string, rowIndex int, column core.TableColumn, ctx core.RenderContext, isCursor, isSelected, isActiveCell bool) string {
+		return "#" + cellValue
+	}))
+
+	lines := strings.Split(stripANSI(table.View()), "\n")
+	cells := func(line string) []string {
+		return strings.Split(strings.Trim(line, "│"), "│")
+	}
+
+	// The first cell is the cursor indicator
+	first := cells(lines[1])[1:]
+	want := []string{"  1,234,567", "   12.5%", "  ✓  ", "05 Mar 2024 ", " #1234567  "}
+	if !slices.Equal(first, want) {
+		t.Errorf("Expected type defaults %q, got %q", want, first)
+	}
+
+	// Cells that don't parse as the column's type are left as they are
+	second := cells(lines[2])[1:]
+	want = []string{"        n/a", "    0.5%", "  ✗  ", "soon        ", "    #42    "}
+	if !slices.Equal(second, want) {
+		t.Errorf("Expected unparseable cells unchanged %q, got %q", want, second)
+	}
+}
+
+func TestTable_RangeSelectionAnchor(t *testing.T) {
+	rows := createTestRows(30)
+	table := createTestTable(rows)
+	dataSource := table.dataSource.(*TestDataSource)
+	// step handles msg and what it leads to, returning the messages it emitted
+	step := func(msg tea.Msg) []tea.Msg {
+		_, cmd := table.Update(msg)
+		msgs := collectMsgs(cmd)
+		for _, emitted := range msgs {
+			pumpMsgs(table, func() tea.Msg { return emitted })
+		}
+		return msgs
+	}
+
+	pumpMsgs(table, core.JumpToCmd(10))
+	msgs := step(core.SelectionAnchorSetMsg{})
+	if !slices.Contains(msgs, tea.Msg(core.RangeSelectionUpdatedMsg{Anchor: 10, Cursor: 10, Count: 1})) {
+		t.Errorf("Expected the anchor reported, got %v", msgs)
+	}
+
+	// Extending up selects from the cursor to the anchor
+	pumpMsgs(table, core.CursorUpCmd())
+	pumpMsgs(table, core.CursorUpCmd())
+	if want := []string{"row-8", "row-9", "row-10"}; !slices.Equal(dataSource.GetSelectedIDs(), want) {
+		t.Errorf("Expected %v selected, got %v", want, dataSource.GetSelectedIDs())
+	}
+
+	// Moving back down past the anchor deselects the rows above it
+	for range 3 {
+		pumpMsgs(table, core.CursorDownCmd())
+	}
+	msgs = step(core.CursorDownMsg{})
+	if want := []string{"row-10", "row-11", "row-12"}; !slices.Equal(dataSource.GetSelectedIDs(), want) {
+		t.Errorf("Expected %v selected, got %v", want, dataSource.GetSelectedIDs())
+	}
+	if !slices.Contains(msgs, tea.Msg(core.RangeSelectionUpdatedMsg{Anchor: 10, Cursor: 12, Count: 3})) {
+		t.Errorf("Expected the range reported, got %v", msgs)
+	}
+	if !table.chunks[10].Items[2].Selected {
+		t.Error("Expected the loaded rows to show the selection")
+	}
+
+	// A committed range stays selected and the cursor moves freely
+	pumpMsgs(table, table.CommitRangeSelection())
+	pumpMsgs(table, core.CursorDownCmd())
+	if len(dataSource.GetSelectedIDs()) != 3 {
+		t.Errorf("Expected the committed range kept, got %v", dataSource.GetSelectedIDs())
+	}
+
+	// A cleared range is deselected
+	pumpMsgs(table, table.SetSelectionAnchor())
+	pumpMsgs(table, core.CursorDownCmd())
+	pumpMsgs(table, table.ClearRangeSelection())
+	if want := []string{"row-10", "row-11", "row-12"}; !slices.Equal(dataSource.GetSelectedIDs(), want) {
+		t.Errorf("Expected only the committed range left, got %v", dataSource.GetSelectedIDs())
+	}
+}
+
+func TestTable_VisibleRows(t *testing.T) {
+	table := createTestTable(createTestRows(20))
+	pumpMsgs(table, core.JumpToCmd(10))
+	pumpMsgs(table, core.CursorDownCmd())
+	pumpMsgs(table, core.SelectCurrentCmd())
+
+	// The data rows of the view, below the header
+	lines := strings.Split(stripANSI(table.View()), "\n")[1:]
+	rows := table.VisibleRows()
+	if len(rows) != table.config.ViewportConfig.Height {
+		t.Fatalf("Expected %d visible rows, got %d", table.config.ViewportConfig.Height, len(rows))
+	}
+	for i, row := range rows {
+		if !strings.Contains(lines[i], row.Row.Cells[0]+" ") {
+			t.Errorf("Expected row %d to be %q, view shows %q", i, row.Row.Cells[0], lines[i])
+		}
+		if row.Index != table.viewport.ViewportStartIndex+i {
+			t.Errorf("Expected row %d at index %d, got %d", i, table.viewport.ViewportStartIndex+i, row.Index)
+		}
+	}
+
+	var cursor core.VisibleRow
+	for _, row := range rows {
+		if row.IsCursor {
+			cursor = row
+		}
+	}
+	if !cursor.IsSelected || cursor.ID != "row-11" || cursor.ActiveColumn != 0 {
+		t.Errorf("Expected the selected cursor row row-11 with column 0 active, got %+v", cursor)
+	}
+	if rows[0].IsCursor || rows[0].IsSelected || rows[0].ActiveColumn != -1 {
+		t.Errorf("Expected the first row neither cursor nor selected, got %+v", rows[0])
+	}
+}
+
+func TestTable_VariableCellCounts(t *testing.T) {
+	rows := []core.TableRow{
+		{ID: "short", Cells: []string{"Short"}},
+		{ID: "long", Cells: []string{"Long", "1", "Status0", "extra"}},
+		{ID: "exact", Cells: []string{"Exact", "2", "Status1"}},
+	}
+	table := createTestTable(rows)
+	pumpMsgs(table, core.CellFormatterSetCmd(1, func(cellValue string, rowIndex int, column core.TableColumn, ctx core.RenderContext, isCursor, isSelected, isActiveCell bool) string {
+		return "$" + cellValue
+	}))
+
+	// Missing cells render empty, without their formatter, and extra cells
+	// are ignored
+	lines := strings.Split(stripANSI(table.View()), "\n")
+	if strings.Contains(lines[1], "$") || !strings.Contains(lines[1], "Short") {
+		t.Errorf("Expected the short row padded with empty cells, got %q", lines[1])
+	}
+	if strings.Contains(lines[2], "extra") || !strings.Contains(lines[2], "$1") {
+		t.Errorf("Expected the long row without its extra cell, got %q", lines[2])
+	}
+
+	// Shape warnings and the strict mode report the mismatched rows
+	load := func(table *Table) []tea.Msg {
+		_, cmd := table.Update(core.DataChunkLoadedMsg{StartIndex: 0, Items: table.chunks[0].Items})
+		return collectMsgs(cmd)
+	}
+	table.config.WarnDataShape = true
+	var warnings []core.DataShapeWarningMsg
+	for _, msg := range load(table) {
+		if warning, ok := msg.(core.DataShapeWarningMsg); ok {
+			warnings = append(warnings, warning)
+		}
+	}
+	want := []core.DataShapeWarningMsg{
+		{Index: 0, RowID: "short", Cells: 1, Columns: 3},
+		{Index: 1, RowID: "long", Cells: 4, Columns: 3},
+	}
+	if !slices.Equal(warnings, want) {
+		t.Errorf("Expected warnings %v, got %v", want, warnings)
+	}
+
+	table.config.WarnDataShape = false
+	table.config.StrictCellCount = true
+	var loadErrors int
+	for _, msg := range load(table) {
+		if _, ok := msg.(core.DataLoadErrorMsg); ok {
+			loadErrors++
+		}
+	}
+	if loadErrors != 1 {
+		t.Errorf("Expected one load error in strict mode, got %d", loadErrors)
+	}
+}
+
+func TestTable_JumpToPercent(t *testing.T) {
+	tests := []struct {
+		name    string
+		total   int
+		percent float64
+		want    int
+	}{
+		{"start", 20, 0, 0},
+		{"end", 20, 1, 19},
+		{"middle rounds half up", 4, 0.5, 2},
+		{"just under half rounds down", 4, 0.49, 1},
+		{"below zero clamps", 10, -0.5, 0},
+		{"past one clamps", 10, 1.5, 9},
+		{"single row", 1, 0.9, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			table := createTestTable(createTestRows(tt.total))
+			_, cmd := table.Update(core.JumpToPercentMsg{Percent: tt.percent})
+			msgs := collectMsgs(cmd)
+			if len(msgs) != 1 || msgs[0] != tea.Msg(core.JumpToMsg{Index: tt.want}) {
+				t.Errorf("Expected JumpToMsg{%d}, got %v", tt.want, msgs)
+			}
+		})
+	}
+
+	// A count prefix typed before the bound key jumps to that percentage
+	table := createTestTable(createTestRows(21))
+	table.config.KeyMap.JumpToPercent = []string{"%"}
+	table.Focus()
+	for _, key := range []string{"5", "0"} {
+		pumpMsgs(table, func() tea.Msg { return tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(key)} })
+	}
+	pumpMsgs(table, func() tea.Msg { return tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("%")} })
+	if table.viewport.CursorIndex != 10 {
+		t.Errorf("Expected 50%% to jump to 10, got %d", table.viewport.CursorIndex)
+	}
+}
+
+func TestTable_ScrollOff(t *testing.T) {
+	table := createTestTable(createTestRows(30))
+	table.config.ViewportConfig.ScrollOff = 2
+
+	// Moving down, the cursor settles in the middle and the rows scroll
+	for i := 1; i <= 26; i++ {
+		pumpMsgs(table, core.CursorDownCmd())
+		wantRow := min(i, 2)
+		if table.viewport.CursorViewportIndex != wantRow || table.viewport.ViewportStartIndex != i-wantRow {
+			t.Fatalf("Step %d: expected the cursor on screen row %d of a viewport at %d, got row %d at %d",
+				i, wantRow, i-wantRow, table.viewport.CursorViewportIndex, table.viewport.ViewportStartIndex)
+		}
+	}
+	lines := strings.Split(stripANSI(table.View()), "\n")
+	if !strings.Contains(lines[3], "Item 27") {
+		t.Errorf("Expected the cursor row in the middle of the view, got:\n%s", strings.Join(lines, "\n"))
+	}
+
+	// At the end of the data the cursor reaches the bottom edge
+	for range 3 {
+		pumpMsgs(table, core.CursorDownCmd())
+	}
+	if table.viewport.CursorViewportIndex != 4 || table.viewport.ViewportStartIndex != 25 {
+		t.Errorf("Expected the cursor at the bottom of the last page, got row %d at %d",
+			table.viewport.CursorViewportIndex, table.viewport.ViewportStartIndex)
+	}
+
+	// Moving back up, the rows scroll once the cursor is two rows from the top
+	for range 4 {
+		pumpMsgs(table, core.CursorUpCmd())
+	}
+	if table.viewport.CursorIndex != 25 || table.viewport.CursorViewportIndex != 2 {
+		t.Errorf("Expected the cursor kept two rows from the top, got index %d on row %d",
+			table.viewport.CursorIndex, table.viewport.CursorViewportIndex)
+	}
+}
+
+func TestTable_ActiveCellMove(t *testing.T) {
+	table := createTestTable(createTestRows(30))
+	move := func(dRow, dCol int) []tea.Msg {
+		var changes []tea.Msg
+		_, cmd := table.Update(core.ActiveCellMoveMsg{DRow: dRow, DCol: dCol})
+		for _, msg := range collectMsgs(cmd) {
+			if _, ok := msg.(core.ActiveCellChangedMsg); ok {
+				changes = append(changes, msg)
+			}
+			pumpMsgs(table, func() tea.Msg { return msg })
+		}
+		return changes
+	}
+
+	changes := move(1, 1)
+	if want := tea.Msg(core.ActiveCellChangedMsg{Row: 1, Col: 1, Value: "10"}); len(changes) != 1 || changes[0] != want {
+		t.Errorf("Expected %v, got %v", want, changes)
+	}
+
+	// Moves stop at the edges of the grid
+	move(-5, -5)
+	if row, col, value := table.GetActiveCell(); row != 0 || col != 0 || value != "Item 1" {
+		t.Errorf("Expected the top-left cell, got (%d, %d) %q", row, col, value)
+	}
+	if changes := move(-1, -1); len(changes) != 0 {
+		t.Errorf("Expected no change past the top-left edge, got %v", changes)
+	}
+	move(100, 100)
+	if row, col, value := table.GetActiveCell(); row != 29 || col != 2 || value != "Status2" {
+		t.Errorf("Expected the bottom-right cell, got (%d, %d) %q", row, col, value)
+	}
+
+	// Columns hidden by configuration are skipped
+	pumpMsgs(table, table.SetColumnVisibility("value", false))
+	move(0, -1)
+	if _, col, _ := table.GetActiveCell(); col != 0 {
+		t.Errorf("Expected the hidden column skipped, got column %d", col)
+	}
+}
+
+func TestTable_ActiveCellMoveRevealsDroppedColumn(t *testing.T) {
+	table := createTestTable(createTestRows(5))
+	table.config.Columns[0].Priority = 3
+	table.config.Columns[1].Priority = 1
+	table.config.Columns[2].Priority = 2
+	table.Update(table.SetOverflowStrategy(core.OverflowHidePriority)())
+	table.Update(tea.WindowSizeMsg{Width: 30, Height: 20})
+	if hidden := table.GetHiddenColumns(); len(hidden) != 1 || hidden[0] != 1 {
+		t.Fatalf("Expected the Value column dropped to fit, got %v", hidden)
+	}
+
+	// Moving onto the dropped column scrolls it into view in place of another
+	pumpMsgs(table, core.ActiveCellMoveCmd(0, 1))
+	if _, col, value := table.GetActiveCell(); col != 1 || value != "0" {
+		t.Errorf("Expected the Value cell active, got column %d %q", col, value)
+	}
+	if hidden := table.GetHiddenColumns(); len(hidden) != 1 || hidden[0] != 2 {
+		t.Errorf("Expected the Status column dropped instead, got %v", hidden)
+	}
+	view := stripANSI(table.View())
+	if !strings.Contains(view, "Value") || strings.Contains(view, "Status") {
+		t.Errorf("Expected Value shown in place of Status:\n%s", view)
+	}
+	if width := lipgloss.Width(strings.Split(table.View(), "\n")[1]); width > 30 {
+		t.Errorf("Expected rows to still fit 30 columns, got %d", width)
+	}
+
+	// And so does moving on to the column dropped now
+	pumpMsgs(table, core.ActiveCellMoveCmd(0, 1))
+	if _, col, _ := table.GetActiveCell(); col != 2 {
+		t.Errorf("Expected the Status cell active, got column %d", col)
+	}
+	if hidden := table.GetHiddenColumns(); len(hidden) != 1 || hidden[0] != 1 {
+		t.Errorf("Expected the Value column dropped again, got %v", hidden)
+	}
+}
+
+func TestTable_FormatterPanicRecovery(t *testing.T) {
+	table := createTestTable(createTestRows(5))
+	table.config.RecoverFormatterPanics = true
+	pumpMsgs(table, core.CellFormatterSetCmd(1, func(cellValue string, rowIndex int, column core.TableColumn, ctx core.RenderContext, isCursor, isSelected, isActiveCell bool) string {
+		if rowIndex == 2 {
+			var values []string
+			return values[rowIndex]
+		}
+		return cellValue
+	}))
+
+	lines := strings.Split(stripANSI(table.View()), "\n")
+	if !strings.Contains(lines[3], "⚠") || !strings.Contains(lines[3], "Item 3") || !strings.Contains(lines[3], "Status2") {
+		t.Errorf("Expected the row rendered with a warning in place of the panicked cell, got %q", lines[3])
+	}
+	if !strings.Contains(lines[2], "10") {
+		t.Errorf("Expected the other rows formatted as usual, got %q", lines[2])
+	}
+
+	// The panic is reported once, with the next message
+	table.View()
+	_, cmd := table.Update(core.CursorDownMsg{})
+	var panics []core.FormatterPanicMsg
+	for _, msg := range collectMsgs(cmd) {
+		if panicked, ok := msg.(core.FormatterPanicMsg); ok {
+			panics = append(panics, panicked)
+		}
+	}
+	if len(panics) != 1 || panics[0].Column != 1 || panics[0].RowID != "row-2" || panics[0].Recovered == nil {
+		t.Errorf("Expected one panic reported for column 1 of row-2, got %v", panics)
+	}
+	table.View()
+	_, cmd = table.Update(core.CursorDownMsg{})
+	for _, msg := range collectMsgs(cmd) {
+		if _, ok := msg.(core.FormatterPanicMsg); ok {
+			t.Error("Expected the panic not reported again")
+		}
+	}
+}
+
+func TestTable_NestedGroups(t *testing.T) {
+	columns := []core.TableColumn{
+		{Title: "Region", Field: "region", Width: 16},
+		{Title: "Category", Field: "category", Width: 10},
+		{Title: "Name", Field: "name", Width: 8},
+	}
+	rows := []core.TableRow{
+		{ID: "1", Cells: []string{"US", "Books", "a"}},
+		{ID: "2", Cells: []string{"EU", "Games", "b"}},
+		{ID: "3", Cells: []string{"US", "Games", "c"}},
+		{ID: "4", Cells: []string{"EU", "Books", "d"}},
+		{ID: "5", Cells: []string{"EU", "Books", "e"}},
+	}
+
+	cfg := config.DefaultTableConfig()
+	cfg.Columns = columns
+	cfg.GroupBy = []string{"region", "category"}
+	cfg.ViewportConfig.Height = 12
+	cfg.ViewportConfig.ChunkSize = 20
+
+	dataSource := &GroupingTestDataSource{TestDataSource: NewTestDataSource(nil), rows: rows, columns: columns}
+	table := NewTable(cfg, dataSource)
+	pumpMsgs(table, table.Init())
+
+	// 2 regions + 4 categories + 5 rows, inner headers under their region
+	if table.GetTotalItems() != 11 {
+		t.Fatalf("Expected 11 rows with nested groups, got %d", table.GetTotalItems())
+	}
+	view := stripANSI(table.View())
+	for _, want := range []string{"▼ EU (3)", "▼ Books (2)", "▼ Games (1)", "▼ US (2)"} {
+		if !strings.Contains(view, want) {
+			t.Errorf("Expected %q in grouped view:\n%s", want, view)
+		}
+	}
+
+	// Collapsing an inner group hides its rows only
+	_, cmd := table.Update(core.GroupToggleMsg{Key: "EU/Books"})
+	msgs := collectMsgs(cmd)
+	if !slices.Contains(msgs, tea.Msg(core.GroupToggledMsg{Key: "EU/Books", Collapsed: true})) {
+		t.Errorf("Expected GroupToggledMsg for EU/Books, got %v", msgs)
+	}
+	for _, msg := range msgs {
+		pumpMsgs(table, func() tea.Msg { return msg })
+	}
+	if table.GetTotalItems() != 9 {
+		t.Errorf("Expected 9 rows with EU/Books collapsed, got %d", table.GetTotalItems())
+	}
+
+	// Collapsing the outer group hides its inner groups too
+	pumpMsgs(table, table.ToggleGroup("EU"))
+	if table.GetTotalItems() != 6 {
+		t.Errorf("Expected 6 rows with EU collapsed, got %d", table.GetTotalItems())
+	}
+	if view := stripANSI(table.View()); !strings.Contains(view, "▶ EU (3)") || strings.Count(view, "Games (1)") != 1 {
+		t.Errorf("Expected EU collapsed with its inner groups hidden:\n%s", view)
+	}
+}
+
+// indexableTestDataSource is a TestDataSource that finds rows by ID
+type indexableTestDataSource struct {
+	*TestDataSource
+}
+
+func (ds *indexableTestDataSource) IndexOfID(id string) tea.Cmd {
+	for i, row := range ds.data {
+		if row.ID == id {
+			return core.IndexOfIDCmd(id, i, true)
+		}
+	}
+	return core.IndexOfIDCmd(id, -1, false)
+}
+
+func TestTable_RefreshKeepsCursorRow(t *testing.T) {
+	table := createTestTable(createTestRows(10))
+	source := &indexableTestDataSource{table.dataSource.(*TestDataSource)}
+	table.dataSource = source
+	pumpMsgs(table, core.JumpToCmd(6))
+
+	// Removing rows above the cursor moves its row up: the cursor follows it
+	source.data = slices.Delete(source.data, 1, 3)
+	source.totalItems = len(source.data)
+	pumpMsgs(table, core.DataRefreshCmd())
+	if state := table.GetState(); state.CursorIndex != 4 {
+		t.Errorf("Expected the cursor to follow row-6 to index 4, got %d", state.CursorIndex)
+	}
+
+	// Once filtered out, the row is not found and the cursor keeps its index
+	source.data = slices.DeleteFunc(source.data, func(row core.TableRow) bool { return row.ID == "row-6" })
+	source.totalItems = len(source.data)
+	pumpMsgs(table, core.DataRefreshCmd())
+	if state := table.GetState(); state.CursorIndex != 4 {
+		t.Errorf("Expected the cursor to stay at index 4 once row-6 is gone, got %d", state.CursorIndex)
+	}
+
+	// Without IndexOfID the old index is clamped to the new total
+	table.dataSource = source.TestDataSource
+	pumpMsgs(table, core.JumpToCmd(6))
+	source.data = source.data[:3]
+	source.totalItems = len(source.data)
+	pumpMsgs(table, core.DataRefreshCmd())
+	if state := table.GetState(); state.CursorIndex != 2 {
+		t.Errorf("Expected the cursor clamped to the last row, got %d", state.CursorIndex)
+	}
+}
+
+func TestTable_TruncateModes(t *testing.T) {
+	cases := []struct {
+		text     string
+		width    int
+		mode     core.TruncateMode
+		ellipsis string
+		want     string
+	}{
+		{"internal/database.go", 12, core.TruncateEnd, "…", "internal/da…"},
+		{"internal/database.go", 12, core.TruncateStart, "…", "…database.go"},
+		{"very_very_long.txt", 13, core.TruncateMiddle, "…", "very_v…ng.txt"},
+		{"short", 12, core.TruncateMiddle, "…", "short"},
+		// Narrower than the ellipsis: as much of it as fits
+		{"internal/database.go", 2, core.TruncateEnd, "...", ".."},
+		{"internal/database.go", 2, core.TruncateStart, "...", ".."},
+		{"internal/database.go", 1, core.TruncateMiddle, "…", "…"},
+		{"internal/database.go", 0, core.TruncateEnd, "…", ""},
+		// A wide character straddling the cut is padded to the exact width
+		{"日本語のファイル", 6, core.TruncateEnd, "…", "日本… "},
+		{"日本語のファイル", 6, core.TruncateStart, "…", " …イル"},
+		{"日本語のファイル", 7, core.TruncateMiddle, "…", "日…イル"},
+		{"日本語のファイル", 8, core.TruncateMiddle, "…", "日本… ル"},
+	}
+	for _, tc := range cases {
+		got := core.TruncateText(tc.text, tc.width, tc.mode, tc.ellipsis)
+		if got != tc.want {
+			t.Errorf("TruncateText(%q, %d, %d, %q) = %q, want %q", tc.text, tc.width, tc.mode, tc.ellipsis, got, tc.want)
+		}
+		if width := lipgloss.Width(got); tc.width > 0 && lipgloss.Width(tc.text) > tc.width && width != tc.width {
+			t.Errorf("TruncateText(%q, %d) is %d cells wide", tc.text, tc.width, width)
+		}
+	}
+
+	// A column cut at the start with the theme's ellipsis
+	table := createTestTable([]core.TableRow{{ID: "1", Cells: []string{"internal/database.go", "1", "ok"}}})
+	table.config.Theme.Ellipsis = "…"
+	table.config.Columns[0].TruncateMode = core.TruncateStart
+	pumpMsgs(table, core.ColumnSetCmd(table.config.Columns))
+	if view := stripANSI(table.View()); !strings.Contains(view, "│…tabase.go│") {
+		t.Errorf("Expected the name cut at the start:\n%s", view)
+	}
+}
+
+func TestTable_GoldenRender(t *testing.T) {
+	table := createTestTable(createTestRows(8))
+
+	// Golden output at 40 columns, after moving the cursor down one row
+	got := testutil.RenderToString(table, 40, 10, core.CursorDownMsg{})
+	want := strings.Join([]string{
+		"│ ●  │Name      │   Value│  Status  │",
+		"│    │Item 1    │       0│ Status0  │",
+		"│ ►  │Item 2    │      10│ Status1  │",
+		"│    │Item 3    │      20│ Status2  │",
+		"│    │Item 4    │      30│ Status0  │",
+		"│    │Item 5    │      40│ Status1  │",
+	}, "\n")
+	if got != want {
+		t.Errorf("Rendered view mismatch:\ngot:\n%s\nwant:\n%s", got, want)
+	}
+}
+
+func TestTable_DebugSnapshot(t *testing.T) {
+	table := createTestTable(createTestRows(45))
+	table.config.ViewportConfig.TopThreshold = 1
+	table.config.ViewportConfig.BottomThreshold = 1
+	pumpMsgs(table, core.JumpToCmd(27))
+
+	snapshot := table.DebugSnapshot()
+	if snapshot.CursorIndex != 27 || snapshot.TotalItems != 45 || snapshot.ViewportHeight != 5 {
+		t.Errorf("Expected cursor 27 of 45 in a viewport of 5, got %+v", snapshot)
+	}
+	if snapshot.TopThreshold != 1 || snapshot.BottomThreshold != 3 {
+		t.Errorf("Expected thresholds at rows 1 and 3, got %d and %d", snapshot.TopThreshold, snapshot.BottomThreshold)
+	}
+
+	// Every visible row lies in a reported chunk
+	for i := snapshot.ViewportStart; i < snapshot.ViewportStart+snapshot.ViewportHeight && i < snapshot.TotalItems; i++ {
+		covered := false
+		for _, chunk := range snapshot.LoadedChunks {
+			covered = covered || (i >= chunk.StartIndex && i <= chunk.EndIndex)
+		}
+		if !covered {
+			t.Errorf("Visible row %d is in none of the loaded chunks %+v", i, snapshot.LoadedChunks)
+		}
+	}
+
+	encoded, err := json.Marshal(snapshot)
+	if err != nil {
+		t.Fatalf("Expected the snapshot to encode to JSON: %v", err)
+	}
+	var decoded core.DebugSnapshot
+	if err := json.Unmarshal(encoded, &decoded); err != nil || decoded.CursorIndex != 27 || len(decoded.LoadedChunks) != len(snapshot.LoadedChunks) {
+		t.Errorf("Expected the snapshot to round-trip through JSON, got %s", encoded)
+	}
+}
+
+// heightHintTestDataSource is a TestDataSource hinting the height of rows
+// whose first column wraps at ten cells
+type heightHintTestDataSource struct {
+	*TestDataSource
+	hinted int
+}
+
+func (ds *heightHintTestDataSource) RowHeightHint(id string, availableWidth int) int {
+	ds.hinted++
+	for _, row := range ds.data {
+		if row.ID == id {
+			return len(wrapCellText(row.Cells[0], 10))
+		}
+	}
+	return 1
+}
+
+func TestTable_HeightHints(t *testing.T) {
+	names := []string{"Short", "A name long enough to wrap", "Mid length name", "Tiny", "Another long name that wraps", "Ok"}
+	var rows []core.TableRow
+	for i := 0; i < 12; i++ {
+		rows = append(rows, core.TableRow{ID: fmt.Sprintf("row-%d", i), Cells: []string{names[i%len(names)], fmt.Sprint(i), "ok"}})
+	}
+
+	newTable := func() *Table {
+		table := createTestTable(rows)
+		table.config.Columns[0].WrapText = true
+		table.applyOverflowStrategy()
+		return table
+	}
+	measured := newTable()
+	hinted := newTable()
+	source := &heightHintTestDataSource{TestDataSource: hinted.dataSource.(*TestDataSource)}
+	hinted.dataSource = source
+
+	ids := func(rows []core.VisibleRow) []string {
+		var ids []string
+		for _, row := range rows {
+			ids = append(ids, row.ID)
+		}
+		return ids
+	}
+	for _, index := range []int{0, 1, 4, 7, 11} {
+		pumpMsgs(measured, core.JumpToCmd(index))
+		pumpMsgs(hinted, core.JumpToCmd(index))
+
+		want, got := ids(measured.VisibleRows()), ids(hinted.VisibleRows())
+		if !slices.Equal(got, want) {
+			t.Errorf("At row %d, expected hinted rows %v to match measured rows %v", index, got, want)
+		}
+		if hinted.View() != measured.View() {
+			t.Errorf("At row %d, hinted view differs from measured view:\n%s\n---\n%s", index, stripANSI(hinted.View()), stripANSI(measured.View()))
+		}
+	}
+	if source.hinted == 0 {
+		t.Error("Expected the data source to be asked for height hints")
+	}
+}
+
+func TestTable_HeightHintsBudgetBoundingArea(t *testing.T) {
+	var rows []core.TableRow
+	for i := 0; i < 100; i++ {
+		rows = append(rows, core.TableRow{ID: fmt.Sprintf("row-%d", i), Cells: []string{"aaaaaaaaa bbbbbbbbb ccccccccc ddddddddd eeeeeeeee", fmt.Sprint(i), "ok"}})
+	}
+
+	newTable := func() *Table {
+		table := createTestTable(rows)
+		table.config.ViewportConfig.Height = 10
+		table.config.ViewportConfig.BoundingAreaAfter = 10
+		table.config.ViewportConfig.ChunkSize = 5
+		table.config.Columns[0].WrapText = true
+		table.applyOverflowStrategy()
+		return table
+	}
+	measured := newTable()
+	hinted := newTable()
+	hinted.dataSource = &heightHintTestDataSource{TestDataSource: hinted.dataSource.(*TestDataSource)}
+	for _, table := range []*Table{measured, hinted} {
+		pumpMsgs(table, table.Init())
+		pumpMsgs(table, core.JumpToCmd(50))
+		pumpMsgs(table, core.CursorDownCmd())
+	}
+
+	// Rows take 5 lines: the hinted area spans the 10 viewport lines and the
+	// 10 lines after them, four rows, where measuring counts rows
+	start := hinted.GetState().ViewportStartIndex
+	if area := hinted.calculateBoundingArea(); area.StartIndex != start || area.EndIndex != start+3 {
+		t.Errorf("Expected the hinted area to span rows %d-%d, got %+v", start, start+3, area)
+	}
+	start = measured.GetState().ViewportStartIndex
+	if area := measured.calculateBoundingArea(); area.EndIndex != start+19 {
+		t.Errorf("Expected the measured area to span 20 rows from %d, got %+v", start, area)
+	}
+
+	// Both still show the same rows
+	if hinted.View() != measured.View() {
+		t.Errorf("Expected the same view:\n%s\n---\n%s", stripANSI(hinted.View()), stripANSI(measured.View()))
+	}
+}
+
+func TestTable_ReserveHeaderSpace(t *testing.T) {
+	shown := createTestTable(createTestRows(10))
+	shown.config.ShowHeaderSeparator = true
+	reserved := createTestTable(createTestRows(10))
+	reserved.config.ShowHeaderSeparator = true
+	pumpMsgs(reserved, core.HeaderVisibilityCmd(false))
+	reserved.config.ReserveHeaderSpace = true
+
+	shownLines := strings.Split(stripANSI(shown.View()), "\n")
+	reservedLines := strings.Split(stripANSI(reserved.View()), "\n")
+	if len(shownLines) != len(reservedLines) {
+		t.Fatalf("Expected as many lines with the header reserved, got %d and %d", len(reservedLines), len(shownLines))
+	}
+	if strings.TrimSpace(reservedLines[0]) != "" || lipgloss.Width(reservedLines[0]) != lipgloss.Width(shownLines[0]) {
+		t.Errorf("Expected a blank header line as wide as the header, got %q", reservedLines[0])
+	}
+	for i := 1; i < len(shownLines); i++ {
+		if reservedLines[i] != shownLines[i] {
+			t.Errorf("Line %d moved: %q, expected %q", i, reservedLines[i], shownLines[i])
+		}
+	}
+
+	// The header renders on its own even while hidden
+	if header := stripANSI(reserved.HeaderView()); header != shownLines[0] {
+		t.Errorf("Expected HeaderView %q, got %q", shownLines[0], header)
+	}
+}
+
+func TestTable_SelectionMergesIntoFormatter(t *testing.T) {
+	previousProfile := lipgloss.ColorProfile()
+	lipgloss.SetColorProfile(termenv.TrueColor)
+	defer lipgloss.SetColorProfile(previousProfile)
+
+	table := createTestTable(createTestRows(3))
+	table.config.Theme.SelectedStyle = lipgloss.NewStyle().Background(lipgloss.Color("#445566"))
+	ctrl := NewController(table)
+	ctrl.Do(table.SetCellFormatter(0, func(cellValue string, rowIndex int, column core.TableColumn, ctx core.RenderContext, isCursor, isSelected, isActiveCell bool) string {
+		return lipgloss.NewStyle().Foreground(lipgloss.Color("#ff0000")).Render(cellValue)
+	}))
+	ctrl.Do(core.SelectToggleCmd(1))
+
+	selectedLine := func() string {
+		for _, line := range strings.Split(ctrl.Render(), "\n") {
+			if strings.Contains(stripANSI(line), "Item 2") {
+				return line
+			}
+		}
+		t.Fatalf("Expected the selected row in view:\n%s", ctrl.Render())
+		return ""
+	}
+
+	line := selectedLine()
+	if !strings.Contains(line, "38;2;255;0;0") {
+		t.Errorf("Expected the formatter's foreground kept, got %q", line)
+	}
+	if n := unfilledCells(line, "48;2;68;85;102"); n != 0 {
+		t.Errorf("Expected the selection background under the formatted cell, found %d unstyled cells in %q", n, line)
+	}
+
+	table.config.SelectionOverridesFormatter = true
+	line = selectedLine()
+	if strings.Contains(line, "38;2;255;0;0") {
+		t.Errorf("Expected the formatter's foreground discarded, got %q", line)
+	}
+	if n := unfilledCells(line, "48;2;68;85;102"); n != 0 {
+		t.Errorf("Expected the selected row fully filled, found %d unstyled cells in %q", n, line)
+	}
+}
+
+func TestTable_RefreshTotalPreservingPosition(t *testing.T) {
+	table := createTestTable(createTestRows(100))
+	source := &indexableTestDataSource{table.dataSource.(*TestDataSource)}
+	table.dataSource = source
+	pumpMsgs(table, core.JumpToCmd(57))
+	before := table.GetState()
+
+	// Append 100 rows to the live data
+	source.data = append(source.data, createTestRows(200)[100:]...)
+	source.totalItems = len(source.data)
+
+	var changed []core.TotalChangedMsg
+	var cmds []tea.Cmd
+	for _, msg := range collectMsgs(table.RefreshTotalPreservingPosition()) {
+		_, cmd := table.Update(msg)
+		for _, next := range collectMsgs(cmd) {
+			if msg, ok := next.(core.TotalChangedMsg); ok {
+				changed = append(changed, msg)
+				continue
+			}
+			cmds = append(cmds, func() tea.Msg { return next })
+		}
+	}
+	pumpMsgs(table, tea.Batch(cmds...))
+
+	if len(changed) != 1 || changed[0] != (core.TotalChangedMsg{Old: 100, New: 200}) {
+		t.Errorf("Expected one TotalChangedMsg from 100 to 200, got %v", changed)
+	}
+	after := table.GetState()
+	if after.CursorIndex != before.CursorIndex || after.ViewportStartIndex != before.ViewportStartIndex {
+		t.Errorf("Expected cursor %d and scroll %d kept, got %d and %d", before.CursorIndex, before.ViewportStartIndex, after.CursorIndex, after.ViewportStartIndex)
+	}
+	if row, ok := table.GetCurrentRow(); !ok || row.ID != "row-57" {
+		t.Errorf("Expected the cursor on row-57, got %q", row.ID)
+	}
+	if table.GetTotalItems() != 200 {
+		t.Errorf("Expected 200 rows, got %d", table.GetTotalItems())
+	}
+
+	// Rows inserted above the cursor: it follows its row to the new index
+	source.data = append(createTestRows(205)[200:], source.data...)
+	for i := range source.data[:5] {
+		source.data[i].ID = fmt.Sprintf("new-%d", i)
+	}
+	source.totalItems = len(source.data)
+	pumpMsgs(table, table.RefreshTotalPreservingPosition())
+	if row, ok := table.GetCurrentRow(); !ok || row.ID != "row-57" || table.GetState().CursorIndex != 62 {
+		t.Errorf("Expected the cursor to follow row-57 to index 62, got %q at %d", row.ID, table.GetState().CursorIndex)
+	}
+}
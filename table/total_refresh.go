@@ -0,0 +1,78 @@
+package table
+
+import (
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/davidroman0O/vtable/core"
+	"github.com/davidroman0O/vtable/viewport"
+)
+
+// totalRefreshedMsg carries the total fetched by RefreshTotalPreservingPosition
+type totalRefreshedMsg struct {
+	total int
+}
+
+// RefreshTotalPreservingPosition fetches the total from the data source, e.g.
+// after rows were appended to a live feed, without moving the cursor or the
+// scroll position. The cursor stays on its row: an IndexableDataSource is
+// asked where the row is now, otherwise the cursor keeps its index, clamped
+// to the new total. Only the chunks past the smaller of the old and new
+// totals reload, unless the cursor row moved, which means earlier rows
+// changed too. A TotalChangedMsg reports a changed total.
+func (t *Table) RefreshTotalPreservingPosition() tea.Cmd {
+	if t.dataSource == nil {
+		return nil
+	}
+	refresh := t.dataSource.RefreshTotal()
+	if refresh == nil {
+		return nil
+	}
+	return func() tea.Msg {
+		msg := refresh()
+		if total, ok := msg.(core.DataTotalMsg); ok {
+			return totalRefreshedMsg{total: total.Total}
+		}
+		return msg
+	}
+}
+
+// handleTotalRefreshed applies a total fetched by
+// RefreshTotalPreservingPosition, keeping the cursor and scroll position
+func (t *Table) handleTotalRefreshed(total int) tea.Cmd {
+	oldTotal := t.totalItems
+	if total == oldTotal {
+		return nil
+	}
+
+	t.rememberRefreshCursor()
+	if t.refreshCursor != nil {
+		t.refreshCursor.inPlace = true
+	}
+
+	t.totalItems = total
+	t.updateViewportBounds()
+	if t.viewport.CursorIndex >= t.totalItems {
+		t.viewport = viewport.ClampCursor(t.viewport, t.config.ViewportConfig, t.totalItems)
+	}
+	t.dropChunksFrom(min(oldTotal, total))
+
+	var locate tea.Cmd
+	source, ok := t.dataSource.(core.IndexableDataSource)
+	if ok && t.refreshCursor != nil && t.refreshCursor.id != "" {
+		locate = source.IndexOfID(t.refreshCursor.id)
+	} else {
+		t.refreshCursor = nil
+	}
+	return tea.Batch(t.smartChunkManagement(), locate, t.computeAggregates(), core.TotalChangedCmd(oldTotal, total))
+}
+
+// dropChunksFrom drops the loaded chunks holding rows at or past index, so
+// they reload
+func (t *Table) dropChunksFrom(index int) {
+	for chunkStart := range t.chunks {
+		if chunkStart+t.config.ViewportConfig.ChunkSize > index {
+			delete(t.chunks, chunkStart)
+			delete(t.chunkAccessTime, chunkStart)
+		}
+	}
+}
@@ -0,0 +1,174 @@
+package table
+
+import (
+	"slices"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/davidroman0O/vtable/core"
+)
+
+// Row change transitions shown with TableConfig.AnimateChanges last
+// rowTransitionFrames ticks of rowTransitionInterval
+const (
+	rowTransitionFrames   = 3
+	rowTransitionInterval = 120 * time.Millisecond
+)
+
+// rowDiff classifies the rows of two visible ID lists
+type rowDiff struct {
+	Added   []string // only in the new list
+	Removed []string // only in the old list
+	Moved   []string // in both, but reordered relative to the other common rows
+}
+
+// diffRowIDs compares the visible row IDs before and after a refresh. Rows
+// shifted by additions or removals around them are not moved; only rows whose
+// order changed among the rows present in both lists are.
+func diffRowIDs(before, after []string) rowDiff {
+	var diff rowDiff
+	var commonBefore, commonAfter []string
+	for _, id := range before {
+		if slices.Contains(after, id) {
+			commonBefore = append(commonBefore, id)
+		} else {
+			diff.Removed = append(diff.Removed, id)
+		}
+	}
+	for _, id := range after {
+		if slices.Contains(before, id) {
+			commonAfter = append(commonAfter, id)
+		} else {
+			diff.Added = append(diff.Added, id)
+		}
+	}
+	for i, id := range commonAfter {
+		if commonBefore[i] != id {
+			diff.Moved = append(diff.Moved, id)
+		}
+	}
+	return diff
+}
+
+// exitingRow is a row a refresh removed, kept at its viewport position while
+// the transition plays
+type exitingRow struct {
+	item     core.Data[any]
+	position int
+}
+
+// rowTransition tracks a row change transition, from the refresh starting it
+// until its last frame
+type rowTransition struct {
+	before   []core.Data[any] // visible rows when the refresh started
+	settled  bool             // the new rows are known and the frames play
+	frames   int
+	entering map[string]bool
+	exiting  []exitingRow
+}
+
+// beginRowTransition remembers the visible rows before a refresh and keeps
+// the cursor on its row through it. It does nothing unless AnimateChanges is
+// set.
+func (t *Table) beginRowTransition() {
+	if !t.config.AnimateChanges {
+		t.rowTransition = nil
+		return
+	}
+
+	t.rowTransition = &rowTransition{before: slices.Clone(t.visibleItems)}
+	if item, ok := t.getItemAtIndex(t.viewport.CursorIndex); ok && t.pendingCursorID == "" {
+		t.pendingCursorID = item.ID
+	}
+}
+
+// settleRowTransition diffs the visible rows against those before the refresh
+// once the new rows are loaded and the cursor is back on its row, and starts
+// the transition frames.
+func (t *Table) settleRowTransition() tea.Cmd {
+	transition := t.rowTransition
+	if transition == nil || transition.settled || t.hasLoadingChunks || t.pendingCursorID != "" {
+		return nil
+	}
+
+	var before, after []string
+	for _, item := range transition.before {
+		before = append(before, item.ID)
+	}
+	for _, item := range t.visibleItems {
+		if !isPlaceholderID(item.ID) {
+			after = append(after, item.ID)
+		}
+	}
+	diff := diffRowIDs(before, after)
+	if len(diff.Added)+len(diff.Removed)+len(diff.Moved) == 0 {
+		t.rowTransition = nil
+		return nil
+	}
+
+	transition.settled = true
+	transition.frames = rowTransitionFrames
+	transition.entering = make(map[string]bool)
+	for _, id := range append(diff.Added, diff.Moved...) {
+		transition.entering[id] = true
+	}
+	for position, item := range transition.before {
+		if slices.Contains(diff.Removed, item.ID) {
+			transition.exiting = append(transition.exiting, exitingRow{item: item, position: position})
+		}
+	}
+	transition.before = nil
+	return core.RowTransitionTickCmd(rowTransitionInterval)
+}
+
+// handleRowTransitionTick plays one frame of the transition and ends it after
+// the last one
+func (t *Table) handleRowTransitionTick() tea.Cmd {
+	if t.rowTransition == nil || !t.rowTransition.settled {
+		return nil
+	}
+	t.rowTransition.frames--
+	if t.rowTransition.frames <= 0 {
+		t.rowTransition = nil
+		return nil
+	}
+	return core.RowTransitionTickCmd(rowTransitionInterval)
+}
+
+// transitionStyle returns the style of a row taking part in the transition
+func (t *Table) transitionStyle(id string) (lipgloss.Style, bool) {
+	if t.rowTransition == nil || !t.rowTransition.settled {
+		return lipgloss.Style{}, false
+	}
+	if t.rowTransition.entering[id] {
+		return t.config.Theme.RowEnterStyle, true
+	}
+	for _, row := range t.rowTransition.exiting {
+		if row.item.ID == id {
+			return t.config.Theme.RowExitStyle, true
+		}
+	}
+	return lipgloss.Style{}, false
+}
+
+// insertExitingRows renders the rows the refresh removed back at their former
+// positions among the rendered rows, within the viewport height. Inserted rows
+// get the index -1, so clicks on them select nothing.
+func (t *Table) insertExitingRows(rows []string, indices []int) ([]string, []int) {
+	if t.rowTransition == nil || !t.rowTransition.settled || t.hasWrappedColumns() {
+		return rows, indices
+	}
+
+	limit := max(len(rows), t.config.ViewportConfig.Height)
+	for _, row := range t.rowTransition.exiting {
+		position := min(row.position, len(rows))
+		rows = slices.Insert(rows, position, t.renderRow(row.item, -1, false))
+		indices = slices.Insert(indices, position, -1)
+	}
+	if len(rows) > limit {
+		rows, indices = rows[:limit], indices[:limit]
+	}
+	return rows, indices
+}
@@ -0,0 +1,69 @@
+package table
+
+import (
+	"github.com/davidroman0O/vtable/core"
+)
+
+// VisibleRows returns the rows View renders in the scrolling area, top to
+// bottom, after sorting and filtering: pinned rows are left out, and with
+// rows spanning several lines only the rows fitting the viewport height are returned.
+// Rows whose chunk is still loading are returned as their placeholder. It
+// allocates nothing but the returned slice unless columns wrap and the data
+// source gives no height hints.
+func (t *Table) VisibleRows() []core.VisibleRow {
+	if t.totalItems == 0 {
+		return nil
+	}
+	t.updateVisibleItems()
+
+	rows := make([]core.VisibleRow, 0, len(t.visibleItems))
+	wrapped := t.hasMultiLineRows()
+	var rendered []string
+	cursorRow := -1
+	width := t.rowWidth()
+
+	// Height hints pick the rows without rendering them
+	hintFrom, hintTo, hinted := 0, 0, false
+	if wrapped {
+		hintFrom, hintTo, hinted = t.hintedRowWindow(width)
+		wrapped = !hinted
+	}
+	for i, item := range t.visibleItems {
+		absoluteIndex := t.viewport.ViewportStartIndex + i
+		if absoluteIndex >= t.totalItems {
+			break
+		}
+		if t.isPinnedRow(item.ID) || (hinted && (i < hintFrom || i >= hintTo)) {
+			continue
+		}
+
+		isCursor := i == t.viewport.CursorViewportIndex
+		if isCursor {
+			cursorRow = len(rows)
+		}
+		row, _ := item.Item.(core.TableRow)
+		visible := core.VisibleRow{
+			Index:        absoluteIndex,
+			ID:           item.ID,
+			Row:          row,
+			IsCursor:     isCursor,
+			IsSelected:   item.Selected,
+			ActiveColumn: -1,
+		}
+		if t.isActiveCell(t.currentColumn, isCursor) {
+			visible.ActiveColumn = t.currentColumn
+		}
+		rows = append(rows, visible)
+
+		// Wrapped rows are measured the way View fits them to the height
+		if wrapped {
+			rendered = append(rendered, t.renderScrollingRow(item, absoluteIndex, isCursor, width))
+		}
+	}
+
+	if wrapped {
+		fitted, skipped := fitRowsToHeight(rendered, cursorRow, t.config.ViewportConfig.Height)
+		rows = rows[skipped : skipped+len(fitted)]
+	}
+	return rows
+}
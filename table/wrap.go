@@ -0,0 +1,292 @@
+package table
+
+import (
+	"strings"
+
+	"github.com/charmbracelet/x/ansi"
+
+	"github.com/davidroman0O/vtable/core"
+)
+
+// rowCell is a rendered cell of a row that may span several lines
+type rowCell struct {
+	lines  []string // Styled lines of content
+	blank  string   // Styled empty line used to pad the cell to the row height
+	valign int
+}
+
+// hasWrappedColumns reports whether any visible column wraps its text
+func (t *Table) hasWrappedColumns() bool {
+	for i, col := range t.columns {
+		if col.WrapText && !t.hiddenColumns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+// showsCardDetail reports whether the card layout shows the collapsed columns
+// on a second line of each row
+func (t *Table) showsCardDetail() bool {
+	return t.droppedColumnCount() > 0 && t.isCardLayout()
+}
+
+// hasMultiLineRows reports whether rows may span several lines, because a
+// column wraps or the card layout adds a detail line
+func (t *Table) hasMultiLineRows() bool {
+	return t.hasWrappedColumns() || t.showsCardDetail()
+}
+
+// renderScrollingRow renders a row of the scrolling area with its card detail
+// line, if any
+func (t *Table) renderScrollingRow(item core.Data[any], absoluteIndex int, isCursor bool, width int) string {
+	row := t.renderCachedRow(item, absoluteIndex, isCursor, width)
+	if t.showsCardDetail() {
+		row += "\n" + t.renderCardDetail(item, isCursor)
+	}
+	return row
+}
+
+// scrollsByLines reports whether the viewport scrolls by lines rather than
+// rows, because rows may span several lines. Paged viewports keep turning
+// whole pages of rows.
+func (t *Table) scrollsByLines() bool {
+	return t.hasMultiLineRows() && !t.config.ViewportConfig.PagedMode && t.config.ViewportConfig.Height > 0
+}
+
+// rowLineCount returns the number of lines the row at an absolute index takes
+// in the scrolling area. Pinned rows show above it and take none. The data
+// source's height hint is used when it gives one; otherwise loaded rows are
+// rendered and rows not loaded yet count as one line, plus the detail line
+// of the card layout.
+func (t *Table) rowLineCount(index, width int) int {
+	item, ok := t.getItemAtIndex(index)
+	if ok && t.isPinnedRow(item.ID) {
+		return 0
+	}
+
+	detail := 0
+	if t.showsCardDetail() {
+		detail = 1
+	}
+	if !ok || isPlaceholderID(item.ID) {
+		return 1 + detail
+	}
+	if source, hinted := t.idSource().(core.HeightHintDataSource); hinted {
+		return max(source.RowHeightHint(item.ID, width), 1) + detail
+	}
+	return strings.Count(t.renderScrollingRow(item, index, index == t.viewport.CursorIndex, width), "\n") + 1
+}
+
+// lineCounter sums the lines of ranges of rows, measuring each row once
+type lineCounter struct {
+	table  *Table
+	width  int
+	counts map[int]int
+}
+
+// lines returns the number of lines of the rows from first through last
+func (c *lineCounter) lines(first, last int) int {
+	total := 0
+	for i := first; i <= last; i++ {
+		count, ok := c.counts[i]
+		if !ok {
+			count = c.table.rowLineCount(i, c.width)
+			c.counts[i] = count
+		}
+		total += count
+	}
+	return total
+}
+
+// newLineCounter returns a lineCounter for rows rendered at the row width
+func (t *Table) newLineCounter() *lineCounter {
+	return &lineCounter{table: t, width: t.rowWidth(), counts: make(map[int]int)}
+}
+
+// fitViewportToLines scrolls the viewport so that it is budgeted in lines
+// rather than rows when rows span several lines: the cursor row and the
+// BottomThreshold rows after it fit in the viewport height, TopThreshold rows
+// stay above the cursor when scrolling up, and the viewport is not scrolled
+// past the last line. The threshold rows give way when rows are too tall for
+// them. CursorIndex is left unchanged. It reports whether the start moved.
+func (t *Table) fitViewportToLines() bool {
+	if !t.scrollsByLines() || t.totalItems == 0 {
+		return false
+	}
+
+	cfg := t.config.ViewportConfig
+	height := cfg.Height
+	counter := t.newLineCounter()
+	cursor := min(max(t.viewport.CursorIndex, 0), t.totalItems-1)
+	start := min(max(t.viewport.ViewportStartIndex, 0), cursor)
+	top, bottom := max(cfg.TopThreshold, cfg.ScrollOff, 0), max(cfg.BottomThreshold, cfg.ScrollOff, 0)
+
+	// Scrolling up keeps the rows above the cursor, dropping those below first
+	scrolledUp := cursor-start < top
+	if scrolledUp {
+		start = max(cursor-top, 0)
+	}
+	last := min(cursor+bottom, t.totalItems-1)
+	for (start < cursor || last > cursor) && counter.lines(start, last) > height {
+		if (scrolledUp || start == cursor) && last > cursor {
+			last--
+		} else {
+			start++
+		}
+	}
+
+	// Rows ending the dataset above the bottom edge pull the viewport back
+	end := t.totalItems - 1
+	if end-start < height && counter.lines(start, end) < height {
+		for start > 0 && counter.lines(start-1, end) <= height {
+			start--
+		}
+	}
+
+	moved := start != t.viewport.ViewportStartIndex
+	t.viewport.CursorIndex = cursor
+	t.viewport.ViewportStartIndex = start
+	t.viewport.CursorViewportIndex = cursor - start
+	t.viewport.IsAtTopThreshold = cfg.TopThreshold >= 0 && cursor-start == cfg.TopThreshold
+	t.viewport.IsAtBottomThreshold = cfg.BottomThreshold >= 0 && cursor+cfg.BottomThreshold+1 < t.totalItems &&
+		counter.lines(start, cursor+cfg.BottomThreshold+1) > height
+	t.viewport.AtDatasetStart = start == 0
+	t.viewport.AtDatasetEnd = end-start < height && counter.lines(start, end) <= height
+	return moved
+}
+
+// linePageRows returns the number of rows fully shown from the viewport start,
+// the size of a page when rows span several lines
+func (t *Table) linePageRows() int {
+	counter := t.newLineCounter()
+	start := t.viewport.ViewportStartIndex
+	rows := 0
+	for start+rows < t.totalItems && counter.lines(start, start+rows) <= t.config.ViewportConfig.Height {
+		rows++
+	}
+	return max(rows, 1)
+}
+
+// wrapCellText wraps text to width, breaking words longer than the width
+func wrapCellText(text string, width int) []string {
+	if width <= 0 || text == "" {
+		return []string{text}
+	}
+	return strings.Split(ansi.Wrap(text, width, ""), "\n")
+}
+
+// composeRowLines lays out the cells of a row on as many lines as its tallest
+// cell, padding shorter cells according to their vertical alignment. The
+// indicator shows on the first line only.
+func (t *Table) composeRowLines(indicator, indicatorBlank string, cells []rowCell, separator, pinnedSeparator string) []string {
+	height := 1
+	for _, cell := range cells {
+		if len(cell.lines) > height {
+			height = len(cell.lines)
+		}
+	}
+
+	padded := make([][]string, len(cells))
+	for i, cell := range cells {
+		padded[i] = padCellLines(cell, height)
+	}
+
+	lines := make([]string, height)
+	parts := make([]string, len(cells)+1)
+	for k := range lines {
+		parts[0] = indicatorBlank
+		if k == 0 {
+			parts[0] = indicator
+		}
+		for i := range cells {
+			parts[i+1] = padded[i][k]
+		}
+		lines[k] = t.joinCells(parts, 1, separator, pinnedSeparator)
+	}
+	return lines
+}
+
+// padCellLines pads a cell's lines with blank lines up to height
+func padCellLines(cell rowCell, height int) []string {
+	missing := height - len(cell.lines)
+	if missing <= 0 {
+		return cell.lines
+	}
+
+	var top int
+	switch cell.valign {
+	case core.AlignMiddle:
+		top = missing / 2
+	case core.AlignBottom:
+		top = missing
+	}
+
+	lines := make([]string, 0, height)
+	for i := 0; i < top; i++ {
+		lines = append(lines, cell.blank)
+	}
+	lines = append(lines, cell.lines...)
+	for len(lines) < height {
+		lines = append(lines, cell.blank)
+	}
+	return lines
+}
+
+// fitRowsToHeight keeps the rendered rows, possibly spanning several lines,
+// that fit in height lines while keeping the cursor row in view. Rows before
+// the cursor are dropped first, then lines past the height are cut. It returns
+// the kept rows and the position of the first one in rows.
+func fitRowsToHeight(rows []string, cursor, height int) ([]string, int) {
+	if height <= 0 || len(rows) == 0 {
+		return rows, 0
+	}
+
+	heights := make([]int, len(rows))
+	for i, row := range rows {
+		heights[i] = strings.Count(row, "\n") + 1
+	}
+	start, _ := fitHeights(heights, cursor, height)
+
+	var fitted []string
+	used := 0
+	for _, row := range rows[start:] {
+		lines := strings.Split(row, "\n")
+		if used+len(lines) > height {
+			lines = lines[:height-used]
+		}
+		fitted = append(fitted, strings.Join(lines, "\n"))
+		used += len(lines)
+		if used >= height {
+			break
+		}
+	}
+	return fitted, start
+}
+
+// fitHeights returns the first and the number of rows of the given heights,
+// in lines, that fit in height lines while keeping the cursor row in view.
+// Rows before the cursor are dropped first; the last row kept may be cut.
+func fitHeights(heights []int, cursor, height int) (int, int) {
+	if cursor < 0 || cursor >= len(heights) {
+		cursor = 0
+	}
+
+	// Drop leading rows until the rows through the cursor fit
+	start, used := cursor, heights[cursor]
+	for start > 0 && used+heights[start-1] <= height {
+		start--
+		used += heights[start]
+	}
+
+	count := 0
+	used = 0
+	for _, rowHeight := range heights[start:] {
+		count++
+		used += rowHeight
+		if used >= height {
+			break
+		}
+	}
+	return start, count
+}
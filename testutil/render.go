@@ -0,0 +1,86 @@
+// Package testutil helps write golden tests for vtable components. It drives a
+// Bubble Tea model through messages without a terminal, running the commands
+// they return, and captures the rendered view so it can be compared with the
+// expected output.
+package testutil
+
+import (
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/x/ansi"
+
+	"github.com/davidroman0O/vtable/core"
+)
+
+// maxRounds bounds the rounds of commands answering messages, so models whose
+// commands keep producing messages stop.
+const maxRounds = 20
+
+// RenderToString drives a model like a program would and returns its view,
+// with the ANSI styling stripped. The model is initialized, sized with a
+// tea.WindowSizeMsg of width and height, then updated with each of msgs in
+// turn. After each step the commands returned are run synchronously and
+// their messages fed back to the model, so data loaded asynchronously is in
+// the view. Timer commands, such as animation ticks and debounces, are
+// skipped (see core.RunCmd).
+//
+// The view is clipped to the width and height, like a terminal of that size
+// would show it: longer lines are cut and lines past the height dropped.
+func RenderToString(m tea.Model, width, height int, msgs ...tea.Msg) string {
+	return core.StripANSI(RenderToStringANSI(m, width, height, msgs...))
+}
+
+// RenderToStringANSI is RenderToString keeping the ANSI styling, for golden
+// tests of colors and styles. Set lipgloss' color profile in the test so the
+// output doesn't depend on the terminal running it.
+func RenderToStringANSI(m tea.Model, width, height int, msgs ...tea.Msg) string {
+	m = Drive(m, m.Init())
+	m = Send(m, tea.WindowSizeMsg{Width: width, Height: height})
+	for _, msg := range msgs {
+		m = Send(m, msg)
+	}
+	return clip(m.View(), width, height)
+}
+
+// Send updates the model with a message and drives the commands it returns,
+// as RenderToString does for each message, so tests can check the model's
+// state between messages.
+func Send(m tea.Model, msg tea.Msg) tea.Model {
+	m, cmd := m.Update(msg)
+	return Drive(m, cmd)
+}
+
+// Drive runs a command and feeds its messages to the model, then the
+// commands the model returns, round after round until none is left.
+// Commands run synchronously through core.RunCmd, which skips timer commands.
+func Drive(m tea.Model, cmd tea.Cmd) tea.Model {
+	for round := 0; round < maxRounds && cmd != nil; round++ {
+		var next []tea.Cmd
+		for _, msg := range core.RunCmd(cmd) {
+			if _, quit := msg.(tea.QuitMsg); quit {
+				continue
+			}
+			var follow tea.Cmd
+			m, follow = m.Update(msg)
+			next = append(next, follow)
+		}
+		cmd = tea.Batch(next...)
+	}
+	return m
+}
+
+// clip cuts the lines of a view to width cells and keeps the first height
+// lines. A width or height of zero or less leaves that dimension alone.
+func clip(view string, width, height int) string {
+	lines := strings.Split(view, "\n")
+	if height > 0 && len(lines) > height {
+		lines = lines[:height]
+	}
+	if width > 0 {
+		for i, line := range lines {
+			lines[i] = ansi.Truncate(line, width, "")
+		}
+	}
+	return strings.Join(lines, "\n")
+}
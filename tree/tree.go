@@ -5,6 +5,7 @@ package tree
 
 import (
 	"fmt"
+	"slices"
 	"strings"
 	"time"
 
@@ -25,6 +26,9 @@ type TreeData[T any] struct {
 	Item     T
 	Children []TreeData[T]
 	Expanded bool
+	// Lazy marks a node whose children are not known yet. A LazyTreeDataSource
+	// loads them the first time the node is expanded.
+	Lazy bool
 }
 
 // TreeDataSource defines the contract for providing hierarchical data to the
@@ -58,6 +62,19 @@ type FlatTreeItem[T any] struct {
 	HasChildNodes bool // Renamed to avoid conflict with method
 	Expanded      bool
 	ParentID      string
+	// ChildCount is the number of direct children of the node.
+	ChildCount int
+	// DescendantCount is the number of nodes below this node at any depth.
+	DescendantCount int
+	// Placeholder marks the loading row shown beneath a node whose children
+	// are being loaded.
+	Placeholder bool
+	// LastChild is true for the last child of its parent, or the last root.
+	LastChild bool
+	// AncestorLines holds, for each ancestor from the root down to the
+	// parent, whether it has siblings below it, i.e. whether a vertical
+	// connector runs through its column beside the node.
+	AncestorLines []bool
 }
 
 // GetDepth returns the indentation level of this tree item.
@@ -75,6 +92,27 @@ func (f FlatTreeItem[T]) IsExpanded() bool {
 	return f.Expanded
 }
 
+// GetChildCount returns the number of direct children of this item.
+func (f FlatTreeItem[T]) GetChildCount() int {
+	return f.ChildCount
+}
+
+// GetDescendantCount returns the number of nodes below this item at any depth.
+func (f FlatTreeItem[T]) GetDescendantCount() int {
+	return f.DescendantCount
+}
+
+// IsLastChild returns true if this item is the last child of its parent.
+func (f FlatTreeItem[T]) IsLastChild() bool {
+	return f.LastChild
+}
+
+// GetAncestorLines returns whether each ancestor of this item, from the root
+// down, has siblings below it.
+func (f FlatTreeItem[T]) GetAncestorLines() []bool {
+	return f.AncestorLines
+}
+
 // TreeList is a stateful Bubble Tea component that displays a scrollable,
 // hierarchical list. It manages tree-specific state like node expansion and
 // selection, flattens the tree structure for efficient rendering, and reuses
@@ -98,6 +136,12 @@ type TreeList[T any] struct {
 	selectedNodes map[string]bool   // A set of IDs for currently selected nodes
 	flattenedView []FlatTreeItem[T] // The cached linear representation of the visible tree
 
+	loadingChildren map[string]bool // IDs of lazy nodes whose children are being loaded
+
+	search        *treeSearch // Active node search, nil when none
+	pendingReveal string      // Node to reveal once a lazy ancestor has loaded
+	pendingCursor string      // Node to put the cursor on once lazy children have loaded
+
 	// Rendering - uses a tree-specific component system
 	formatter         core.ItemFormatter[any]
 	animatedFormatter core.ItemFormatterAnimated[any]
@@ -115,6 +159,7 @@ type TreeList[T any] struct {
 	loadingChunks    map[int]bool
 	hasLoadingChunks bool
 	canScroll        bool
+	chunkLoadStarted map[int]time.Time // Load start times for chunk lifecycle logging
 
 	// Error handling
 	lastError error
@@ -138,6 +183,19 @@ type TreeConfig struct {
 	// ExpandOnSelect, when true, expands or collapses a node when it is selected.
 	ExpandOnSelect bool
 
+	// LoadingText is shown in the row beneath a lazy node whose children are
+	// being loaded.
+	LoadingText string
+
+	// SearchMatchStyle styles the text of nodes matching the query passed to
+	// Search.
+	SearchMatchStyle lipgloss.Style
+
+	// Columns, when set, shows fields of the nodes in columns right of the
+	// tree, as a tree-table. With ShowHeader the header takes a line above
+	// the nodes.
+	Columns *TreeColumnConfig
+
 	// The fields below are legacy and kept for backward compatibility. The
 	// component-based rendering system in `TreeRenderConfig` is now the
 	// preferred way to control appearance.
@@ -164,6 +222,8 @@ func DefaultTreeConfig() TreeConfig {
 		AutoExpand:            true,
 		ShowRoot:              true,
 		ExpandOnSelect:        true,
+		LoadingText:           "Loading...",
+		SearchMatchStyle:      lipgloss.NewStyle().Background(lipgloss.Color("220")).Foreground(lipgloss.Color("0")),
 		Enumerator:            tree.DefaultEnumerator,
 		Indenter:              tree.DefaultIndenter,
 		RootStyle:             lipgloss.NewStyle(),
@@ -195,10 +255,12 @@ func NewTreeList[T any](listConfig core.ListConfig, treeConfig TreeConfig, dataS
 		rootNodes:        dataSource.GetRootNodes(),
 		expandedNodes:    make(map[string]bool),
 		selectedNodes:    make(map[string]bool),
+		loadingChildren:  make(map[string]bool),
 		treeConfig:       treeConfig,
 		chunkAccessTime:  make(map[int]time.Time),
 		visibleItems:     make([]core.Data[any], 0),
 		loadingChunks:    make(map[int]bool),
+		chunkLoadStarted: make(map[int]time.Time),
 		hasLoadingChunks: false,
 		canScroll:        true,
 		viewport: core.ViewportState{
@@ -231,6 +293,17 @@ func (tl *TreeList[T]) Init() tea.Cmd {
 // messages for navigation, data manipulation, tree expansion, and other state
 // changes. It implements the bubbletea.Model interface.
 func (tl *TreeList[T]) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	previousCursor := tl.viewport.CursorIndex
+	model, cmd := tl.update(msg)
+	if followCmd := tl.selectOnCursorMove(previousCursor); followCmd != nil {
+		cmd = tea.Batch(cmd, followCmd)
+	}
+	return model, cmd
+}
+
+// update dispatches a message to its handler. Update wraps it to apply the
+// cursor-follows-selection behavior once per message.
+func (tl *TreeList[T]) update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	switch msg := msg.(type) {
 	// ===== Navigation Messages - Same as List =====
 	case core.CursorUpMsg:
@@ -261,10 +334,32 @@ func (tl *TreeList[T]) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		cmd := tl.handleJumpTo(msg.Index)
 		return tl, cmd
 
+	case core.JumpToPercentMsg:
+		if tl.totalItems == 0 {
+			return tl, nil
+		}
+		return tl, core.JumpToCmd(viewport.PercentIndex(msg.Percent, tl.totalItems))
+
 	case core.TreeJumpToIndexMsg:
 		cmd := tl.handleTreeJumpToIndex(msg.Index, msg.ExpandParents)
 		return tl, cmd
 
+	case core.TreeExpandAllMsg:
+		cmd := tl.ExpandAll()
+		return tl, cmd
+
+	case core.TreeCollapseAllMsg:
+		cmd := tl.CollapseAll()
+		return tl, cmd
+
+	case core.TreeExpandToDepthMsg:
+		cmd := tl.ExpandToDepth(msg.Depth)
+		return tl, cmd
+
+	case core.TreeChildrenLoadedMsg:
+		cmd := tl.handleChildrenLoaded(msg)
+		return tl, cmd
+
 	// ===== Data Messages - Same as List =====
 	case core.DataRefreshMsg:
 		cmd := tl.handleDataRefresh()
@@ -321,6 +416,10 @@ func (tl *TreeList[T]) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		cmd := tl.handleSelectAll()
 		return tl, cmd
 
+	case core.SelectAllToggleMsg:
+		cmd := tl.handleSelectAllToggle()
+		return tl, cmd
+
 	case core.SelectClearMsg:
 		cmd := tl.handleSelectClear()
 		return tl, cmd
@@ -368,6 +467,11 @@ func (tl *TreeList[T]) View() string {
 		// The data will appear automatically when chunks load
 	}
 
+	if tl.treeConfig.Columns != nil && tl.treeConfig.Columns.ShowHeader {
+		builder.WriteString(tl.renderColumnHeader())
+		builder.WriteString("\n")
+	}
+
 	// Render each visible item using component-based system
 	for i, item := range tl.visibleItems {
 		absoluteIndex := tl.viewport.ViewportStartIndex + i
@@ -380,7 +484,9 @@ func (tl *TreeList[T]) View() string {
 
 		var renderedItem string
 
-		if tl.formatter != nil {
+		if flatItem, ok := item.Item.(FlatTreeItem[T]); ok && flatItem.Placeholder {
+			renderedItem = tl.renderLoadingPlaceholder(flatItem, isCursor)
+		} else if tl.formatter != nil {
 			// Use custom formatter
 			renderedItem = tl.formatter(
 				item,
@@ -424,6 +530,13 @@ func (tl *TreeList[T]) View() string {
 			}
 		}
 
+		if tl.search != nil && tl.search.matched[item.ID] {
+			renderedItem = tl.highlightSearchMatch(renderedItem)
+		}
+		if tl.treeConfig.Columns != nil {
+			renderedItem = tl.renderColumnRow(renderedItem, item, isCursor)
+		}
+
 		builder.WriteString(renderedItem)
 
 		if i < len(tl.visibleItems)-1 && absoluteIndex < tl.totalItems-1 {
@@ -522,14 +635,17 @@ func (tl *TreeList[T]) formatItemContent(item T) string {
 }
 
 // ExpandNode expands a tree node specified by its ID, revealing its children.
-// It then updates the flattened view and refreshes the data.
+// It then updates the flattened view and refreshes the data. A lazy node shows
+// a loading row until the data source delivers its children.
 func (tl *TreeList[T]) ExpandNode(id string) tea.Cmd {
 	tl.expandedNodes[id] = true
+	loadCmd := tl.startLoadingChildren(id)
 	tl.updateFlattenedView()
 	// Update total and refresh chunks
 	return tea.Batch(
 		core.DataTotalUpdateCmd(len(tl.flattenedView)),
 		core.DataChunksRefreshCmd(),
+		loadCmd,
 	)
 }
 
@@ -537,6 +653,8 @@ func (tl *TreeList[T]) ExpandNode(id string) tea.Cmd {
 // It then updates the flattened view and refreshes the data.
 func (tl *TreeList[T]) CollapseNode(id string) tea.Cmd {
 	delete(tl.expandedNodes, id)
+	// Children still loading are discarded when they arrive
+	delete(tl.loadingChildren, id)
 	tl.updateFlattenedView()
 	// Update total and refresh chunks
 	return tea.Batch(
@@ -579,12 +697,14 @@ func (tl *TreeList[T]) flattenNodesFullyExpanded(nodes []TreeData[T], parentID s
 	for _, node := range nodes {
 		// Add the node itself
 		*result = append(*result, FlatTreeItem[T]{
-			ID:            node.ID,
-			Item:          node.Item,
-			Depth:         depth,
-			HasChildNodes: len(node.Children) > 0,
-			Expanded:      true, // Always expanded in this view
-			ParentID:      parentID,
+			ID:              node.ID,
+			Item:            node.Item,
+			Depth:           depth,
+			HasChildNodes:   len(node.Children) > 0,
+			Expanded:        true, // Always expanded in this view
+			ParentID:        parentID,
+			ChildCount:      len(node.Children),
+			DescendantCount: countDescendants(node.Children),
 		})
 
 		// Always add children (fully expanded)
@@ -631,31 +751,63 @@ func (tl *TreeList[T]) findItemIndexInFlattenedView(itemID string) int {
 // the tree structure changes.
 func (tl *TreeList[T]) updateFlattenedView() {
 	tl.flattenedView = nil
-	tl.flattenNodes(tl.rootNodes, "", 0)
+	tl.flattenNodes(tl.rootNodes, "", 0, nil)
 	tl.totalItems = len(tl.flattenedView)
 }
 
 // flattenNodes is a recursive helper function that traverses the tree data and
 // builds the `flattenedView`, respecting the current expansion state of each node.
-func (tl *TreeList[T]) flattenNodes(nodes []TreeData[T], parentID string, depth int) {
-	for _, node := range nodes {
+// ancestorLines tells for each ancestor of the nodes whether it has siblings
+// below it, for drawing connectors.
+func (tl *TreeList[T]) flattenNodes(nodes []TreeData[T], parentID string, depth int, ancestorLines []bool) {
+	for i, node := range nodes {
+		lastChild := i == len(nodes)-1
+		// Children append to a copy, so siblings keep their own lines
+		childLines := append(slices.Clip(ancestorLines), !lastChild)
+
 		// Add the node itself
 		tl.flattenedView = append(tl.flattenedView, FlatTreeItem[T]{
-			ID:            node.ID,
-			Item:          node.Item,
-			Depth:         depth,
-			HasChildNodes: len(node.Children) > 0,
-			Expanded:      tl.expandedNodes[node.ID],
-			ParentID:      parentID,
+			ID:              node.ID,
+			Item:            node.Item,
+			Depth:           depth,
+			HasChildNodes:   len(node.Children) > 0 || node.Lazy,
+			Expanded:        tl.expandedNodes[node.ID],
+			ParentID:        parentID,
+			ChildCount:      len(node.Children),
+			DescendantCount: countDescendants(node.Children),
+			LastChild:       lastChild,
+			AncestorLines:   ancestorLines,
 		})
 
+		// Show a loading row while the children of a lazy node load
+		if tl.expandedNodes[node.ID] && tl.loadingChildren[node.ID] {
+			tl.flattenedView = append(tl.flattenedView, FlatTreeItem[T]{
+				ID:            loadingPlaceholderID(node.ID),
+				Depth:         depth + 1,
+				ParentID:      node.ID,
+				Placeholder:   true,
+				LastChild:     true,
+				AncestorLines: childLines,
+			})
+			continue
+		}
+
 		// Add children if expanded
 		if tl.expandedNodes[node.ID] && len(node.Children) > 0 {
-			tl.flattenNodes(node.Children, node.ID, depth+1)
+			tl.flattenNodes(node.Children, node.ID, depth+1, childLines)
 		}
 	}
 }
 
+// countDescendants returns the number of nodes in the given subtrees.
+func countDescendants[T any](nodes []TreeData[T]) int {
+	count := len(nodes)
+	for _, node := range nodes {
+		count += countDescendants(node.Children)
+	}
+	return count
+}
+
 // loadInitialData prepares the initial state of the tree by setting the total
 // number of items based on the initial flattened view.
 func (tl *TreeList[T]) loadInitialData() tea.Cmd {
@@ -683,6 +835,12 @@ func (tl *TreeList[T]) smartChunkManagement() tea.Cmd {
 			// Create chunk data from flattened view
 			cmd := tl.loadChunkFromFlattenedView(chunkStart, chunkSize)
 			cmds = append(cmds, cmd)
+			tl.logChunkEvent(core.ChunkEvent{
+				Type:       core.ChunkEventLoadStarted,
+				StartIndex: chunkStart,
+				Size:       chunkSize,
+				Request:    core.DataRequest{Start: chunkStart, Count: chunkSize},
+			})
 		}
 	}
 
@@ -698,6 +856,7 @@ func (tl *TreeList[T]) smartChunkManagement() tea.Cmd {
 		delete(tl.chunks, chunkStart)
 		delete(tl.chunkAccessTime, chunkStart)
 		cmds = append(cmds, core.ChunkUnloadedCmd(chunkStart))
+		tl.logChunkEvent(core.ChunkEvent{Type: core.ChunkEventUnloaded, StartIndex: chunkStart, Size: chunkSize})
 	}
 
 	return tea.Batch(cmds...)
@@ -749,7 +908,13 @@ func (tl *TreeList[T]) loadChunkFromFlattenedView(chunkStart, chunkSize int) tea
 // handleCursorUp processes a "cursor up" event, recalculating the viewport
 // and cursor positions. It reuses the core viewport logic.
 func (tl *TreeList[T]) handleCursorUp() tea.Cmd {
-	if tl.totalItems == 0 || !tl.canScroll || tl.viewport.CursorIndex <= 0 {
+	if tl.totalItems == 0 || !tl.canScroll {
+		return nil
+	}
+	if tl.viewport.CursorIndex <= 0 {
+		if tl.config.ViewportConfig.WrapNavigation && tl.totalItems > 1 {
+			return tea.Batch(tl.handleJumpToEnd(), core.NavigationWrappedCmd("up"))
+		}
 		return nil
 	}
 
@@ -766,7 +931,13 @@ func (tl *TreeList[T]) handleCursorUp() tea.Cmd {
 // handleCursorDown processes a "cursor down" event, adjusting the viewport for
 // downward movement. It reuses the core viewport logic.
 func (tl *TreeList[T]) handleCursorDown() tea.Cmd {
-	if tl.totalItems == 0 || !tl.canScroll || tl.viewport.CursorIndex >= tl.totalItems-1 {
+	if tl.totalItems == 0 || !tl.canScroll {
+		return nil
+	}
+	if tl.viewport.CursorIndex >= tl.totalItems-1 {
+		if tl.config.ViewportConfig.WrapNavigation && tl.totalItems > 1 {
+			return tea.Batch(tl.handleJumpToStart(), core.NavigationWrappedCmd("down"))
+		}
 		return nil
 	}
 
@@ -786,6 +957,9 @@ func (tl *TreeList[T]) handlePageUp() tea.Cmd {
 	if tl.totalItems == 0 || !tl.canScroll {
 		return nil
 	}
+	if tl.viewport.CursorIndex <= 0 && tl.config.ViewportConfig.WrapPageNavigation && tl.totalItems > 1 {
+		return tea.Batch(tl.handleJumpToEnd(), core.NavigationWrappedCmd("up"))
+	}
 
 	previousState := tl.viewport
 	tl.viewport = viewport.CalculatePageUp(tl.viewport, tl.config.ViewportConfig, tl.totalItems)
@@ -800,6 +974,9 @@ func (tl *TreeList[T]) handlePageUp() tea.Cmd {
 // down by one page. It reuses the core viewport logic.
 func (tl *TreeList[T]) handlePageDown() tea.Cmd {
 	if tl.viewport.CursorIndex >= tl.totalItems-1 {
+		if tl.config.ViewportConfig.WrapPageNavigation && tl.totalItems > 1 {
+			return tea.Batch(tl.handleJumpToStart(), core.NavigationWrappedCmd("down"))
+		}
 		return nil
 	}
 
@@ -959,11 +1136,23 @@ func (tl *TreeList[T]) ensureChunkLoadedImmediate(index int) {
 }
 
 // handleDataRefresh performs a hard refresh of the tree's data. It clears all
-// local caches and re-initiates the data loading process.
+// local caches, re-fetches the root nodes from the data source and re-initiates
+// the data loading process. Expansion state is keyed by node ID, so nodes that
+// still exist after the reload keep their expanded state.
 func (tl *TreeList[T]) handleDataRefresh() tea.Cmd {
 	tl.chunks = make(map[int]core.Chunk[any])
+	tl.rootNodes = tl.treeDataSource.GetRootNodes()
+
+	// Expanded lazy nodes reload the children the refresh dropped
+	cmds := []tea.Cmd{}
+	for id := range tl.expandedNodes {
+		if cmd := tl.startLoadingChildren(id); cmd != nil {
+			cmds = append(cmds, cmd)
+		}
+	}
+
 	tl.updateFlattenedView()
-	return core.DataTotalCmd(tl.totalItems)
+	return tea.Batch(append(cmds, core.DataTotalCmd(tl.totalItems))...)
 }
 
 // handleDataChunkLoaded processes a newly loaded data chunk. It adds the chunk
@@ -989,9 +1178,21 @@ func (tl *TreeList[T]) handleDataChunkLoaded(msg core.DataChunkLoadedMsg) tea.Cm
 	tl.updateVisibleItems()
 	tl.updateViewportBounds()
 
+	tl.logChunkEvent(core.ChunkEvent{
+		Type:       core.ChunkEventLoadCompleted,
+		StartIndex: msg.StartIndex,
+		Size:       len(msg.Items),
+		Request:    msg.Request,
+	})
+
 	return core.ChunkLoadingCompletedCmd(msg.StartIndex, len(msg.Items), msg.Request)
 }
 
+// logChunkEvent reports a chunk lifecycle event to the configured ChunkLogger.
+func (tl *TreeList[T]) logChunkEvent(event core.ChunkEvent) {
+	data.ReportChunkEvent(tl.config.ViewportConfig.ChunkLogger, tl.chunkLoadStarted, event)
+}
+
 // handleSelectCurrent toggles the selection state of the item currently under
 // the cursor. If cascading selection is enabled, it also toggles all descendants.
 func (tl *TreeList[T]) handleSelectCurrent() tea.Cmd {
@@ -1001,6 +1202,9 @@ func (tl *TreeList[T]) handleSelectCurrent() tea.Cmd {
 
 	if tl.viewport.CursorIndex >= 0 && tl.viewport.CursorIndex < len(tl.flattenedView) {
 		currentItem := tl.flattenedView[tl.viewport.CursorIndex]
+		if currentItem.Placeholder {
+			return nil
+		}
 
 		// Toggle the current item's selection
 		newSelectionState := !tl.selectedNodes[currentItem.ID]
@@ -1011,11 +1215,39 @@ func (tl *TreeList[T]) handleSelectCurrent() tea.Cmd {
 			tl.cascadeSelection(currentItem.ID, newSelectionState)
 		}
 
-		return tl.refreshChunks()
+		return tl.advanceAfterSelect(tl.refreshChunks())
 	}
 	return nil
 }
 
+// advanceAfterSelect moves the cursor down one row after a selection when
+// Selection.MoveCursorOnSelect is enabled.
+func (tl *TreeList[T]) advanceAfterSelect(selectCmd tea.Cmd) tea.Cmd {
+	selection := tl.config.Selection
+	if !selection.MoveCursorOnSelect || selection.SelectOnCursorMove {
+		return selectCmd
+	}
+	return tea.Batch(selectCmd, tl.handleCursorDown())
+}
+
+// selectOnCursorMove deselects the node the cursor left and selects the node it
+// landed on when Selection.SelectOnCursorMove is enabled. Cascading selection
+// is not applied, so moving over a parent does not select its subtree.
+func (tl *TreeList[T]) selectOnCursorMove(previousCursor int) tea.Cmd {
+	if !tl.config.Selection.SelectOnCursorMove || tl.config.SelectionMode == core.SelectionNone ||
+		tl.viewport.CursorIndex == previousCursor || tl.viewport.CursorIndex < 0 ||
+		tl.viewport.CursorIndex >= len(tl.flattenedView) {
+		return nil
+	}
+
+	if previousCursor >= 0 && previousCursor < len(tl.flattenedView) {
+		delete(tl.selectedNodes, tl.flattenedView[previousCursor].ID)
+	}
+	tl.selectedNodes[tl.flattenedView[tl.viewport.CursorIndex].ID] = true
+
+	return tl.refreshChunks()
+}
+
 // cascadeSelection recursively applies the selection state to a node's descendants.
 func (tl *TreeList[T]) cascadeSelection(parentID string, selected bool) {
 	// Find the parent node in the tree structure
@@ -1067,11 +1299,28 @@ func (tl *TreeList[T]) handleSelectAll() tea.Cmd {
 	}
 
 	for _, item := range tl.flattenedView {
-		tl.selectedNodes[item.ID] = true
+		if !item.Placeholder {
+			tl.selectedNodes[item.ID] = true
+		}
 	}
 	return tl.refreshChunks()
 }
 
+// handleSelectAllToggle selects all visible items, or clears the selection if
+// every visible item is already selected.
+func (tl *TreeList[T]) handleSelectAllToggle() tea.Cmd {
+	if tl.config.SelectionMode != core.SelectionMultiple {
+		return nil
+	}
+
+	for _, item := range tl.flattenedView {
+		if !item.Placeholder && !tl.selectedNodes[item.ID] {
+			return tl.handleSelectAll()
+		}
+	}
+	return tl.handleSelectClear()
+}
+
 // handleSelectClear clears all node selections.
 func (tl *TreeList[T]) handleSelectClear() tea.Cmd {
 	tl.selectedNodes = make(map[string]bool)
@@ -1348,6 +1597,39 @@ func (tl *TreeList[T]) GetState() core.ViewportState {
 	return tl.viewport
 }
 
+// DebugSnapshot returns the viewport math and loaded chunks of the tree, to
+// attach to bug reports about scrolling and chunk loading. Indices count the
+// visible nodes of the flattened tree.
+func (tl *TreeList[T]) DebugSnapshot() core.DebugSnapshot {
+	return core.NewDebugSnapshot(tl.viewport, tl.config.ViewportConfig, tl.totalItems, tl.chunks, tl.loadingChunks)
+}
+
+// VisibleItems returns the nodes View renders, top to bottom, in the
+// flattened tree. Nodes whose chunk is still loading are returned as their
+// placeholder.
+func (tl *TreeList[T]) VisibleItems() []core.VisibleItem {
+	if tl.totalItems == 0 {
+		return nil
+	}
+	tl.updateVisibleItems()
+
+	items := make([]core.VisibleItem, 0, len(tl.visibleItems))
+	for i, item := range tl.visibleItems {
+		absoluteIndex := tl.viewport.ViewportStartIndex + i
+		if absoluteIndex >= tl.totalItems {
+			break
+		}
+		items = append(items, core.VisibleItem{
+			Index:      absoluteIndex,
+			ID:         item.ID,
+			Item:       item,
+			IsCursor:   i == tl.viewport.CursorViewportIndex,
+			IsSelected: item.Selected,
+		})
+	}
+	return items
+}
+
 // GetSelectionCount returns the number of currently selected nodes.
 func (tl *TreeList[T]) GetSelectionCount() int {
 	return len(tl.selectedNodes)
@@ -1492,38 +1774,93 @@ func (tl *TreeList[T]) CollapseCurrentSubtree() tea.Cmd {
 	return tl.CollapseSubtree(currentID)
 }
 
-// ExpandAll expands all nodes in the entire tree.
+// ExpandAll expands all nodes in the entire tree. The cursor stays on the same
+// node and a TreeStructureChangedMsg reports the new number of visible nodes.
 func (tl *TreeList[T]) ExpandAll() tea.Cmd {
+	currentID := tl.GetCurrentNodeID()
+
 	// Expand all nodes
 	for _, node := range tl.rootNodes {
 		tl.expandNodeRecursively(node)
 	}
 
-	// Update the flattened view and refresh
-	tl.updateFlattenedView()
-	return tea.Batch(
-		core.DataTotalUpdateCmd(len(tl.flattenedView)),
-		core.DataChunksRefreshCmd(),
-	)
+	return tl.rebuildKeepingCursor(currentID)
 }
 
-// CollapseAll collapses all nodes in the entire tree.
+// CollapseAll collapses all nodes in the entire tree. The cursor moves to the
+// root node it was under and a TreeStructureChangedMsg reports the new number
+// of visible nodes.
 func (tl *TreeList[T]) CollapseAll() tea.Cmd {
+	currentID := tl.GetCurrentNodeID()
+
 	// Collapse all nodes
 	for _, node := range tl.rootNodes {
 		tl.collapseNodeRecursively(node)
 	}
 
-	// Update the flattened view and refresh
+	return tl.rebuildKeepingCursor(currentID)
+}
+
+// ExpandToDepth expands the tree so that nodes down to the given depth are
+// visible and collapses every node below. Root nodes are at depth 0, so a
+// depth of 0 collapses the whole tree. The cursor stays on the same node, or
+// moves to its closest visible ancestor.
+func (tl *TreeList[T]) ExpandToDepth(depth int) tea.Cmd {
+	currentID := tl.GetCurrentNodeID()
+
+	for _, node := range tl.rootNodes {
+		tl.collapseNodeRecursively(node)
+	}
+	tl.expandToDepth(tl.rootNodes, 0, depth)
+
+	return tl.rebuildKeepingCursor(currentID)
+}
+
+// expandToDepth expands the nodes above the given depth.
+func (tl *TreeList[T]) expandToDepth(nodes []TreeData[T], level, depth int) {
+	if level >= depth {
+		return
+	}
+	for _, node := range nodes {
+		if node.Lazy {
+			continue
+		}
+		tl.expandedNodes[node.ID] = true
+		tl.expandToDepth(node.Children, level+1, depth)
+	}
+}
+
+// rebuildKeepingCursor rebuilds the flattened view after a bulk expansion
+// change. The cursor stays on the node with the given ID, or moves to its
+// closest visible ancestor when the node is now hidden.
+func (tl *TreeList[T]) rebuildKeepingCursor(currentID string) tea.Cmd {
 	tl.updateFlattenedView()
+
+	index := tl.findItemIndexInFlattenedView(currentID)
+	if index < 0 {
+		path := tl.findPathToItem(currentID, tl.rootNodes, nil)
+		for i := len(path) - 1; i >= 0 && index < 0; i-- {
+			index = tl.findItemIndexInFlattenedView(path[i])
+		}
+	}
+	if index >= 0 {
+		tl.viewport = viewport.CalculateJumpTo(index, tl.config.ViewportConfig, tl.totalItems)
+	}
+
 	return tea.Batch(
 		core.DataTotalUpdateCmd(len(tl.flattenedView)),
 		core.DataChunksRefreshCmd(),
+		core.TreeStructureChangedCmd(len(tl.flattenedView)),
 	)
 }
 
-// expandNodeRecursively expands a node and all its descendants.
+// expandNodeRecursively expands a node and all its descendants. Lazy nodes stay
+// collapsed, as expanding them would load their whole subtree.
 func (tl *TreeList[T]) expandNodeRecursively(node TreeData[T]) {
+	if node.Lazy {
+		return
+	}
+
 	// Mark this node as expanded
 	tl.expandedNodes[node.ID] = true
 
@@ -1537,9 +1874,106 @@ func (tl *TreeList[T]) expandNodeRecursively(node TreeData[T]) {
 func (tl *TreeList[T]) collapseNodeRecursively(node TreeData[T]) {
 	// Mark this node as collapsed
 	delete(tl.expandedNodes, node.ID)
+	delete(tl.loadingChildren, node.ID)
 
 	// Recursively collapse all children
 	for _, child := range node.Children {
 		tl.collapseNodeRecursively(child)
 	}
 }
+
+// GetExpansionState returns the set of currently expanded node IDs. The returned
+// map is a copy and can be serialized (e.g. to JSON) to restore the tree later
+// with SetExpansionState.
+func (tl *TreeList[T]) GetExpansionState() map[string]bool {
+	state := make(map[string]bool, len(tl.expandedNodes))
+	for id, expanded := range tl.expandedNodes {
+		if expanded {
+			state[id] = true
+		}
+	}
+	return state
+}
+
+// SetExpansionState replaces the expansion state of the tree with the given set
+// of node IDs. IDs that don't exist in the current data are kept, so they apply
+// as soon as a reload brings those nodes back. Expanded lazy nodes load their
+// children, showing a loading row meanwhile. The cursor stays on the same node
+// if it is still visible, or returns to it once the lazy children hiding it
+// have loaded.
+func (tl *TreeList[T]) SetExpansionState(state map[string]bool) tea.Cmd {
+	currentID := tl.GetCurrentNodeID()
+
+	tl.expandedNodes = make(map[string]bool, len(state))
+	for id, expanded := range state {
+		if expanded {
+			tl.expandedNodes[id] = true
+		}
+	}
+
+	// Children still loading under nodes no longer expanded are discarded,
+	// and restored lazy nodes load theirs
+	var cmds []tea.Cmd
+	for id := range tl.loadingChildren {
+		if !tl.expandedNodes[id] {
+			delete(tl.loadingChildren, id)
+		}
+	}
+	for id := range tl.expandedNodes {
+		if cmd := tl.startLoadingChildren(id); cmd != nil {
+			cmds = append(cmds, cmd)
+		}
+	}
+
+	tl.updateFlattenedView()
+
+	cmds = append(cmds,
+		core.DataTotalUpdateCmd(len(tl.flattenedView)),
+		core.DataChunksRefreshCmd(),
+	)
+
+	tl.pendingCursor = ""
+	if index := tl.findItemIndexInFlattenedView(currentID); index >= 0 {
+		tl.viewport = viewport.CalculateJumpTo(index, tl.config.ViewportConfig, tl.totalItems)
+	} else if len(tl.loadingChildren) > 0 {
+		tl.pendingCursor = currentID
+	}
+
+	return tea.Batch(cmds...)
+}
+
+// JumpToNode moves the cursor onto the node with the given ID if it is visible
+// in the current flattened view. While lazy children are loading, as after
+// SetExpansionState, a node not visible yet gets the cursor once it shows up.
+// Combined with SetExpansionState it restores the tree exactly as the user
+// left it.
+func (tl *TreeList[T]) JumpToNode(id string) tea.Cmd {
+	tl.pendingCursor = ""
+	index := tl.findItemIndexInFlattenedView(id)
+	if index < 0 {
+		if len(tl.loadingChildren) > 0 {
+			tl.pendingCursor = id
+		}
+		return nil
+	}
+	return core.JumpToCmd(index)
+}
+
+// resumeCursor moves the cursor onto the node JumpToNode or SetExpansionState
+// left pending once loaded children bring it into view. The node is given up
+// when no children are loading anymore.
+func (tl *TreeList[T]) resumeCursor() tea.Cmd {
+	if tl.pendingCursor == "" {
+		return nil
+	}
+	index := tl.findItemIndexInFlattenedView(tl.pendingCursor)
+	if index < 0 {
+		if len(tl.loadingChildren) == 0 {
+			tl.pendingCursor = ""
+		}
+		return nil
+	}
+	tl.pendingCursor = ""
+	tl.viewport = viewport.CalculateJumpTo(index, tl.config.ViewportConfig, tl.totalItems)
+	return tl.smartChunkManagement()
+}
@@ -0,0 +1,141 @@
+package tree
+
+import (
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/x/ansi"
+
+	"github.com/davidroman0O/vtable/core"
+)
+
+// TreeColumnConfig turns a TreeList into a tree-table: the rendered node, with
+// its cursor, indentation and connectors, fills a first column of LabelWidth
+// cells, and the cells Cells returns for the node follow in columns aligned
+// like a table's.
+type TreeColumnConfig struct {
+	// LabelWidth is the width of the tree column. Longer nodes are cut with an
+	// ellipsis. It defaults to 30.
+	LabelWidth int
+	// LabelTitle is the header of the tree column.
+	LabelTitle string
+
+	// Columns are the columns right of the tree column. Their Title, Width,
+	// Alignment and TruncateMode are used; the alignment defaults from Type
+	// as in a table.
+	Columns []core.TableColumn
+	// Cells returns the cells of a node, one per column. item.Item is the
+	// node's FlatTreeItem. Missing cells are blank.
+	Cells func(item core.Data[any]) []string
+
+	// Separator goes between columns. It defaults to a space.
+	Separator string
+
+	// ShowHeader shows the column titles, styled with HeaderStyle, on a line
+	// above the nodes.
+	ShowHeader  bool
+	HeaderStyle lipgloss.Style
+
+	// CursorStyle and SelectedStyle highlight the node under the cursor and
+	// the selected nodes across every column. Without colors of its own,
+	// CursorStyle falls back to TreeConfig.CursorBackgroundStyle and
+	// SelectedStyle to a purple background.
+	CursorStyle   lipgloss.Style
+	SelectedStyle lipgloss.Style
+}
+
+// labelWidth returns the width of the tree column
+func (c *TreeColumnConfig) labelWidth() int {
+	if c.LabelWidth <= 0 {
+		return 30
+	}
+	return c.LabelWidth
+}
+
+// separator returns the string between columns
+func (c *TreeColumnConfig) separator() string {
+	if c.Separator == "" {
+		return " "
+	}
+	return c.Separator
+}
+
+// renderColumnHeader renders the header line of a tree-table
+func (tl *TreeList[T]) renderColumnHeader() string {
+	columns := tl.treeConfig.Columns
+	parts := []string{fitTreeCell(columns.LabelTitle, columns.labelWidth(), core.AlignLeft, core.TruncateEnd)}
+	for _, col := range columns.Columns {
+		parts = append(parts, fitTreeCell(col.Title, col.Width, core.ColumnAlignment(col), col.TruncateMode))
+	}
+	return columns.HeaderStyle.Render(strings.Join(parts, columns.separator()))
+}
+
+// renderColumnRow lays a rendered node out as the tree column, followed by the
+// node's cells. The cursor and selected rows are highlighted across the whole
+// line, under the styling of the node and cells.
+func (tl *TreeList[T]) renderColumnRow(label string, item core.Data[any], isCursor bool) string {
+	columns := tl.treeConfig.Columns
+
+	var cells []string
+	if flatItem, ok := item.Item.(FlatTreeItem[T]); columns.Cells != nil && !(ok && flatItem.Placeholder) {
+		cells = columns.Cells(item)
+	}
+
+	parts := []string{fitTreeCell(label, columns.labelWidth(), core.AlignLeft, core.TruncateEnd)}
+	for i, col := range columns.Columns {
+		cell := ""
+		if i < len(cells) {
+			cell = cells[i]
+		}
+		parts = append(parts, fitTreeCell(cell, col.Width, core.ColumnAlignment(col), col.TruncateMode))
+	}
+	line := strings.Join(parts, columns.separator())
+
+	var style lipgloss.Style
+	switch {
+	case isCursor:
+		style = columns.CursorStyle
+		if !hasTreeColors(style) {
+			style = tl.treeConfig.CursorBackgroundStyle
+		}
+	case item.Selected:
+		style = columns.SelectedStyle
+		if !hasTreeColors(style) {
+			style = lipgloss.NewStyle().Background(lipgloss.Color("57")).Foreground(lipgloss.Color("230"))
+		}
+	default:
+		return line
+	}
+	// The highlight goes under the styling of the node and cells, which
+	// keeps showing
+	if !strings.Contains(line, "\x1b[") {
+		return style.Render(line)
+	}
+	return core.MergeStyle(line, style)
+}
+
+// fitTreeCell cuts or pads text to exactly width cells, aligned as given
+func fitTreeCell(text string, width, alignment int, mode core.TruncateMode) string {
+	if width <= 0 {
+		return ""
+	}
+	text = core.TruncateText(text, width, mode, "…")
+
+	padding := width - ansi.StringWidth(text)
+	switch alignment {
+	case core.AlignRight:
+		return strings.Repeat(" ", padding) + text
+	case core.AlignCenter:
+		return strings.Repeat(" ", padding/2) + text + strings.Repeat(" ", padding-padding/2)
+	default:
+		return text + strings.Repeat(" ", padding)
+	}
+}
+
+// hasTreeColors reports whether a style sets colors or reverses them, i.e.
+// whether it shows as a highlight
+func hasTreeColors(style lipgloss.Style) bool {
+	_, noForeground := style.GetForeground().(lipgloss.NoColor)
+	_, noBackground := style.GetBackground().(lipgloss.NoColor)
+	return !noForeground || !noBackground || style.GetReverse()
+}
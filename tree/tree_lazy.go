@@ -0,0 +1,149 @@
+package tree
+
+import (
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/davidroman0O/vtable/core"
+)
+
+// LazyTreeDataSource is an optional extension of TreeDataSource for trees too
+// large to load eagerly. Nodes returned with Lazy set have unknown children;
+// the first time such a node is expanded, the TreeList shows a loading row
+// beneath it and calls LoadChildren.
+type LazyTreeDataSource[T any] interface {
+	TreeDataSource[T]
+
+	// LoadChildren returns a command that loads the children of a node and
+	// reports them with a core.TreeChildrenLoadedMsg, typically built with
+	// ChildrenLoadedCmd.
+	LoadChildren(nodeID string) tea.Cmd
+}
+
+// ChildrenLoadedCmd creates a command that sends a core.TreeChildrenLoadedMsg
+// carrying the loaded children of a node.
+func ChildrenLoadedCmd[T any](parentID string, children []TreeData[T], err error) tea.Cmd {
+	return func() tea.Msg {
+		return core.TreeChildrenLoadedMsg{
+			ParentID: parentID,
+			Children: children,
+			Err:      err,
+		}
+	}
+}
+
+// loadingPlaceholderID returns the ID of the loading row shown under a node
+func loadingPlaceholderID(parentID string) string {
+	return parentID + ":loading"
+}
+
+// startLoadingChildren fires LoadChildren for a lazy node that is being
+// expanded. It returns nil when the node is not lazy, is already loading, or
+// the data source cannot load children.
+func (tl *TreeList[T]) startLoadingChildren(id string) tea.Cmd {
+	if tl.loadingChildren[id] {
+		return nil
+	}
+	lazySource, ok := tl.treeDataSource.(LazyTreeDataSource[T])
+	if !ok {
+		return nil
+	}
+	node, found := tl.findNodeInTree(tl.rootNodes, id)
+	if !found || !node.Lazy {
+		return nil
+	}
+
+	tl.loadingChildren[id] = true
+	return lazySource.LoadChildren(id)
+}
+
+// handleChildrenLoaded splices loaded children under their parent. Results for
+// a node that was collapsed while loading are discarded, so the node loads
+// again on its next expansion. Loaded lazy children that are already expanded,
+// as after SetExpansionState, start loading their own children in turn.
+func (tl *TreeList[T]) handleChildrenLoaded(msg core.TreeChildrenLoadedMsg) tea.Cmd {
+	if !tl.loadingChildren[msg.ParentID] {
+		return nil
+	}
+	delete(tl.loadingChildren, msg.ParentID)
+
+	var cmds []tea.Cmd
+	if msg.Err != nil {
+		tl.lastError = msg.Err
+		tl.pendingReveal = ""
+	} else {
+		children, _ := msg.Children.([]TreeData[T])
+		if nodes, ok := replaceChildren(tl.rootNodes, msg.ParentID, children); ok {
+			tl.rootNodes = nodes
+			cmds = tl.loadExpandedChildren(children)
+		}
+	}
+
+	tl.updateFlattenedView()
+	return tea.Batch(append(cmds,
+		core.DataTotalUpdateCmd(len(tl.flattenedView)),
+		core.DataChunksRefreshCmd(),
+		tl.resumeReveal(),
+		tl.resumeCursor(),
+	)...)
+}
+
+// loadExpandedChildren starts loading the children of the expanded lazy nodes
+// among nodes and their expanded descendants
+func (tl *TreeList[T]) loadExpandedChildren(nodes []TreeData[T]) []tea.Cmd {
+	var cmds []tea.Cmd
+	for _, node := range nodes {
+		if !tl.expandedNodes[node.ID] {
+			continue
+		}
+		if cmd := tl.startLoadingChildren(node.ID); cmd != nil {
+			cmds = append(cmds, cmd)
+		}
+		cmds = append(cmds, tl.loadExpandedChildren(node.Children)...)
+	}
+	return cmds
+}
+
+// replaceChildren returns a copy of nodes where the node with the given ID has
+// the given children and is no longer lazy. Only the slices on the path to the
+// node are copied, leaving the data source's nodes untouched.
+func replaceChildren[T any](nodes []TreeData[T], id string, children []TreeData[T]) ([]TreeData[T], bool) {
+	for i, node := range nodes {
+		if node.ID == id {
+			node.Children = children
+			node.Lazy = false
+		} else if updated, ok := replaceChildren(node.Children, id, children); ok {
+			node.Children = updated
+		} else {
+			continue
+		}
+
+		result := make([]TreeData[T], len(nodes))
+		copy(result, nodes)
+		result[i] = node
+		return result, true
+	}
+	return nil, false
+}
+
+// renderLoadingPlaceholder renders the row shown beneath a node whose children
+// are loading, indented one level below the node.
+func (tl *TreeList[T]) renderLoadingPlaceholder(flatItem FlatTreeItem[T], isCursor bool) string {
+	renderConfig := tl.treeConfig.RenderConfig
+
+	prefix := renderConfig.CursorConfig.NormalSpacing
+	if isCursor {
+		prefix = renderConfig.CursorConfig.CursorIndicator
+	}
+
+	indent := renderConfig.IndentationConfig.IndentString
+	if indent == "" {
+		indent = strings.Repeat(" ", renderConfig.IndentationConfig.IndentSize)
+	}
+
+	text := tl.treeConfig.LoadingText
+	if text == "" {
+		text = "Loading..."
+	}
+	return prefix + strings.Repeat(indent, flatItem.Depth) + tl.renderContext.LoadingIndicator + " " + text
+}
@@ -80,6 +80,15 @@ type TreeComponentContext struct {
 	IsExpanded bool
 	// ParentID is the ID of the parent node.
 	ParentID string
+	// ChildCount is the number of direct children of the node.
+	ChildCount int
+	// DescendantCount is the number of nodes below the node at any depth.
+	DescendantCount int
+	// IsLastChild is true if the node is the last child of its parent.
+	IsLastChild bool
+	// AncestorLines tells for each ancestor of the node, from the root down,
+	// whether it has siblings below it.
+	AncestorLines []bool
 
 	// RenderContext provides global rendering information like theming and
 	// utility functions.
@@ -110,6 +119,75 @@ type TreeRenderConfig struct {
 	ContentConfig     TreeContentConfig
 	PostSpacingConfig TreeSpacingConfig
 	BackgroundConfig  TreeBackgroundConfig
+
+	// ShowChildCountWhenCollapsed, if true, appends the number of hidden nodes
+	// to the content of collapsed nodes, e.g. "Documents (3)".
+	ShowChildCountWhenCollapsed bool
+	// ChildCountMode selects whether direct children or all descendants are
+	// counted.
+	ChildCountMode TreeChildCountMode
+	// ChildCountFormatter formats the count appended to collapsed nodes. When
+	// nil, DefaultChildCountFormatter is used.
+	ChildCountFormatter TreeChildCountFormatter
+}
+
+// TreeChildCountMode selects which nodes are counted for collapsed parents.
+type TreeChildCountMode int
+
+// Constants for child count modes.
+const (
+	// TreeChildCountDirect counts only the direct children of the node.
+	TreeChildCountDirect TreeChildCountMode = iota
+	// TreeChildCountTotal counts every descendant of the node.
+	TreeChildCountTotal
+)
+
+// TreeChildCountFormatter is a function type for rendering the count appended to
+// collapsed nodes. known is false when the node reports children that have not
+// been loaded yet, in which case count holds the number known so far.
+type TreeChildCountFormatter func(count int, known bool) string
+
+// DefaultChildCountFormatter renders the count as " (N)", or " (?)" when the
+// children have not been loaded yet.
+func DefaultChildCountFormatter(count int, known bool) string {
+	if !known {
+		return " (?)"
+	}
+	return fmt.Sprintf(" (%d)", count)
+}
+
+// treeChildCounter is implemented by flattened tree items that know the size
+// of their subtree.
+type treeChildCounter interface {
+	GetChildCount() int
+	GetDescendantCount() int
+}
+
+// treeBranchInfo is implemented by flattened tree items that know where they
+// sit among their siblings and ancestors.
+type treeBranchInfo interface {
+	IsLastChild() bool
+	GetAncestorLines() []bool
+}
+
+// childCountText returns the count text for a collapsed parent, or "" when
+// counts are disabled or the node is expanded or a leaf.
+func childCountText(ctx TreeComponentContext) string {
+	config := ctx.TreeConfig
+	if !config.ShowChildCountWhenCollapsed || !ctx.HasChildren || ctx.IsExpanded {
+		return ""
+	}
+
+	count := ctx.ChildCount
+	if config.ChildCountMode == TreeChildCountTotal {
+		count = ctx.DescendantCount
+	}
+
+	formatter := config.ChildCountFormatter
+	if formatter == nil {
+		formatter = DefaultChildCountFormatter
+	}
+	return formatter(count, ctx.ChildCount > 0)
 }
 
 // TreeCursorConfig configures the appearance and behavior of the cursor component.
@@ -156,8 +234,52 @@ type TreeIndentationConfig struct {
 	// UseConnectors is true.
 	ConnectorStyle lipgloss.Style
 	// UseConnectors, if true, renders indentation using box-drawing characters
-	// to create a classic tree look.
+	// to create a classic tree look. The connectors draw the shape of the
+	// tree only: collapsed parents no longer get a "├+ " branch, their state
+	// is shown by the TreeSymbolConfig symbols.
 	UseConnectors bool
+	// ConnectorChars are the characters the connectors are drawn with. The
+	// zero value draws DefaultTreeConnectorChars.
+	ConnectorChars TreeConnectorChars
+}
+
+// TreeConnectorChars defines the characters used for drawing the connectors
+// of a tree. Each level of indentation draws one of them, so they should all
+// be equally wide.
+type TreeConnectorChars struct {
+	// Vertical continues the line of an ancestor with siblings below it.
+	Vertical string
+	// Branch leads to a node with siblings below it.
+	Branch string
+	// LastBranch leads to the last child of its parent.
+	LastBranch string
+	// Space fills the column of an ancestor without siblings below it.
+	Space string
+}
+
+// DefaultTreeConnectorChars returns the default characters used for tree
+// connectors: single lines with square corners.
+func DefaultTreeConnectorChars() TreeConnectorChars {
+	return TreeConnectorChars{Vertical: "│  ", Branch: "├─ ", LastBranch: "└─ ", Space: "   "}
+}
+
+// RoundedTreeConnectorChars returns tree connectors with a rounded corner on
+// the last child.
+func RoundedTreeConnectorChars() TreeConnectorChars {
+	chars := DefaultTreeConnectorChars()
+	chars.LastBranch = "╰─ "
+	return chars
+}
+
+// HeavyTreeConnectorChars returns tree connectors drawn with heavy lines.
+func HeavyTreeConnectorChars() TreeConnectorChars {
+	return TreeConnectorChars{Vertical: "┃  ", Branch: "┣━ ", LastBranch: "┗━ ", Space: "   "}
+}
+
+// ASCIITreeConnectorChars returns tree connectors drawn with "|", "-" and "`"
+// only.
+func ASCIITreeConnectorChars() TreeConnectorChars {
+	return TreeConnectorChars{Vertical: "|  ", Branch: "|- ", LastBranch: "`- ", Space: "   "}
 }
 
 // TreeSymbolConfig configures the component that displays symbols indicating a
@@ -351,24 +473,24 @@ func (c *TreeIndentationComponent) Render(ctx TreeComponentContext) string {
 	var indent strings.Builder
 
 	if c.config.UseConnectors {
-		// Use box-drawing characters for indentation
-		for i := 0; i < ctx.Depth; i++ {
-			if i == ctx.Depth-1 {
-				// This is the last level, use appropriate connector
-				if ctx.HasChildren {
-					if ctx.IsExpanded {
-						indent.WriteString("├─ ")
-					} else {
-						indent.WriteString("├+ ")
-					}
-				} else {
-					indent.WriteString("├─ ")
-				}
+		chars := c.config.ConnectorChars
+		if chars == (TreeConnectorChars{}) {
+			chars = DefaultTreeConnectorChars()
+		}
+		// The columns of the ancestors below the root carry on their lines
+		// while they have siblings below; without that information they do
+		for level := 1; level < ctx.Depth; level++ {
+			if level >= len(ctx.AncestorLines) || ctx.AncestorLines[level] {
+				indent.WriteString(chars.Vertical)
 			} else {
-				// Intermediate levels
-				indent.WriteString("│  ")
+				indent.WriteString(chars.Space)
 			}
 		}
+		if ctx.IsLastChild {
+			indent.WriteString(chars.LastBranch)
+		} else {
+			indent.WriteString(chars.Branch)
+		}
 	} else {
 		// Use simple string-based indentation
 		if c.config.IndentString != "" {
@@ -581,6 +703,8 @@ func (c *TreeContentComponent) Render(ctx TreeComponentContext) string {
 		}
 	}
 
+	content += childCountText(ctx)
+
 	return c.config.Style.Render(content)
 }
 
@@ -845,6 +969,14 @@ func (r *TreeComponentRenderer) Render(
 		ComponentData: make(map[TreeComponentType]string),
 		TreeConfig:    r.config,
 	}
+	if counter, ok := item.Item.(treeChildCounter); ok {
+		ctx.ChildCount = counter.GetChildCount()
+		ctx.DescendantCount = counter.GetDescendantCount()
+	}
+	if branch, ok := item.Item.(treeBranchInfo); ok {
+		ctx.IsLastChild = branch.IsLastChild()
+		ctx.AncestorLines = branch.GetAncestorLines()
+	}
 
 	// First pass: render all non-background components
 	for _, compType := range r.config.ComponentOrder {
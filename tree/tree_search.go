@@ -0,0 +1,203 @@
+package tree
+
+import (
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/davidroman0O/vtable/core"
+	"github.com/davidroman0O/vtable/viewport"
+)
+
+// NodePathProvider is an optional extension of LazyTreeDataSource that knows
+// where a node sits in the tree before its ancestors are loaded. RevealNode
+// uses it to load the lazy ancestors of a node one level at a time.
+type NodePathProvider interface {
+	// GetNodePath returns the IDs of the ancestors of a node, from the root
+	// down to its parent, and whether the node exists.
+	GetNodePath(id string) ([]string, bool)
+}
+
+// treeSearch holds the state of a node search
+type treeSearch struct {
+	query   string
+	matches []string
+	matched map[string]bool
+	current int // index into matches, -1 before the first step
+}
+
+// Search finds the nodes whose text contains query, ignoring case, and returns
+// their IDs in tree order. The text of a node is its String method when it
+// implements fmt.Stringer. Collapsed nodes are searched too; the children of
+// lazy nodes are not, until they are loaded. Matches are highlighted with the
+// SearchMatchStyle and stepped through with SearchNext and SearchPrev. An
+// empty query clears the search.
+func (tl *TreeList[T]) Search(query string) []string {
+	if query == "" {
+		tl.ClearSearch()
+		return nil
+	}
+
+	search := &treeSearch{query: query, matched: make(map[string]bool), current: -1}
+	needle := strings.ToLower(query)
+	tl.collectSearchMatches(tl.rootNodes, needle, search)
+	tl.search = search
+
+	return append([]string(nil), search.matches...)
+}
+
+// collectSearchMatches walks the nodes depth first, recording the matches
+func (tl *TreeList[T]) collectSearchMatches(nodes []TreeData[T], needle string, search *treeSearch) {
+	for _, node := range nodes {
+		if strings.Contains(strings.ToLower(tl.formatItemContent(node.Item)), needle) {
+			search.matches = append(search.matches, node.ID)
+			search.matched[node.ID] = true
+		}
+		tl.collectSearchMatches(node.Children, needle, search)
+	}
+}
+
+// ClearSearch ends the node search and removes its highlights.
+func (tl *TreeList[T]) ClearSearch() {
+	tl.search = nil
+	tl.pendingReveal = ""
+}
+
+// SearchNext reveals the next search match, wrapping to the first one.
+func (tl *TreeList[T]) SearchNext() tea.Cmd {
+	return tl.stepSearch(1)
+}
+
+// SearchPrev reveals the previous search match, wrapping to the last one.
+func (tl *TreeList[T]) SearchPrev() tea.Cmd {
+	return tl.stepSearch(-1)
+}
+
+// stepSearch moves delta matches from the current one and reveals it
+func (tl *TreeList[T]) stepSearch(delta int) tea.Cmd {
+	if tl.search == nil || len(tl.search.matches) == 0 {
+		return nil
+	}
+	search := tl.search
+
+	count := len(search.matches)
+	switch {
+	case search.current >= 0:
+		search.current = ((search.current+delta)%count + count) % count
+	case delta < 0:
+		search.current = count - 1
+	default:
+		search.current = 0
+	}
+
+	return tl.RevealNode(search.matches[search.current])
+}
+
+// RevealNode expands all ancestors of a node and moves the cursor onto it.
+// When an ancestor is a lazy node whose children are not loaded yet, it is
+// loaded first and the reveal completes once its children arrive; this needs
+// a data source implementing NodePathProvider for nodes below it. It returns
+// nil when the node is unknown.
+func (tl *TreeList[T]) RevealNode(id string) tea.Cmd {
+	path, found := tl.nodePath(id)
+	if !found {
+		return nil
+	}
+
+	tl.pendingReveal = ""
+	var cmds []tea.Cmd
+	for _, ancestorID := range path {
+		tl.expandedNodes[ancestorID] = true
+		cmds = append(cmds, tl.startLoadingChildren(ancestorID))
+		if tl.loadingChildren[ancestorID] {
+			// Deeper ancestors are known once these children arrive
+			tl.pendingReveal = id
+			break
+		}
+	}
+
+	tl.updateFlattenedView()
+	cmds = append(cmds,
+		core.DataTotalUpdateCmd(len(tl.flattenedView)),
+		core.DataChunksRefreshCmd(),
+	)
+
+	if tl.pendingReveal == "" {
+		if index := tl.findItemIndexInFlattenedView(id); index >= 0 {
+			tl.viewport = viewport.CalculateJumpTo(index, tl.config.ViewportConfig, tl.totalItems)
+			cmds = append(cmds, tl.smartChunkManagement())
+		}
+	}
+
+	return tea.Batch(cmds...)
+}
+
+// nodePath returns the ancestor IDs of a node, root first. Nodes that are not
+// loaded yet are looked up through a NodePathProvider.
+func (tl *TreeList[T]) nodePath(id string) ([]string, bool) {
+	if _, found := tl.findNodeInTree(tl.rootNodes, id); found {
+		return tl.findPathToItem(id, tl.rootNodes, nil), true
+	}
+	if provider, ok := tl.treeDataSource.(NodePathProvider); ok {
+		return provider.GetNodePath(id)
+	}
+	return nil, false
+}
+
+// resumeReveal continues a reveal that waited for the children of a lazy
+// ancestor to load.
+func (tl *TreeList[T]) resumeReveal() tea.Cmd {
+	if tl.pendingReveal == "" {
+		return nil
+	}
+	id := tl.pendingReveal
+	tl.pendingReveal = ""
+	return tl.RevealNode(id)
+}
+
+// highlightSearchMatch styles the occurrences of the search query in a
+// rendered line with the SearchMatchStyle. Occurrences are looked up in the
+// visible text, so styling already applied to the line is left intact.
+func (tl *TreeList[T]) highlightSearchMatch(line string) string {
+	needle := strings.ToLower(tl.search.query)
+
+	// Map each visible byte to its offset in the line, skipping escape codes
+	var visible strings.Builder
+	var offsets []int
+	for i := 0; i < len(line); i++ {
+		if line[i] == '\x1b' && i+1 < len(line) && line[i+1] == '[' {
+			i += 2
+			for i < len(line) && (line[i] < 0x40 || line[i] > 0x7e) {
+				i++
+			}
+			continue
+		}
+		visible.WriteByte(line[i])
+		offsets = append(offsets, i)
+	}
+
+	// Case folding may change byte lengths, which would misplace the matches
+	lower := strings.ToLower(visible.String())
+	if len(lower) != len(offsets) {
+		return line
+	}
+
+	var builder strings.Builder
+	last := 0
+	for from := 0; ; {
+		idx := strings.Index(lower[from:], needle)
+		if idx < 0 {
+			break
+		}
+		start, end := offsets[from+idx], offsets[from+idx+len(needle)-1]+1
+		from += idx + len(needle)
+		if end-start != len(needle) {
+			// An escape code splits the match, leave it as rendered
+			continue
+		}
+		builder.WriteString(line[last:start])
+		builder.WriteString(tl.treeConfig.SearchMatchStyle.Render(line[start:end]))
+		last = end
+	}
+	builder.WriteString(line[last:])
+	return builder.String()
+}
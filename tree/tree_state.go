@@ -0,0 +1,52 @@
+package tree
+
+import (
+	"sort"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/davidroman0O/vtable/core"
+	"github.com/davidroman0O/vtable/viewport"
+)
+
+// SaveState captures the view state of the tree: the cursor node, the expanded
+// nodes and the selected nodes. IDs are sorted so equal states compare equal.
+func (tl *TreeList[T]) SaveState() core.PersistedState {
+	state := core.PersistedState{
+		CursorID:    tl.GetCurrentNodeID(),
+		CursorIndex: tl.viewport.CursorIndex,
+	}
+	for id := range tl.GetExpansionState() {
+		state.ExpandedNodes = append(state.ExpandedNodes, id)
+	}
+	for id, selected := range tl.selectedNodes {
+		if selected {
+			state.SelectedIDs = append(state.SelectedIDs, id)
+		}
+	}
+	sort.Strings(state.ExpandedNodes)
+	sort.Strings(state.SelectedIDs)
+	return state
+}
+
+// RestoreState applies a state saved with SaveState. The cursor returns to the
+// saved node if it is visible, otherwise it stays at the saved index, clamped
+// to the visible nodes.
+func (tl *TreeList[T]) RestoreState(state core.PersistedState) tea.Cmd {
+	expanded := make(map[string]bool, len(state.ExpandedNodes))
+	for _, id := range state.ExpandedNodes {
+		expanded[id] = true
+	}
+	tl.selectedNodes = make(map[string]bool, len(state.SelectedIDs))
+	for _, id := range state.SelectedIDs {
+		tl.selectedNodes[id] = true
+	}
+
+	cmd := tl.SetExpansionState(expanded)
+
+	index := tl.findItemIndexInFlattenedView(state.CursorID)
+	if index < 0 {
+		index = state.CursorIndex
+	}
+	tl.viewport = viewport.CalculateJumpTo(index, tl.config.ViewportConfig, tl.totalItems)
+	return cmd
+}
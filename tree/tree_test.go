@@ -0,0 +1,677 @@
+package tree
+
+import (
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/x/ansi"
+	"github.com/muesli/termenv"
+
+	"github.com/davidroman0O/vtable/config"
+	"github.com/davidroman0O/vtable/core"
+	"github.com/davidroman0O/vtable/testutil"
+)
+
+// testTreeSource serves a fixed tree of strings. Nodes listed in lazy are
+// returned with Lazy set and their children load through LoadChildren.
+type testTreeSource struct {
+	roots []TreeData[string]
+	lazy  map[string][]TreeData[string]
+}
+
+func (s *testTreeSource) GetRootNodes() []TreeData[string] { return s.roots }
+
+func (s *testTreeSource) GetItemByID(id string) (TreeData[string], bool) {
+	return findTestNode(s.roots, id)
+}
+
+func (s *testTreeSource) SetSelected(id string, selected bool) tea.Cmd     { return nil }
+func (s *testTreeSource) SetSelectedByID(id string, selected bool) tea.Cmd { return nil }
+func (s *testTreeSource) SelectAll() tea.Cmd                               { return nil }
+func (s *testTreeSource) ClearSelection() tea.Cmd                          { return nil }
+func (s *testTreeSource) SelectRange(startID, endID string) tea.Cmd        { return nil }
+
+func (s *testTreeSource) LoadChildren(nodeID string) tea.Cmd {
+	return ChildrenLoadedCmd(nodeID, s.lazy[nodeID], nil)
+}
+
+func findTestNode(nodes []TreeData[string], id string) (TreeData[string], bool) {
+	for _, node := range nodes {
+		if node.ID == id {
+			return node, true
+		}
+		if found, ok := findTestNode(node.Children, id); ok {
+			return found, true
+		}
+	}
+	return TreeData[string]{}, false
+}
+
+// node builds a tree node whose item is its ID
+func node(id string, children ...TreeData[string]) TreeData[string] {
+	return TreeData[string]{ID: id, Item: id, Children: children}
+}
+
+// testTreeConfig returns the default tree configuration showing each node's
+// item as its content
+func testTreeConfig() TreeConfig {
+	treeConfig := DefaultTreeConfig()
+	treeConfig.RenderConfig.ContentConfig.Formatter = func(item core.Data[any], index int, depth int, hasChildren, isExpanded bool, ctx core.RenderContext, isCursor, isTopThreshold, isBottomThreshold bool) string {
+		if flatItem, ok := item.Item.(FlatTreeItem[string]); ok {
+			return flatItem.Item
+		}
+		return ""
+	}
+	return treeConfig
+}
+
+// createTestTree returns an initialized tree over roots, 10 lines high
+func createTestTree(source *testTreeSource, treeConfig TreeConfig) *TreeList[string] {
+	listConfig := config.DefaultListConfig()
+	listConfig.ViewportConfig.Height = 10
+	listConfig.ViewportConfig.ChunkSize = 20
+	tl := NewTreeList(listConfig, treeConfig, source)
+	testutil.Drive(tl, tl.Init())
+	return tl
+}
+
+// send updates the tree with a command's messages and the commands they lead to
+func send(tl *TreeList[string], cmd tea.Cmd) {
+	testutil.Drive(tl, cmd)
+}
+
+// viewLines returns the lines of the view without styling or trailing spaces
+func viewLines(tl *TreeList[string]) []string {
+	lines := strings.Split(core.StripANSI(tl.View()), "\n")
+	for i, line := range lines {
+		lines[i] = strings.TrimRight(line, " ")
+	}
+	return lines
+}
+
+// connectorTree returns a tree whose branches end at different depths:
+//
+//	a
+//	├─ b
+//	│  ├─ d
+//	│  └─ e
+//	│     └─ g
+//	└─ c
+//	   └─ f
+//	z
+func connectorTree() *testTreeSource {
+	return &testTreeSource{roots: []TreeData[string]{
+		node("a", node("b", node("d"), node("e", node("g"))), node("c", node("f"))),
+		node("z"),
+	}}
+}
+
+func TestTreeList_ExpansionStateRoundTrip(t *testing.T) {
+	tl := createTestTree(connectorTree(), testTreeConfig())
+	send(tl, tl.ExpandNode("a"))
+	send(tl, tl.ExpandNode("b"))
+	send(tl, tl.JumpToNode("d"))
+	want := viewLines(tl)
+
+	// The state survives serialization and restores the same view in a new tree
+	encoded, err := json.Marshal(tl.GetExpansionState())
+	if err != nil {
+		t.Fatal(err)
+	}
+	var state map[string]bool
+	if err := json.Unmarshal(encoded, &state); err != nil {
+		t.Fatal(err)
+	}
+	if len(state) != 2 || !state["a"] || !state["b"] {
+		t.Errorf("Expected a and b expanded, got %v", state)
+	}
+
+	restored := createTestTree(connectorTree(), testTreeConfig())
+	send(restored, restored.SetExpansionState(state))
+	send(restored, restored.JumpToNode("d"))
+	if got := viewLines(restored); strings.Join(got, "\n") != strings.Join(want, "\n") {
+		t.Errorf("Expected the restored tree as it was left:\n%s\ngot:\n%s", strings.Join(want, "\n"), strings.Join(got, "\n"))
+	}
+	if id := restored.GetCurrentNodeID(); id != "d" {
+		t.Errorf("Expected the cursor back on d, got %q", id)
+	}
+}
+
+func TestTreeList_ExpansionStateSurvivesReload(t *testing.T) {
+	source := &testTreeSource{roots: []TreeData[string]{node("a", node("b"))}}
+	tl := createTestTree(source, testTreeConfig())
+
+	// A node missing from the data expands once a reload brings it
+	send(tl, tl.SetExpansionState(map[string]bool{"a": true, "n": true}))
+	source.roots = append(source.roots, node("n", node("m")))
+	send(tl, core.DataRefreshCmd())
+
+	want := []string{"► ▼ a", "    • b", "  ▼ n", "    • m"}
+	if got := viewLines(tl); strings.Join(got[:len(want)], "\n") != strings.Join(want, "\n") {
+		t.Errorf("Expected both nodes expanded after the reload, got:\n%s", strings.Join(got, "\n"))
+	}
+	if state := tl.GetExpansionState(); !state["a"] || !state["n"] {
+		t.Errorf("Expected the expansion state kept, got %v", state)
+	}
+}
+
+func TestTreeList_ChildCountWhenCollapsed(t *testing.T) {
+	counted := func(mode TreeChildCountMode) TreeConfig {
+		treeConfig := testTreeConfig()
+		treeConfig.RenderConfig.ShowChildCountWhenCollapsed = true
+		treeConfig.RenderConfig.ChildCountMode = mode
+		return treeConfig
+	}
+
+	tests := []struct {
+		name     string
+		config   TreeConfig
+		expand   []string
+		expected []string
+	}{
+		{
+			name:     "direct children",
+			config:   counted(TreeChildCountDirect),
+			expected: []string{"► ▶ a (2)", "  • z"},
+		},
+		{
+			name:     "all descendants",
+			config:   counted(TreeChildCountTotal),
+			expected: []string{"► ▶ a (6)", "  • z"},
+		},
+		{
+			name:     "expanded parents show no count",
+			config:   counted(TreeChildCountTotal),
+			expand:   []string{"a"},
+			expected: []string{"► ▼ a", "    ▶ b (3)", "    ▶ c (1)", "  • z"},
+		},
+		{
+			name:     "disabled",
+			config:   testTreeConfig(),
+			expected: []string{"► ▶ a", "  • z"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tl := createTestTree(connectorTree(), tt.config)
+			for _, id := range tt.expand {
+				send(tl, tl.ExpandNode(id))
+			}
+			if got := viewLines(tl)[:len(tt.expected)]; strings.Join(got, "\n") != strings.Join(tt.expected, "\n") {
+				t.Errorf("Expected:\n%s\ngot:\n%s", strings.Join(tt.expected, "\n"), strings.Join(got, "\n"))
+			}
+		})
+	}
+}
+
+func TestTreeList_ChildCountFormatter(t *testing.T) {
+	treeConfig := testTreeConfig()
+	treeConfig.RenderConfig.ShowChildCountWhenCollapsed = true
+	treeConfig.RenderConfig.ChildCountFormatter = func(count int, known bool) string {
+		if !known {
+			return " [?]"
+		}
+		return fmt.Sprintf(" [%d]", count)
+	}
+	tl := createTestTree(connectorTree(), treeConfig)
+
+	if got := viewLines(tl)[0]; got != "► ▶ a [2]" {
+		t.Errorf("Expected the count from the formatter, got %q", got)
+	}
+}
+
+func TestTreeList_SetExpansionStateLoadsLazyChildren(t *testing.T) {
+	source := &testTreeSource{
+		roots: []TreeData[string]{{ID: "a", Item: "a", Lazy: true}, node("z")},
+		lazy:  map[string][]TreeData[string]{"a": {node("b"), node("c")}},
+	}
+	tl := createTestTree(source, testTreeConfig())
+
+	// Restoring an expanded lazy node shows its loading row, then loads it
+	cmd := tl.SetExpansionState(map[string]bool{"a": true})
+	if len(tl.flattenedView) != 3 || !tl.flattenedView[1].Placeholder {
+		t.Errorf("Expected a loading row under the restored node, got %+v", tl.flattenedView)
+	}
+	send(tl, cmd)
+
+	want := []string{"► ▼ a", "    • b", "    • c", "  • z"}
+	if got := viewLines(tl); strings.Join(got, "\n") != strings.Join(want, "\n") {
+		t.Errorf("Expected the loaded children under the restored node, got:\n%s", strings.Join(got, "\n"))
+	}
+}
+
+func TestTreeList_SetExpansionStateLoadsNestedLazyChildren(t *testing.T) {
+	source := &testTreeSource{
+		roots: []TreeData[string]{{ID: "a", Item: "a", Lazy: true}, node("z")},
+		lazy: map[string][]TreeData[string]{
+			"a": {{ID: "b", Item: "b", Lazy: true}, node("c")},
+			"b": {node("d")},
+		},
+	}
+	tl := createTestTree(source, testTreeConfig())
+
+	// The restored lazy child loads once its parent's children arrive
+	send(tl, tl.SetExpansionState(map[string]bool{"a": true, "b": true}))
+
+	want := []string{"► ▼ a", "    ▼ b", "      • d", "    • c", "  • z"}
+	if got := viewLines(tl); strings.Join(got, "\n") != strings.Join(want, "\n") {
+		t.Errorf("Expected both lazy levels loaded, got:\n%s", strings.Join(got, "\n"))
+	}
+	if len(tl.loadingChildren) != 0 {
+		t.Errorf("Expected no load left pending, got %v", tl.loadingChildren)
+	}
+}
+
+func TestTreeList_JumpToNodeInsideRestoredLazyNodes(t *testing.T) {
+	source := &testTreeSource{
+		roots: []TreeData[string]{{ID: "a", Item: "a", Lazy: true}, node("z")},
+		lazy: map[string][]TreeData[string]{
+			"a": {{ID: "b", Item: "b", Lazy: true}, node("c")},
+			"b": {node("d")},
+		},
+	}
+	tl := createTestTree(source, testTreeConfig())
+
+	// The cursor waits for the lazy levels hiding its node to load
+	cmd := tl.SetExpansionState(map[string]bool{"a": true, "b": true})
+	if jump := tl.JumpToNode("d"); jump != nil {
+		t.Error("Expected no jump before the node is loaded")
+	}
+	send(tl, cmd)
+
+	if got := tl.GetCurrentNodeID(); got != "d" {
+		t.Errorf("Expected the cursor on the restored node, got %q", got)
+	}
+	if tl.pendingCursor != "" {
+		t.Errorf("Expected the pending cursor cleared, got %q", tl.pendingCursor)
+	}
+}
+
+func TestTreeList_ChildCountOfLazyNodes(t *testing.T) {
+	treeConfig := testTreeConfig()
+	treeConfig.RenderConfig.ShowChildCountWhenCollapsed = true
+	treeConfig.RenderConfig.ChildCountFormatter = func(count int, known bool) string {
+		if !known {
+			return " [?]"
+		}
+		return fmt.Sprintf(" [%d]", count)
+	}
+	source := &testTreeSource{
+		roots: []TreeData[string]{{ID: "a", Item: "a", Lazy: true}},
+		lazy:  map[string][]TreeData[string]{"a": {node("b"), node("c")}},
+	}
+	tl := createTestTree(source, treeConfig)
+
+	// Unloaded children are unknown until the node is expanded once
+	if got := viewLines(tl)[0]; got != "► ▶ a [?]" {
+		t.Errorf("Expected an unknown count before loading, got %q", got)
+	}
+	send(tl, tl.ExpandNode("a"))
+	send(tl, tl.CollapseNode("a"))
+	if got := viewLines(tl)[0]; got != "► ▶ a [2]" {
+		t.Errorf("Expected the loaded count after collapsing, got %q", got)
+	}
+}
+
+// runTreeCmd runs a command, unwrapping batches, and returns its messages
+func runTreeCmd(cmd tea.Cmd) []tea.Msg {
+	if cmd == nil {
+		return nil
+	}
+	msg := cmd()
+	if batch, ok := msg.(tea.BatchMsg); ok {
+		var msgs []tea.Msg
+		for _, c := range batch {
+			msgs = append(msgs, runTreeCmd(c)...)
+		}
+		return msgs
+	}
+	return []tea.Msg{msg}
+}
+
+// structureChange updates the tree with a command's messages and the commands
+// they lead to, and returns the last visible node count reported, or -1
+func structureChange(tl *TreeList[string], cmd tea.Cmd) int {
+	total := -1
+	for round := 0; round < 20 && cmd != nil; round++ {
+		var next []tea.Cmd
+		for _, msg := range runTreeCmd(cmd) {
+			if changed, ok := msg.(core.TreeStructureChangedMsg); ok {
+				total = changed.TotalVisible
+			}
+			_, follow := tl.Update(msg)
+			next = append(next, follow)
+		}
+		cmd = tea.Batch(next...)
+	}
+	return total
+}
+
+func TestTreeList_ExpandToDepth(t *testing.T) {
+	tests := []struct {
+		depth    int
+		expected []string
+	}{
+		{0, []string{"a", "z"}},
+		{1, []string{"a", "b", "c", "z"}},
+		{2, []string{"a", "b", "d", "e", "c", "f", "z"}},
+		{5, []string{"a", "b", "d", "e", "g", "c", "f", "z"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(fmt.Sprint(tt.depth), func(t *testing.T) {
+			tl := createTestTree(connectorTree(), testTreeConfig())
+			send(tl, tl.ExpandAll())
+
+			// Deeper nodes collapse, shallower ones expand
+			if total := structureChange(tl, core.TreeExpandToDepthCmd(tt.depth)); total != len(tt.expected) {
+				t.Errorf("Expected %d visible nodes reported, got %d", len(tt.expected), total)
+			}
+			var ids []string
+			for _, item := range tl.flattenedView {
+				ids = append(ids, item.ID)
+			}
+			if !slices.Equal(ids, tt.expected) {
+				t.Errorf("Expected %v visible, got %v", tt.expected, ids)
+			}
+		})
+	}
+}
+
+func TestTreeList_BulkExpansionKeepsCursor(t *testing.T) {
+	tl := createTestTree(connectorTree(), testTreeConfig())
+
+	// Expanding everything keeps the cursor on its node
+	send(tl, tl.JumpToNode("z"))
+	if total := structureChange(tl, core.TreeExpandAllCmd()); total != 8 {
+		t.Errorf("Expected 8 visible nodes reported, got %d", total)
+	}
+	if id := tl.GetCurrentNodeID(); id != "z" {
+		t.Errorf("Expected the cursor to stay on z, got %q", id)
+	}
+
+	// A node hidden by a shallower expansion hands the cursor to its closest
+	// visible ancestor
+	send(tl, tl.JumpToNode("g"))
+	send(tl, core.TreeExpandToDepthCmd(1))
+	if id := tl.GetCurrentNodeID(); id != "b" {
+		t.Errorf("Expected the cursor on b, got %q", id)
+	}
+	send(tl, core.TreeCollapseAllCmd())
+	if id := tl.GetCurrentNodeID(); id != "a" {
+		t.Errorf("Expected the cursor on a, got %q", id)
+	}
+}
+
+// projectTree returns 10 projects of 10 modules of 10 tasks, 1110 nodes with
+// IDs such as "p3-m4-t7"
+func projectTree() *testTreeSource {
+	source := &testTreeSource{}
+	for p := 0; p < 10; p++ {
+		project := node(fmt.Sprintf("p%d", p))
+		for m := 0; m < 10; m++ {
+			module := node(fmt.Sprintf("p%d-m%d", p, m))
+			for k := 0; k < 10; k++ {
+				module.Children = append(module.Children, node(fmt.Sprintf("p%d-m%d-t%d", p, m, k)))
+			}
+			project.Children = append(project.Children, module)
+		}
+		source.roots = append(source.roots, project)
+	}
+	return source
+}
+
+func TestTreeList_ExpandAllLargeTree(t *testing.T) {
+	tl := createTestTree(projectTree(), testTreeConfig())
+
+	// The viewport and chunks are rebuilt for the expanded tree
+	if total := structureChange(tl, tl.ExpandAll()); total != 1110 {
+		t.Errorf("Expected 1110 visible nodes reported, got %d", total)
+	}
+	send(tl, core.JumpToEndCmd())
+	lines := viewLines(tl)
+	if id := tl.GetCurrentNodeID(); id != "p9-m9-t9" || !slices.Contains(lines, "►     • p9-m9-t9") {
+		t.Errorf("Expected the cursor on the last task, got %q:\n%s", id, strings.Join(lines, "\n"))
+	}
+}
+
+func TestTreeList_SearchRevealsMatches(t *testing.T) {
+	tl := createTestTree(projectTree(), testTreeConfig())
+
+	// Collapsed nodes are searched, ignoring case, in tree order
+	matches := tl.Search("P3-M4-T")
+	if len(matches) != 10 || matches[0] != "p3-m4-t0" || matches[9] != "p3-m4-t9" {
+		t.Fatalf("Expected the ten tasks of p3-m4, got %v", matches)
+	}
+
+	// Stepping reveals the match, expanding only its ancestors
+	send(tl, tl.SearchNext())
+	if id := tl.GetCurrentNodeID(); id != "p3-m4-t0" {
+		t.Errorf("Expected the cursor on the first match, got %q", id)
+	}
+	if state := tl.GetExpansionState(); len(state) != 2 || !state["p3"] || !state["p3-m4"] {
+		t.Errorf("Expected only the ancestors of the match expanded, got %v", state)
+	}
+	if lines := viewLines(tl); !slices.Contains(lines, "►     • p3-m4-t0") {
+		t.Errorf("Expected the match in view, got:\n%s", strings.Join(lines, "\n"))
+	}
+
+	// Stepping wraps around both ways
+	send(tl, tl.SearchPrev())
+	if id := tl.GetCurrentNodeID(); id != "p3-m4-t9" {
+		t.Errorf("Expected the cursor to wrap to the last match, got %q", id)
+	}
+	send(tl, tl.SearchNext())
+	if id := tl.GetCurrentNodeID(); id != "p3-m4-t0" {
+		t.Errorf("Expected the cursor to wrap to the first match, got %q", id)
+	}
+
+	if matches := tl.Search("missing"); len(matches) != 0 || tl.SearchNext() != nil {
+		t.Errorf("Expected no match to step through, got %v", matches)
+	}
+}
+
+func TestTreeList_SearchHighlightsMatches(t *testing.T) {
+	previousProfile := lipgloss.ColorProfile()
+	lipgloss.SetColorProfile(termenv.TrueColor)
+	defer lipgloss.SetColorProfile(previousProfile)
+
+	tl := createTestTree(projectTree(), testTreeConfig())
+	tl.Search("m4-t7")
+	send(tl, tl.SearchNext())
+
+	highlighted := tl.treeConfig.SearchMatchStyle.Render("m4-t7")
+	if view := tl.View(); !strings.Contains(view, highlighted) {
+		t.Errorf("Expected the matched text highlighted, got %q", view)
+	}
+	tl.ClearSearch()
+	if view := tl.View(); strings.Contains(view, highlighted) {
+		t.Errorf("Expected no highlight once the search is cleared, got %q", view)
+	}
+}
+
+// pathTreeSource is a lazy testTreeSource that knows the ancestors of nodes
+// not loaded yet
+type pathTreeSource struct {
+	*testTreeSource
+	paths map[string][]string
+}
+
+func (s *pathTreeSource) GetNodePath(id string) ([]string, bool) {
+	path, ok := s.paths[id]
+	return path, ok
+}
+
+func TestTreeList_RevealLoadsLazyAncestors(t *testing.T) {
+	source := &pathTreeSource{
+		testTreeSource: &testTreeSource{
+			roots: []TreeData[string]{{ID: "p", Item: "p", Lazy: true}, node("q")},
+			lazy: map[string][]TreeData[string]{
+				"p":   {{ID: "p-m", Item: "p-m", Lazy: true}},
+				"p-m": {node("p-m-t0"), node("p-m-t1")},
+			},
+		},
+		paths: map[string][]string{"p-m-t1": {"p", "p-m"}},
+	}
+	listConfig := config.DefaultListConfig()
+	listConfig.ViewportConfig.Height = 10
+	tl := NewTreeList(listConfig, testTreeConfig(), TreeDataSource[string](source))
+	send(tl, tl.Init())
+
+	// Each lazy ancestor loads before the next, then the cursor lands on the node
+	send(tl, tl.RevealNode("p-m-t1"))
+	if id := tl.GetCurrentNodeID(); id != "p-m-t1" {
+		t.Errorf("Expected the cursor on the revealed node, got %q", id)
+	}
+	want := []string{"  ▼ p", "    ▼ p-m", "      • p-m-t0", "►     • p-m-t1", "  • q"}
+	if got := viewLines(tl)[:len(want)]; strings.Join(got, "\n") != strings.Join(want, "\n") {
+		t.Errorf("Expected the lazy ancestors loaded and expanded, got:\n%s", strings.Join(got, "\n"))
+	}
+}
+
+// columnTreeConfig returns a tree-table configuration with a status column
+// and a right-aligned priority column
+func columnTreeConfig() TreeConfig {
+	treeConfig := testTreeConfig()
+	treeConfig.Columns = &TreeColumnConfig{
+		LabelWidth: 14,
+		LabelTitle: "Task",
+		Columns: []core.TableColumn{
+			{Title: "Status", Width: 6},
+			{Title: "Prio", Width: 4, Alignment: core.AlignRight},
+		},
+		Cells: func(item core.Data[any]) []string {
+			flatItem := item.Item.(FlatTreeItem[string])
+			return []string{"s-" + flatItem.Item, fmt.Sprint(flatItem.Depth + 1)}
+		},
+		ShowHeader: true,
+	}
+	return treeConfig
+}
+
+func TestTreeList_ColumnsAlignAcrossDepths(t *testing.T) {
+	tl := createTestTree(connectorTree(), columnTreeConfig())
+	send(tl, tl.ExpandAll())
+
+	// Whatever the depth of the node, its cells start and end at the same
+	// columns
+	lines := viewLines(tl)[:9]
+	for _, line := range lines[1:] {
+		prefix, _, _ := strings.Cut(line, "s-")
+		if ansi.StringWidth(prefix) != 15 || ansi.StringWidth(line) != 26 {
+			t.Errorf("Expected the cells aligned under the header, got:\n%s", strings.Join(lines, "\n"))
+			break
+		}
+	}
+	if lines[0] != "Task           Status Prio" || lines[5] != "        • g    s-g       4" {
+		t.Errorf("Expected the header and the deepest node aligned, got:\n%s", strings.Join(lines, "\n"))
+	}
+}
+
+func TestTreeList_ColumnHighlightKeepsStyling(t *testing.T) {
+	previousProfile := lipgloss.ColorProfile()
+	lipgloss.SetColorProfile(termenv.TrueColor)
+	defer lipgloss.SetColorProfile(previousProfile)
+
+	red := lipgloss.NewStyle().Foreground(lipgloss.Color("#ff0000"))
+	redSequence, _, _ := strings.Cut(red.Render("x"), "x")
+	treeConfig := columnTreeConfig()
+	treeConfig.RenderConfig.ContentConfig.Formatter = func(item core.Data[any], index int, depth int, hasChildren, isExpanded bool, ctx core.RenderContext, isCursor, isTopThreshold, isBottomThreshold bool) string {
+		return red.Render(item.Item.(FlatTreeItem[string]).Item)
+	}
+	treeConfig.Columns.CursorStyle = lipgloss.NewStyle().Background(lipgloss.Color("#0000ff"))
+	treeConfig.Columns.SelectedStyle = lipgloss.NewStyle().Background(lipgloss.Color("#00ff00"))
+	cursorSequence, _, _ := strings.Cut(treeConfig.Columns.CursorStyle.Render("x"), "x")
+	selectedSequence, _, _ := strings.Cut(treeConfig.Columns.SelectedStyle.Render("x"), "x")
+
+	// a is selected, and z under the cursor
+	tl := createTestTree(connectorTree(), treeConfig)
+	send(tl, core.SelectCurrentCmd())
+	send(tl, core.CursorDownCmd())
+	lines := strings.Split(tl.View(), "\n")
+	if len(lines) < 3 {
+		t.Fatalf("Expected the header and two nodes, got %q", lines)
+	}
+
+	// The highlight spans the line and resumes after the red content
+	highlights := []struct {
+		line     string
+		sequence string
+		content  string
+	}{
+		{lines[1], selectedSequence, "a"},
+		{lines[2], cursorSequence, "z"},
+	}
+	for _, h := range highlights {
+		if !strings.HasPrefix(h.line, h.sequence) || !strings.Contains(h.line, redSequence+h.content+"\x1b[0m"+h.sequence) {
+			t.Errorf("Expected %q highlighted around its red content, got %q", h.content, h.line)
+		}
+		if !strings.HasSuffix(core.StripANSI(h.line), "s-"+h.content+"       1") {
+			t.Errorf("Expected the cells of %q highlighted, got %q", h.content, core.StripANSI(h.line))
+		}
+	}
+}
+
+func TestTreeList_FlattenAncestorLines(t *testing.T) {
+	tl := createTestTree(connectorTree(), testTreeConfig())
+	send(tl, tl.ExpandAll())
+
+	want := map[string]struct {
+		last  bool
+		lines []bool
+	}{
+		"a": {false, nil},
+		"b": {false, []bool{true}},
+		"d": {false, []bool{true, true}},
+		"e": {true, []bool{true, true}},
+		"g": {true, []bool{true, true, false}},
+		"c": {true, []bool{true}},
+		"f": {true, []bool{true, false}},
+		"z": {true, nil},
+	}
+	if len(tl.flattenedView) != len(want) {
+		t.Fatalf("Expected %d flattened items, got %d", len(want), len(tl.flattenedView))
+	}
+	for _, item := range tl.flattenedView {
+		expected := want[item.ID]
+		if item.IsLastChild() != expected.last || !slices.Equal(item.GetAncestorLines(), expected.lines) {
+			t.Errorf("Expected %s to be last child %v with ancestor lines %v, got %v and %v",
+				item.ID, expected.last, expected.lines, item.IsLastChild(), item.GetAncestorLines())
+		}
+	}
+}
+
+func TestTreeList_ConnectorsContinueAncestorLines(t *testing.T) {
+	treeConfig := testTreeConfig()
+	treeConfig.RenderConfig.IndentationConfig.UseConnectors = true
+	treeConfig.RenderConfig.IndentationConfig.ConnectorChars = ASCIITreeConnectorChars()
+	tl := createTestTree(connectorTree(), treeConfig)
+	send(tl, tl.ExpandAll())
+
+	// A line carries on only below ancestors with siblings further down
+	want := []string{
+		"► ▼ a",
+		"  |- ▼ b",
+		"  |  |- • d",
+		"  |  `- ▼ e",
+		"  |     `- • g",
+		"  `- ▼ c",
+		"     `- • f",
+		"  • z",
+	}
+	if got := viewLines(tl); strings.Join(got, "\n") != strings.Join(want, "\n") {
+		t.Errorf("Expected the ancestor lines at their depths, got:\n%s", strings.Join(got, "\n"))
+	}
+
+	// A collapsed parent keeps its branch, its symbol shows it is collapsed
+	send(tl, tl.CollapseNode("c"))
+	if got := viewLines(tl); got[5] != "  `- ▶ c" || got[6] != "  • z" {
+		t.Errorf("Expected the collapsed parent on a plain branch, got:\n%s", strings.Join(got, "\n"))
+	}
+}
@@ -0,0 +1,26 @@
+package viewport
+
+import "github.com/davidroman0O/vtable/core"
+
+// AutoHeight returns the viewport height that fills a terminal of
+// terminalHeight lines, once the chromeLines a component draws around its
+// items (borders, header) and the configured ReservedLines are taken. It
+// never returns less than one item, however small the terminal.
+func AutoHeight(terminalHeight, chromeLines int, viewportConfig core.ViewportConfig) int {
+	height := terminalHeight - chromeLines - viewportConfig.ReservedLines
+	if height < 1 {
+		return 1
+	}
+	return height
+}
+
+// Resize adjusts the viewport state to a new viewport height. The cursor stays
+// on the same item and in view, and the viewport is pulled back so a taller
+// viewport shows as many items as the dataset allows.
+func Resize(viewport core.ViewportState, viewportConfig core.ViewportConfig, totalItems int) core.ViewportState {
+	result := viewport
+	if maxStart := totalItems - viewportConfig.Height; result.ViewportStartIndex > maxStart {
+		result.ViewportStartIndex = max(maxStart, 0)
+	}
+	return ClampCursor(result, viewportConfig, totalItems)
+}
@@ -184,6 +184,9 @@ func CalculatePageUp(viewport core.ViewportState, viewportConfig core.ViewportCo
 	if totalItems <= 0 || viewport.CursorIndex <= 0 {
 		return viewport
 	}
+	if viewportConfig.PagedMode {
+		return calculatePageTurn(viewport, viewportConfig, totalItems, -1)
+	}
 
 	result := viewport
 
@@ -223,6 +226,9 @@ func CalculatePageDown(viewport core.ViewportState, viewportConfig core.Viewport
 	if totalItems <= 0 || viewport.CursorIndex >= totalItems-1 {
 		return viewport
 	}
+	if viewportConfig.PagedMode {
+		return calculatePageTurn(viewport, viewportConfig, totalItems, 1)
+	}
 
 	result := viewport
 
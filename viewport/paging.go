@@ -0,0 +1,55 @@
+package viewport
+
+import (
+	"math"
+
+	"github.com/davidroman0O/vtable/core"
+)
+
+// PageStart returns the index of the first item on the page containing index,
+// for pages of pageSize items.
+func PageStart(index, pageSize int) int {
+	if pageSize <= 0 || index <= 0 {
+		return 0
+	}
+	return index / pageSize * pageSize
+}
+
+// PageInfo returns the 1-based page of the cursor and the number of pages of
+// viewportConfig.Height items. An empty dataset has a single empty page.
+func PageInfo(viewport core.ViewportState, viewportConfig core.ViewportConfig, totalItems int) (page, totalPages int) {
+	height := viewportConfig.Height
+	if height <= 0 || totalItems <= 0 {
+		return 1, 1
+	}
+	return viewport.CursorIndex/height + 1, (totalItems + height - 1) / height
+}
+
+// PercentIndex returns the index at fraction p, from 0.0 to 1.0, of
+// totalItems items: round(p * (totalItems-1)). p is clamped to that range.
+func PercentIndex(p float64, totalItems int) int {
+	if totalItems <= 0 || math.IsNaN(p) {
+		return 0
+	}
+	p = min(max(p, 0), 1)
+	return int(math.Round(p * float64(totalItems-1)))
+}
+
+// calculatePageTurn moves the cursor to the top of the adjacent page in
+// direction, -1 for the previous page and 1 for the next. From the first page
+// the cursor goes to the first item, and from the last page to the last item.
+func calculatePageTurn(viewport core.ViewportState, viewportConfig core.ViewportConfig, totalItems int, direction int) core.ViewportState {
+	height := viewportConfig.Height
+	result := viewport
+
+	target := PageStart(viewport.CursorIndex, height) + direction*height
+	switch {
+	case target < 0:
+		target = 0
+	case target >= totalItems:
+		target = totalItems - 1
+	}
+	result.CursorIndex = target
+
+	return UpdateViewportBounds(result, viewportConfig, totalItems)
+}
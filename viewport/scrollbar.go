@@ -0,0 +1,45 @@
+package viewport
+
+import "github.com/davidroman0O/vtable/core"
+
+// CalculateScrollbarThumb computes the position and length, in lines, of the
+// scrollbar thumb on a track of trackHeight lines. The thumb is proportional to
+// the share of items visible in the viewport and only reaches the end of the
+// track when the last item is visible. When every item fits in the viewport the
+// thumb fills the whole track.
+func CalculateScrollbarThumb(viewport core.ViewportState, viewportConfig core.ViewportConfig, totalItems, trackHeight int) (offset, length int) {
+	if trackHeight <= 0 {
+		return 0, 0
+	}
+
+	height := viewportConfig.Height
+	maxStart := totalItems - height
+	if height <= 0 || maxStart <= 0 {
+		return 0, trackHeight
+	}
+
+	// Round the thumb length to the nearest line, keeping it visible and
+	// leaving room to move
+	length = (trackHeight*height + totalItems/2) / totalItems
+	if length < 1 {
+		length = 1
+	}
+	if length >= trackHeight {
+		length = trackHeight - 1
+	}
+	if length < 1 {
+		return 0, trackHeight
+	}
+
+	start := viewport.ViewportStartIndex
+	if start < 0 {
+		start = 0
+	}
+	if start > maxStart {
+		start = maxStart
+	}
+
+	// Rounding down keeps the thumb off the bottom until start reaches maxStart
+	offset = (trackHeight - length) * start / maxStart
+	return offset, length
+}
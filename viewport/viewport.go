@@ -31,6 +31,22 @@ func UpdateViewportBounds(viewport core.ViewportState, viewportConfig core.Viewp
 	// Create a copy to avoid modifying the input
 	result := viewport
 
+	// Paged viewports start on the page of the cursor and never lock the
+	// cursor at a threshold
+	if viewportConfig.PagedMode && height > 0 {
+		result.ViewportStartIndex = PageStart(result.CursorIndex, height)
+		result.CursorViewportIndex = result.CursorIndex - result.ViewportStartIndex
+		topThreshold, bottomThreshold = -1, -1
+	}
+
+	// ScrollOff scrolls the viewport to keep rows around the cursor
+	if !viewportConfig.PagedMode && height > 0 {
+		if scrollOff := min(viewportConfig.ScrollOff, (height-1)/2); scrollOff > 0 {
+			result.ViewportStartIndex = scrollOffStart(result, height, scrollOff, totalItems)
+			result.CursorViewportIndex = result.CursorIndex - result.ViewportStartIndex
+		}
+	}
+
 	// Update threshold flags using offset semantics
 	// TopThreshold: offset from viewport start (e.g., TopThreshold=2 means position 2)
 	// BottomThreshold: offset from viewport end (e.g., BottomThreshold=2 means position height-2-1)
@@ -56,6 +72,22 @@ func UpdateViewportBounds(viewport core.ViewportState, viewportConfig core.Viewp
 	return result
 }
 
+// scrollOffStart returns the viewport start keeping scrollOff rows above and
+// below the cursor, as close to the current start as possible and without
+// scrolling past either end of the dataset
+func scrollOffStart(viewport core.ViewportState, height, scrollOff, totalItems int) int {
+	start := viewport.ViewportStartIndex
+	switch {
+	case viewport.CursorIndex-start < scrollOff:
+		start = viewport.CursorIndex - scrollOff
+	case viewport.CursorIndex-start > height-1-scrollOff:
+		start = viewport.CursorIndex - (height - 1 - scrollOff)
+	default:
+		return start
+	}
+	return max(0, min(start, totalItems-height))
+}
+
 // CalculateBoundingArea determines the range of data that should be loaded into
 // memory, based on the current viewport position and the configured buffer sizes
 // (`BoundingAreaBefore` and `BoundingAreaAfter`). This "bounding area" is larger
@@ -70,6 +102,11 @@ func CalculateBoundingArea(viewport core.ViewportState, viewportConfig core.View
 	boundingBefore := viewportConfig.BoundingAreaBefore
 	boundingAfter := viewportConfig.BoundingAreaAfter
 
+	// Paged viewports prefetch the whole next page
+	if viewportConfig.PagedMode && boundingAfter < viewportHeight {
+		boundingAfter = viewportHeight
+	}
+
 	// Calculate viewport bounds (item indices)
 	viewportStart := viewport.ViewportStartIndex
 	viewportEnd := viewportStart + viewportHeight - 1
@@ -132,3 +169,29 @@ func UpdateViewportPosition(viewport core.ViewportState, viewportConfig core.Vie
 
 	return result
 }
+
+// ClampCursor keeps the cursor and viewport valid for the given dataset size.
+// An empty dataset resets the state to index 0 with both dataset boundary flags
+// set, and a cursor beyond the end, for example after the dataset shrank or
+// with an out-of-range initial index, is moved to the last item.
+func ClampCursor(viewport core.ViewportState, viewportConfig core.ViewportConfig, totalItems int) core.ViewportState {
+	if totalItems <= 0 {
+		return UpdateViewportBounds(core.ViewportState{}, viewportConfig, 0)
+	}
+
+	result := viewport
+	if result.CursorIndex < 0 {
+		result.CursorIndex = 0
+	}
+	if result.CursorIndex >= totalItems {
+		result.CursorIndex = totalItems - 1
+	}
+	if result.ViewportStartIndex < 0 {
+		result.ViewportStartIndex = 0
+	}
+	if result.ViewportStartIndex > result.CursorIndex {
+		result.ViewportStartIndex = result.CursorIndex
+	}
+
+	return UpdateViewportPosition(result, viewportConfig, totalItems)
+}
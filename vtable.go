@@ -0,0 +1,464 @@
+// Package vtable is the single import path for building virtualized lists,
+// tables and trees with Bubble Tea. It re-exports the components, the data
+// contracts, the configuration defaults and the commands that applications use
+// day to day, so that most programs never need to import the core, config,
+// list, table or tree packages directly.
+//
+// Everything declared here is an alias of the underlying declaration: types are
+// Go type aliases and commands are the same functions, so values can be mixed
+// freely with code that imports the deeper packages. The names in this file are
+// the stable surface of the library; the deeper packages may grow or change
+// between releases.
+package vtable
+
+import (
+	"github.com/davidroman0O/vtable/config"
+	"github.com/davidroman0O/vtable/core"
+	"github.com/davidroman0O/vtable/list"
+	"github.com/davidroman0O/vtable/table"
+	"github.com/davidroman0O/vtable/tree"
+)
+
+// ===== Components =====
+
+// List is a virtualized, single-column list component.
+type List = list.List
+
+// Table is a virtualized, multi-column table component.
+type Table = table.Table
+
+// TreeList is a virtualized component for hierarchical data.
+type TreeList[T any] = tree.TreeList[T]
+
+// Controller drives a Table synchronously, for tests and scripted interaction.
+type Controller = table.Controller
+
+// NewList creates a List backed by the given data source.
+func NewList(listConfig ListConfig, dataSource DataSource[any]) *List {
+	return list.NewList(listConfig, dataSource)
+}
+
+// NewTable creates a Table backed by the given data source.
+func NewTable(tableConfig TableConfig, dataSource DataSource[any]) *Table {
+	return table.NewTable(tableConfig, dataSource)
+}
+
+// NewTreeList creates a TreeList backed by the given tree data source.
+func NewTreeList[T any](listConfig ListConfig, treeConfig TreeConfig, dataSource TreeDataSource[T]) *TreeList[T] {
+	return tree.NewTreeList(listConfig, treeConfig, dataSource)
+}
+
+// NewController creates a Controller for the table and runs its Init command.
+func NewController(t *Table) *Controller {
+	return table.NewController(t)
+}
+
+// ===== Data contracts =====
+
+// DataSource provides items to the components in chunks.
+type DataSource[T any] = core.DataSource[T]
+
+// Data wraps an item with its ID and its selection, loading and error state.
+type Data[T any] = core.Data[T]
+
+// DataRequest describes a chunk request, including sorting and filtering.
+type DataRequest = core.DataRequest
+
+// PersistedState is a JSON-friendly snapshot of a component's view state.
+type PersistedState = core.PersistedState
+
+// TableRow is a single row of table cells.
+type TableRow = core.TableRow
+
+// TableColumn describes a table column.
+type TableColumn = core.TableColumn
+
+// TreeData is a node of hierarchical data.
+type TreeData[T any] = tree.TreeData[T]
+
+// TreeDataSource provides hierarchical data to a TreeList.
+type TreeDataSource[T any] = tree.TreeDataSource[T]
+
+// LazyTreeDataSource is a TreeDataSource that loads children on first expansion.
+type LazyTreeDataSource[T any] = tree.LazyTreeDataSource[T]
+
+// FlatTreeItem is a tree node as seen by formatters of a TreeList.
+type FlatTreeItem[T any] = tree.FlatTreeItem[T]
+
+// ===== Configuration =====
+
+// ListConfig configures a List.
+type ListConfig = core.ListConfig
+
+// TableConfig configures a Table.
+type TableConfig = core.TableConfig
+
+// TreeConfig configures the tree-specific behavior of a TreeList.
+type TreeConfig = tree.TreeConfig
+
+// ViewportConfig configures the visible window and chunk loading.
+type ViewportConfig = core.ViewportConfig
+
+// ViewportState is the cursor and viewport position of a component.
+type ViewportState = core.ViewportState
+
+// DebugSnapshot is a dump of a component's viewport math and loaded chunks.
+type DebugSnapshot = core.DebugSnapshot
+
+// SelectionMode selects single, multiple or no selection.
+type SelectionMode = core.SelectionMode
+
+// SelectionConfig controls how the cursor and the selection interact.
+type SelectionConfig = core.SelectionConfig
+
+// NavigationKeyMap defines the keybindings of a component.
+type NavigationKeyMap = core.NavigationKeyMap
+
+// Theme defines the styles of a Table.
+type Theme = core.Theme
+
+// ThemeRegistry holds Table themes by name.
+type ThemeRegistry = core.ThemeRegistry
+
+// StyleConfig defines the styles of a List.
+type StyleConfig = core.StyleConfig
+
+// ListRenderConfig configures the component-based rendering of a List.
+type ListRenderConfig = core.ListRenderConfig
+
+// TreeRenderConfig configures the component-based rendering of a TreeList.
+type TreeRenderConfig = tree.TreeRenderConfig
+
+// RenderContext carries rendering helpers and settings to formatters.
+type RenderContext = core.RenderContext
+
+// ItemFormatter renders a list item.
+type ItemFormatter[T any] = core.ItemFormatter[T]
+
+// CellFormatter renders a table cell.
+type CellFormatter = core.CellFormatter
+
+// HeaderFormatter renders the whole table header.
+type HeaderFormatter = core.HeaderFormatter
+
+// HeaderCellFormatter renders a single table header cell.
+type HeaderCellFormatter = core.HeaderCellFormatter
+
+// Selection modes.
+const (
+	SelectionSingle   = core.SelectionSingle
+	SelectionMultiple = core.SelectionMultiple
+	SelectionNone     = core.SelectionNone
+)
+
+// Cell alignments.
+const (
+	AlignLeft   = core.AlignLeft
+	AlignCenter = core.AlignCenter
+	AlignRight  = core.AlignRight
+)
+
+// ColorProfile is the range of colors a terminal can display.
+type ColorProfile = core.ColorProfile
+
+// Color profiles.
+const (
+	ColorProfileAuto      = core.ColorProfileAuto
+	ColorProfileTrueColor = core.ColorProfileTrueColor
+	ColorProfileANSI256   = core.ColorProfileANSI256
+	ColorProfileANSI      = core.ColorProfileANSI
+	ColorProfileMono      = core.ColorProfileMono
+)
+
+// CopyFormat selects how copied rows are written to the clipboard.
+type CopyFormat = core.CopyFormat
+
+// Copy formats.
+const (
+	CopyTSV  = core.CopyTSV
+	CopyCSV  = core.CopyCSV
+	CopyJSON = core.CopyJSON
+)
+
+// BorderStyle names a set of table border characters.
+type BorderStyle = core.BorderStyle
+
+// Border styles.
+const (
+	BorderNormal  = core.BorderNormal
+	BorderRounded = core.BorderRounded
+	BorderDouble  = core.BorderDouble
+	BorderThick   = core.BorderThick
+	BorderASCII   = core.BorderASCII
+	BorderNone    = core.BorderNone
+)
+
+// Default configurations.
+var (
+	// DefaultListConfig returns the default List configuration.
+	DefaultListConfig = config.DefaultListConfig
+	// DefaultListRenderConfig returns the default List rendering pipeline.
+	DefaultListRenderConfig = config.DefaultListRenderConfig
+	// DefaultTableConfig returns the default Table configuration.
+	DefaultTableConfig = config.DefaultTableConfig
+	// DefaultViewportConfig returns the default viewport configuration.
+	DefaultViewportConfig = config.DefaultViewportConfig
+	// DefaultStyleConfig returns the default List styles.
+	DefaultStyleConfig = config.DefaultStyleConfig
+	// DefaultTheme returns the default Table theme.
+	DefaultTheme = config.DefaultTheme
+	// DefaultTreeConfig returns the default TreeList configuration.
+	DefaultTreeConfig = tree.DefaultTreeConfig
+	// DefaultTreeRenderConfig returns the default TreeList rendering pipeline.
+	DefaultTreeRenderConfig = tree.DefaultTreeRenderConfig
+)
+
+// ===== Commands =====
+
+// Navigation commands.
+var (
+	// CursorUpCmd moves the cursor up one item.
+	CursorUpCmd = core.CursorUpCmd
+	// CursorDownCmd moves the cursor down one item.
+	CursorDownCmd = core.CursorDownCmd
+	// CursorLeftCmd moves the active table column left.
+	CursorLeftCmd = core.CursorLeftCmd
+	// CursorRightCmd moves the active table column right.
+	CursorRightCmd = core.CursorRightCmd
+	// PageUpCmd moves the cursor up one page.
+	PageUpCmd = core.PageUpCmd
+	// PageDownCmd moves the cursor down one page.
+	PageDownCmd = core.PageDownCmd
+	// JumpToStartCmd moves the cursor to the first item.
+	JumpToStartCmd = core.JumpToStartCmd
+	// JumpToEndCmd moves the cursor to the last item.
+	JumpToEndCmd = core.JumpToEndCmd
+	// JumpToCmd moves the cursor to an absolute index.
+	JumpToCmd = core.JumpToCmd
+	// JumpToPercentCmd moves the cursor to a fraction of the dataset.
+	JumpToPercentCmd = core.JumpToPercentCmd
+	// SetMarkCmd marks the row under the cursor with a name.
+	SetMarkCmd = core.SetMarkCmd
+	// JumpToMarkCmd moves the cursor to a marked row.
+	JumpToMarkCmd = core.JumpToMarkCmd
+	// TreeJumpToIndexCmd moves a tree's cursor to an index, optionally
+	// expanding the parents of the target node.
+	TreeJumpToIndexCmd = core.TreeJumpToIndexCmd
+	// TreeExpandAllCmd expands every node of a tree.
+	TreeExpandAllCmd = core.TreeExpandAllCmd
+	// TreeCollapseAllCmd collapses every node of a tree.
+	TreeCollapseAllCmd = core.TreeCollapseAllCmd
+	// TreeExpandToDepthCmd shows the nodes of a tree down to a depth.
+	TreeExpandToDepthCmd = core.TreeExpandToDepthCmd
+	// NextColumnCmd moves the active table column forward.
+	NextColumnCmd = core.NextColumnCmd
+	// PrevColumnCmd moves the active table column backward.
+	PrevColumnCmd = core.PrevColumnCmd
+	// FocusCmd gives a component keyboard focus.
+	FocusCmd = core.FocusCmd
+	// BlurCmd removes keyboard focus from a component.
+	BlurCmd = core.BlurCmd
+)
+
+// Horizontal scrolling commands for table cells wider than their column.
+var (
+	// HorizontalScrollLeftCmd scrolls the active column left by a character.
+	HorizontalScrollLeftCmd = core.HorizontalScrollLeftCmd
+	// HorizontalScrollRightCmd scrolls the active column right by a character.
+	HorizontalScrollRightCmd = core.HorizontalScrollRightCmd
+	// HorizontalScrollWordLeftCmd scrolls the active column left by a word.
+	HorizontalScrollWordLeftCmd = core.HorizontalScrollWordLeftCmd
+	// HorizontalScrollWordRightCmd scrolls the active column right by a word.
+	HorizontalScrollWordRightCmd = core.HorizontalScrollWordRightCmd
+	// HorizontalScrollSmartLeftCmd scrolls the active column left to the
+	// previous smart boundary.
+	HorizontalScrollSmartLeftCmd = core.HorizontalScrollSmartLeftCmd
+	// HorizontalScrollSmartRightCmd scrolls the active column right to the
+	// next smart boundary.
+	HorizontalScrollSmartRightCmd = core.HorizontalScrollSmartRightCmd
+	// HorizontalScrollPageLeftCmd scrolls the active column left by a page.
+	HorizontalScrollPageLeftCmd = core.HorizontalScrollPageLeftCmd
+	// HorizontalScrollPageRightCmd scrolls the active column right by a page.
+	HorizontalScrollPageRightCmd = core.HorizontalScrollPageRightCmd
+	// HorizontalScrollModeToggleCmd cycles the character, word and smart modes.
+	HorizontalScrollModeToggleCmd = core.HorizontalScrollModeToggleCmd
+	// HorizontalScrollScopeToggleCmd toggles scrolling the cursor row or all rows.
+	HorizontalScrollScopeToggleCmd = core.HorizontalScrollScopeToggleCmd
+	// HorizontalScrollResetCmd resets all horizontal scroll offsets.
+	HorizontalScrollResetCmd = core.HorizontalScrollResetCmd
+)
+
+// Data commands.
+var (
+	// DataRefreshCmd reloads the total and all chunks from the data source.
+	DataRefreshCmd = core.DataRefreshCmd
+	// DataChunksRefreshCmd reloads the loaded chunks without resetting the total.
+	DataChunksRefreshCmd = core.DataChunksRefreshCmd
+	// DataTotalCmd reports the total number of items.
+	DataTotalCmd = core.DataTotalCmd
+	// DataTotalUpdateCmd reports a changed total while keeping the position.
+	DataTotalUpdateCmd = core.DataTotalUpdateCmd
+	// DataChunkLoadedCmd reports a loaded chunk.
+	DataChunkLoadedCmd = core.DataChunkLoadedCmd
+	// DataChunkErrorCmd reports a chunk that failed to load.
+	DataChunkErrorCmd = core.DataChunkErrorCmd
+	// RetryItemCmd reloads an item that failed to load.
+	RetryItemCmd = core.RetryItemCmd
+	// RetryAllErroredCmd reloads every loaded item that failed to load.
+	RetryAllErroredCmd = core.RetryAllErroredCmd
+	// DataSourceSetCmd replaces a component's data source.
+	DataSourceSetCmd = core.DataSourceSetCmd
+	// DataRequestSetCmd applies a whole DataRequest to a table.
+	DataRequestSetCmd = core.DataRequestSetCmd
+	// StateRestoreCmd restores a view state saved with SaveState.
+	StateRestoreCmd = core.StateRestoreCmd
+	// ExportCSVCmd exports a table's rows to a CSV file.
+	ExportCSVCmd = core.ExportCSVCmd
+	// CopySelectionCmd copies the selected rows to the clipboard.
+	CopySelectionCmd = core.CopySelectionCmd
+	// CellEditStartCmd starts editing the active cell of the table in place.
+	CellEditStartCmd = core.CellEditStartCmd
+)
+
+// Selection commands.
+var (
+	// SelectCurrentCmd toggles the selection of the item under the cursor.
+	SelectCurrentCmd = core.SelectCurrentCmd
+	// SelectToggleCmd toggles the selection of the item at an index.
+	SelectToggleCmd = core.SelectToggleCmd
+	// SelectAllCmd selects every item.
+	SelectAllCmd = core.SelectAllCmd
+	// SelectAllToggleCmd selects every item, or clears a full selection.
+	SelectAllToggleCmd = core.SelectAllToggleCmd
+	// SelectClearCmd clears the selection.
+	SelectClearCmd = core.SelectClearCmd
+	// SelectRangeCmd selects the items between two IDs.
+	SelectRangeCmd = core.SelectRangeCmd
+	// SelectionAnchorSetCmd starts a range selection at the cursor.
+	SelectionAnchorSetCmd = core.SelectionAnchorSetCmd
+	// SelectionAnchorCommitCmd ends a range selection, keeping it.
+	SelectionAnchorCommitCmd = core.SelectionAnchorCommitCmd
+	// SelectionAnchorClearCmd ends a range selection, deselecting it.
+	SelectionAnchorClearCmd = core.SelectionAnchorClearCmd
+	// SelectionModeSetCmd changes the selection mode.
+	SelectionModeSetCmd = core.SelectionModeSetCmd
+	// SelectionResponseCmd reports the result of a selection operation from a
+	// data source.
+	SelectionResponseCmd = core.SelectionResponseCmd
+)
+
+// Sorting, filtering and searching commands.
+var (
+	// SortToggleCmd toggles the sort of a field.
+	SortToggleCmd = core.SortToggleCmd
+	// SortSetCmd replaces the sort with a single field.
+	SortSetCmd = core.SortSetCmd
+	// SortAddCmd adds a field to a multi-column sort.
+	SortAddCmd = core.SortAddCmd
+	// SortRemoveCmd removes a field from the sort.
+	SortRemoveCmd = core.SortRemoveCmd
+	// SortsClearAllCmd removes all sorting.
+	SortsClearAllCmd = core.SortsClearAllCmd
+	// CycleSortCmd advances a field through its column's SortCycle.
+	CycleSortCmd = core.CycleSortCmd
+	// FilterSetCmd sets the filter of a field.
+	FilterSetCmd = core.FilterSetCmd
+	// FilterClearCmd removes the filter of a field.
+	FilterClearCmd = core.FilterClearCmd
+	// FiltersClearAllCmd removes all filters.
+	FiltersClearAllCmd = core.FiltersClearAllCmd
+	// FiltersFlushCmd applies debounced filter changes immediately.
+	FiltersFlushCmd = core.FiltersFlushCmd
+	// FuzzyFieldsSetCmd makes the filters of fields match fuzzily.
+	FuzzyFieldsSetCmd = core.FuzzyFieldsSetCmd
+	// SearchSetCmd searches for a query in a field.
+	SearchSetCmd = core.SearchSetCmd
+	// SearchClearCmd clears the search.
+	SearchClearCmd = core.SearchClearCmd
+)
+
+// Table layout and appearance commands.
+var (
+	// ColumnSetCmd replaces the table columns.
+	ColumnSetCmd = core.ColumnSetCmd
+	// ColumnUpdateCmd replaces a single table column.
+	ColumnUpdateCmd = core.ColumnUpdateCmd
+	// ColumnResizeCmd grows or shrinks a table column by a delta.
+	ColumnResizeCmd = core.ColumnResizeCmd
+	// MoveColumnLeftCmd moves the active table column one position left.
+	MoveColumnLeftCmd = core.MoveColumnLeftCmd
+	// MoveColumnRightCmd moves the active table column one position right.
+	MoveColumnRightCmd = core.MoveColumnRightCmd
+	// ColumnWidthSetCmd sets the width of a table column.
+	ColumnWidthSetCmd = core.ColumnWidthSetCmd
+	// ColumnsAutoSizeCmd fits the table column widths to their content.
+	ColumnsAutoSizeCmd = core.ColumnsAutoSizeCmd
+	// ColumnPinCmd pins or unpins a table column.
+	ColumnPinCmd = core.ColumnPinCmd
+	// RowPinCmd pins or unpins a table row above the scrolling rows.
+	RowPinCmd = core.RowPinCmd
+	// ColumnVisibilityCmd shows or hides a table column by field.
+	ColumnVisibilityCmd = core.ColumnVisibilityCmd
+	// HeaderVisibilityCmd shows or hides the table header.
+	HeaderVisibilityCmd = core.HeaderVisibilityCmd
+	// BorderVisibilityCmd shows or hides all table borders.
+	BorderVisibilityCmd = core.BorderVisibilityCmd
+	// TopBorderVisibilityCmd shows or hides the top border.
+	TopBorderVisibilityCmd = core.TopBorderVisibilityCmd
+	// BottomBorderVisibilityCmd shows or hides the bottom border.
+	BottomBorderVisibilityCmd = core.BottomBorderVisibilityCmd
+	// HeaderSeparatorVisibilityCmd shows or hides the line under the header.
+	HeaderSeparatorVisibilityCmd = core.HeaderSeparatorVisibilityCmd
+	// TopBorderSpaceRemovalCmd removes the line reserved for the top border.
+	TopBorderSpaceRemovalCmd = core.TopBorderSpaceRemovalCmd
+	// BottomBorderSpaceRemovalCmd removes the line reserved for the bottom border.
+	BottomBorderSpaceRemovalCmd = core.BottomBorderSpaceRemovalCmd
+	// FullRowHighlightEnableCmd turns full-row cursor highlighting on or off.
+	FullRowHighlightEnableCmd = core.FullRowHighlightEnableCmd
+	// FullRowHighlightToggleCmd toggles full-row cursor highlighting.
+	FullRowHighlightToggleCmd = core.FullRowHighlightToggleCmd
+	// ZebraStripingEnableCmd turns alternating row backgrounds on or off.
+	ZebraStripingEnableCmd = core.ZebraStripingEnableCmd
+	// RowStyleFuncSetCmd sets the callback styling whole table rows.
+	RowStyleFuncSetCmd = core.RowStyleFuncSetCmd
+	// FooterRowSetCmd sets the summary row pinned below the table rows.
+	FooterRowSetCmd = core.FooterRowSetCmd
+	// ActiveCellIndicationModeSetCmd turns the active cell highlight on or off.
+	ActiveCellIndicationModeSetCmd = core.ActiveCellIndicationModeSetCmd
+	// ActiveCellMoveCmd moves the active cell across rows and columns.
+	ActiveCellMoveCmd = core.ActiveCellMoveCmd
+	// ActiveCellBackgroundColorSetCmd sets the active cell highlight color.
+	ActiveCellBackgroundColorSetCmd = core.ActiveCellBackgroundColorSetCmd
+	// CellFormatterSetCmd sets the formatter of a table column.
+	CellFormatterSetCmd = core.CellFormatterSetCmd
+	// HeaderFormatterSetCmd sets the formatter of the whole table header.
+	HeaderFormatterSetCmd = core.HeaderFormatterSetCmd
+	// CellFormatterSetByFieldCmd sets the formatter of the column showing a field.
+	CellFormatterSetByFieldCmd = core.CellFormatterSetByFieldCmd
+	// HeaderFormatterSetByFieldCmd sets the header formatter of the column showing a field.
+	HeaderFormatterSetByFieldCmd = core.HeaderFormatterSetByFieldCmd
+	// HeaderCellFormatterSetCmd sets the formatter of a single header cell.
+	HeaderCellFormatterSetCmd = core.HeaderCellFormatterSetCmd
+	// LoadingFormatterSetCmd sets the formatter of rows that are still loading.
+	LoadingFormatterSetCmd = core.LoadingFormatterSetCmd
+	// TableThemeSetCmd applies a table theme.
+	TableThemeSetCmd = core.TableThemeSetCmd
+	// StatusLineSetCmd sets the status line shown under the table.
+	StatusLineSetCmd = core.StatusLineSetCmd
+)
+
+// List and general component commands.
+var (
+	// FormatterSetCmd sets the item formatter of a list.
+	FormatterSetCmd = core.FormatterSetCmd
+	// StyleConfigSetCmd applies list styles.
+	StyleConfigSetCmd = core.StyleConfigSetCmd
+	// MaxWidthSetCmd sets the maximum width of a list.
+	MaxWidthSetCmd = core.MaxWidthSetCmd
+	// ViewportResizeCmd changes the viewport height.
+	ViewportResizeCmd = core.ViewportResizeCmd
+	// KeyMapSetCmd replaces the keybindings.
+	KeyMapSetCmd = core.KeyMapSetCmd
+	// ResetCmd resets a component to its initial state.
+	ResetCmd = core.ResetCmd
+)
@@ -0,0 +1,270 @@
+package vtable_test
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/davidroman0O/vtable"
+	"github.com/davidroman0O/vtable/config"
+	"github.com/davidroman0O/vtable/core"
+	"github.com/davidroman0O/vtable/list"
+	"github.com/davidroman0O/vtable/table"
+	"github.com/davidroman0O/vtable/testutil"
+	"github.com/davidroman0O/vtable/tree"
+)
+
+// reexports lists every value re-exported by the package, so that removing or
+// retyping one breaks the build of this test
+var reexports = []any{
+	vtable.NewList, vtable.NewTable, vtable.NewTreeList[string], vtable.NewController,
+
+	vtable.SelectionSingle, vtable.SelectionMultiple, vtable.SelectionNone,
+	vtable.AlignLeft, vtable.AlignCenter, vtable.AlignRight,
+	vtable.ColorProfileAuto, vtable.ColorProfileTrueColor, vtable.ColorProfileANSI256, vtable.ColorProfileANSI, vtable.ColorProfileMono,
+	vtable.CopyTSV, vtable.CopyCSV, vtable.CopyJSON,
+	vtable.BorderNormal, vtable.BorderRounded, vtable.BorderDouble, vtable.BorderThick, vtable.BorderASCII, vtable.BorderNone,
+
+	vtable.DefaultListConfig, vtable.DefaultListRenderConfig, vtable.DefaultTableConfig, vtable.DefaultViewportConfig,
+	vtable.DefaultStyleConfig, vtable.DefaultTheme, vtable.DefaultTreeConfig, vtable.DefaultTreeRenderConfig,
+
+	vtable.CursorUpCmd, vtable.CursorDownCmd, vtable.CursorLeftCmd, vtable.CursorRightCmd,
+	vtable.PageUpCmd, vtable.PageDownCmd, vtable.JumpToStartCmd, vtable.JumpToEndCmd, vtable.JumpToCmd,
+	vtable.JumpToPercentCmd, vtable.SetMarkCmd, vtable.JumpToMarkCmd, vtable.TreeJumpToIndexCmd,
+	vtable.TreeExpandAllCmd, vtable.TreeCollapseAllCmd, vtable.TreeExpandToDepthCmd,
+	vtable.NextColumnCmd, vtable.PrevColumnCmd, vtable.FocusCmd, vtable.BlurCmd,
+
+	vtable.HorizontalScrollLeftCmd, vtable.HorizontalScrollRightCmd, vtable.HorizontalScrollWordLeftCmd,
+	vtable.HorizontalScrollWordRightCmd, vtable.HorizontalScrollSmartLeftCmd, vtable.HorizontalScrollSmartRightCmd,
+	vtable.HorizontalScrollPageLeftCmd, vtable.HorizontalScrollPageRightCmd, vtable.HorizontalScrollModeToggleCmd,
+	vtable.HorizontalScrollScopeToggleCmd, vtable.HorizontalScrollResetCmd,
+
+	vtable.DataRefreshCmd, vtable.DataChunksRefreshCmd, vtable.DataTotalCmd, vtable.DataTotalUpdateCmd,
+	vtable.DataChunkLoadedCmd, vtable.DataChunkErrorCmd, vtable.RetryItemCmd, vtable.RetryAllErroredCmd,
+	vtable.DataSourceSetCmd, vtable.DataRequestSetCmd, vtable.StateRestoreCmd, vtable.ExportCSVCmd,
+	vtable.CopySelectionCmd, vtable.CellEditStartCmd,
+
+	vtable.SelectCurrentCmd, vtable.SelectToggleCmd, vtable.SelectAllCmd, vtable.SelectAllToggleCmd,
+	vtable.SelectClearCmd, vtable.SelectRangeCmd, vtable.SelectionAnchorSetCmd, vtable.SelectionAnchorCommitCmd,
+	vtable.SelectionAnchorClearCmd, vtable.SelectionModeSetCmd, vtable.SelectionResponseCmd,
+
+	vtable.SortToggleCmd, vtable.SortSetCmd, vtable.SortAddCmd, vtable.SortRemoveCmd, vtable.SortsClearAllCmd,
+	vtable.CycleSortCmd, vtable.FilterSetCmd, vtable.FilterClearCmd, vtable.FiltersClearAllCmd,
+	vtable.FiltersFlushCmd, vtable.FuzzyFieldsSetCmd, vtable.SearchSetCmd, vtable.SearchClearCmd,
+
+	vtable.ColumnSetCmd, vtable.ColumnUpdateCmd, vtable.ColumnResizeCmd, vtable.MoveColumnLeftCmd,
+	vtable.MoveColumnRightCmd, vtable.ColumnWidthSetCmd, vtable.ColumnsAutoSizeCmd, vtable.ColumnPinCmd,
+	vtable.RowPinCmd, vtable.ColumnVisibilityCmd, vtable.HeaderVisibilityCmd, vtable.BorderVisibilityCmd,
+	vtable.TopBorderVisibilityCmd, vtable.BottomBorderVisibilityCmd, vtable.HeaderSeparatorVisibilityCmd,
+	vtable.TopBorderSpaceRemovalCmd, vtable.BottomBorderSpaceRemovalCmd, vtable.FullRowHighlightEnableCmd,
+	vtable.FullRowHighlightToggleCmd, vtable.ZebraStripingEnableCmd, vtable.RowStyleFuncSetCmd,
+	vtable.FooterRowSetCmd, vtable.ActiveCellIndicationModeSetCmd, vtable.ActiveCellMoveCmd,
+	vtable.ActiveCellBackgroundColorSetCmd, vtable.CellFormatterSetCmd, vtable.HeaderFormatterSetCmd,
+	vtable.CellFormatterSetByFieldCmd, vtable.HeaderFormatterSetByFieldCmd, vtable.HeaderCellFormatterSetCmd,
+	vtable.LoadingFormatterSetCmd, vtable.TableThemeSetCmd, vtable.StatusLineSetCmd,
+
+	vtable.FormatterSetCmd, vtable.StyleConfigSetCmd, vtable.MaxWidthSetCmd, vtable.ViewportResizeCmd,
+	vtable.KeyMapSetCmd, vtable.ResetCmd,
+}
+
+// The type aliases convert to their underlying types without conversion,
+// which only compiles while they stay aliases of them
+var (
+	_ = func(v *vtable.List) *list.List { return v }
+	_ = func(v *vtable.Table) *table.Table { return v }
+	_ = func(v *vtable.TreeList[string]) *tree.TreeList[string] { return v }
+	_ = func(v *vtable.Controller) *table.Controller { return v }
+
+	_ = func(v vtable.DataSource[string]) core.DataSource[string] { return v }
+	_ = func(v vtable.Data[string]) core.Data[string] { return v }
+	_ = func(v vtable.DataRequest) core.DataRequest { return v }
+	_ = func(v vtable.PersistedState) core.PersistedState { return v }
+	_ = func(v vtable.TableRow) core.TableRow { return v }
+	_ = func(v vtable.TableColumn) core.TableColumn { return v }
+	_ = func(v vtable.TreeData[string]) tree.TreeData[string] { return v }
+	_ = func(v vtable.TreeDataSource[string]) tree.TreeDataSource[string] { return v }
+	_ = func(v vtable.LazyTreeDataSource[string]) tree.LazyTreeDataSource[string] { return v }
+	_ = func(v vtable.FlatTreeItem[string]) tree.FlatTreeItem[string] { return v }
+
+	_ = func(v vtable.ListConfig) core.ListConfig { return v }
+	_ = func(v vtable.TableConfig) core.TableConfig { return v }
+	_ = func(v vtable.TreeConfig) tree.TreeConfig { return v }
+	_ = func(v vtable.ViewportConfig) core.ViewportConfig { return v }
+	_ = func(v vtable.ViewportState) core.ViewportState { return v }
+	_ = func(v vtable.DebugSnapshot) core.DebugSnapshot { return v }
+	_ = func(v vtable.SelectionMode) core.SelectionMode { return v }
+	_ = func(v vtable.SelectionConfig) core.SelectionConfig { return v }
+	_ = func(v vtable.NavigationKeyMap) core.NavigationKeyMap { return v }
+	_ = func(v vtable.Theme) core.Theme { return v }
+	_ = func(v *vtable.ThemeRegistry) *core.ThemeRegistry { return v }
+	_ = func(v vtable.StyleConfig) core.StyleConfig { return v }
+	_ = func(v vtable.ListRenderConfig) core.ListRenderConfig { return v }
+	_ = func(v vtable.TreeRenderConfig) tree.TreeRenderConfig { return v }
+	_ = func(v vtable.RenderContext) core.RenderContext { return v }
+	_ = func(v vtable.ItemFormatter[string]) core.ItemFormatter[string] { return v }
+	_ = func(v vtable.CellFormatter) core.CellFormatter { return v }
+	_ = func(v vtable.HeaderFormatter) core.HeaderFormatter { return v }
+	_ = func(v vtable.HeaderCellFormatter) core.HeaderCellFormatter { return v }
+	_ = func(v vtable.ColorProfile) core.ColorProfile { return v }
+	_ = func(v vtable.CopyFormat) core.CopyFormat { return v }
+	_ = func(v vtable.BorderStyle) core.BorderStyle { return v }
+)
+
+// rowSource serves table rows from memory, written against the core package
+type rowSource struct {
+	rows     []core.TableRow
+	selected map[string]bool
+}
+
+func (s *rowSource) LoadChunk(request core.DataRequest) tea.Cmd {
+	return func() tea.Msg {
+		var items []core.Data[any]
+		for i := request.Start; i < request.Start+request.Count && i < len(s.rows); i++ {
+			row := s.rows[i]
+			items = append(items, core.Data[any]{ID: row.ID, Item: row, Selected: s.selected[row.ID]})
+		}
+		return core.DataChunkLoadedMsg{StartIndex: request.Start, Items: items, Request: request}
+	}
+}
+
+func (s *rowSource) GetTotal() tea.Cmd {
+	return func() tea.Msg { return core.DataTotalMsg{Total: len(s.rows)} }
+}
+
+func (s *rowSource) RefreshTotal() tea.Cmd { return s.GetTotal() }
+
+func (s *rowSource) SetSelected(index int, selected bool) tea.Cmd {
+	if index < 0 || index >= len(s.rows) {
+		return nil
+	}
+	return s.SetSelectedByID(s.rows[index].ID, selected)
+}
+
+func (s *rowSource) SetSelectedByID(id string, selected bool) tea.Cmd {
+	return func() tea.Msg {
+		s.selected[id] = selected
+		return core.SelectionResponseMsg{Success: true, ID: id, Selected: selected}
+	}
+}
+
+func (s *rowSource) SelectAll() tea.Cmd                           { return nil }
+func (s *rowSource) ClearSelection() tea.Cmd                      { return nil }
+func (s *rowSource) SelectRange(startIndex, endIndex int) tea.Cmd { return nil }
+
+func (s *rowSource) GetItemID(item any) string {
+	if row, ok := item.(core.TableRow); ok {
+		return row.ID
+	}
+	return ""
+}
+
+func TestReexportsAreAliases(t *testing.T) {
+	for i, value := range reexports {
+		if value == nil {
+			t.Errorf("Re-export %d is nil", i)
+		}
+	}
+
+	// Generic aliases instantiate to the very same types
+	pairs := []struct{ alias, original any }{
+		{vtable.Data[string]{}, core.Data[string]{}},
+		{vtable.TreeData[int]{}, tree.TreeData[int]{}},
+		{vtable.FlatTreeItem[string]{}, tree.FlatTreeItem[string]{}},
+		{(*vtable.TreeList[string])(nil), (*tree.TreeList[string])(nil)},
+		{(*vtable.DataSource[any])(nil), (*core.DataSource[any])(nil)},
+		{vtable.ItemFormatter[any](nil), core.ItemFormatter[any](nil)},
+	}
+	for _, pair := range pairs {
+		if a, o := reflect.TypeOf(pair.alias), reflect.TypeOf(pair.original); a != o {
+			t.Errorf("Expected %v to be %v", a, o)
+		}
+	}
+
+	// Constants and defaults are the underlying values
+	if vtable.SelectionMultiple != core.SelectionMultiple || vtable.BorderRounded != core.BorderRounded {
+		t.Error("Expected re-exported constants to equal the core constants")
+	}
+	if !reflect.DeepEqual(vtable.DefaultViewportConfig(), config.DefaultViewportConfig()) {
+		t.Error("Expected DefaultViewportConfig to return the config default")
+	}
+}
+
+func TestReexportsInteroperate(t *testing.T) {
+	// A core configuration and data source build a table through vtable
+	cfg := config.DefaultTableConfig()
+	cfg.Columns = []vtable.TableColumn{{Title: "Name", Field: "name", Width: 10}}
+	cfg.SelectionMode = vtable.SelectionMultiple
+	cfg.ViewportConfig.Height = 3
+	source := &rowSource{selected: make(map[string]bool)}
+	for _, name := range []string{"alpha", "beta", "gamma", "delta"} {
+		source.rows = append(source.rows, core.TableRow{ID: name, Cells: []string{name}})
+	}
+
+	var tbl *table.Table = vtable.NewTable(cfg, source)
+	ctrl := vtable.NewController(tbl)
+
+	// vtable commands drive the table, and its state reads back as core types
+	ctrl.Do(vtable.CursorDownCmd())
+	ctrl.Do(vtable.SelectCurrentCmd())
+	var state core.ViewportState = tbl.GetState()
+	if state.CursorIndex != 1 {
+		t.Errorf("Expected the cursor on the second row, got %+v", state)
+	}
+	if ids := tbl.GetSelectedIDs(); len(ids) != 1 || ids[0] != "beta" {
+		t.Errorf("Expected beta selected, got %v", ids)
+	}
+	if view := ctrl.Render(); !strings.Contains(view, "gamma") {
+		t.Errorf("Expected the rows in the view:\n%s", view)
+	}
+
+	// A core command drives a list built through vtable
+	lst := vtable.NewList(vtable.DefaultListConfig(), source)
+	testutil.Drive(lst, lst.Init())
+	testutil.Drive(lst, core.JumpToEndCmd())
+	if got := lst.GetState().CursorIndex; got != 3 {
+		t.Errorf("Expected the list cursor on the last item, got %d", got)
+	}
+
+	// Tree data built with the tree package feeds a vtable tree
+	roots := []tree.TreeData[string]{{ID: "root", Item: "root", Children: []vtable.TreeData[string]{{ID: "leaf", Item: "leaf"}}}}
+	tl := vtable.NewTreeList(vtable.DefaultListConfig(), vtable.DefaultTreeConfig(), &treeSource{roots: roots})
+	testutil.Drive(tl, tl.Init())
+	testutil.Drive(tl, vtable.TreeExpandAllCmd())
+	if got := tl.GetState(); got.CursorIndex != 0 {
+		t.Errorf("Expected the tree cursor on the root, got %+v", got)
+	}
+	if view := core.StripANSI(tl.View()); !strings.Contains(view, "leaf") {
+		t.Errorf("Expected the expanded leaf in the tree view:\n%s", view)
+	}
+}
+
+// treeSource serves a fixed tree
+type treeSource struct {
+	roots []vtable.TreeData[string]
+}
+
+func (s *treeSource) GetRootNodes() []tree.TreeData[string] { return s.roots }
+
+func (s *treeSource) GetItemByID(id string) (tree.TreeData[string], bool) {
+	var find func(nodes []tree.TreeData[string]) (tree.TreeData[string], bool)
+	find = func(nodes []tree.TreeData[string]) (tree.TreeData[string], bool) {
+		for _, node := range nodes {
+			if node.ID == id {
+				return node, true
+			}
+			if found, ok := find(node.Children); ok {
+				return found, true
+			}
+		}
+		return tree.TreeData[string]{}, false
+	}
+	return find(s.roots)
+}
+
+func (s *treeSource) SetSelected(id string, selected bool) tea.Cmd     { return nil }
+func (s *treeSource) SetSelectedByID(id string, selected bool) tea.Cmd { return nil }
+func (s *treeSource) SelectAll() tea.Cmd                               { return nil }
+func (s *treeSource) ClearSelection() tea.Cmd                          { return nil }
+func (s *treeSource) SelectRange(startID, endID string) tea.Cmd        { return nil }